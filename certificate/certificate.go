@@ -0,0 +1,140 @@
+// Package certificate parses and validates PEM encoded TLS certificates offline, so that problems with a
+// certificate can be reported before it is uploaded to Vercel.
+package certificate
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Certificate holds the details of a parsed leaf certificate.
+type Certificate struct {
+	// Domains are the DNS subject alternative names of the certificate. If the certificate has none, the
+	// common name of its subject is used instead.
+	Domains   []string
+	Issuer    string
+	NotBefore time.Time
+	NotAfter  time.Time
+	// Fingerprint is the SHA-256 fingerprint of the certificate, as lowercase hex.
+	Fingerprint string
+}
+
+// Parse parses a PEM encoded leaf certificate. Only the first certificate in certPEM is used.
+func Parse(certPEM string) (Certificate, error) {
+	certs, err := parseCertificates(certPEM)
+	if err != nil {
+		return Certificate{}, err
+	}
+	if len(certs) == 0 {
+		return Certificate{}, fmt.Errorf("no PEM encoded CERTIFICATE block found")
+	}
+	leaf := certs[0]
+	fingerprint := sha256.Sum256(leaf.Raw)
+	domains := leaf.DNSNames
+	if len(domains) == 0 && leaf.Subject.CommonName != "" {
+		domains = []string{leaf.Subject.CommonName}
+	}
+	return Certificate{
+		Domains:     domains,
+		Issuer:      leaf.Issuer.String(),
+		NotBefore:   leaf.NotBefore.UTC(),
+		NotAfter:    leaf.NotAfter.UTC(),
+		Fingerprint: hex.EncodeToString(fingerprint[:]),
+	}, nil
+}
+
+// Validate checks that a certificate, private key and CA chain can be used together. The private key must
+// match the certificate, and the certificate must be signed by the chain. The expiry of the certificate is not
+// checked, so that a certificate that has since expired remains valid configuration; use Parse to check it.
+func Validate(certPEM, keyPEM, caPEM string) error {
+	if err := CheckKey(certPEM, keyPEM); err != nil {
+		return err
+	}
+	return CheckChain(certPEM, caPEM)
+}
+
+// CheckKey checks that a PEM encoded private key matches a certificate.
+func CheckKey(certPEM, keyPEM string) error {
+	if _, err := tls.X509KeyPair([]byte(certPEM), []byte(keyPEM)); err != nil {
+		return fmt.Errorf("the private key does not match the certificate: %w", err)
+	}
+	return nil
+}
+
+// CheckChain checks that a certificate is signed by a PEM encoded CA chain. The chain may either end with a
+// root certificate, or stop at an intermediate that is signed by a root trusted by clients.
+func CheckChain(certPEM, caPEM string) error {
+	certs, err := parseCertificates(certPEM)
+	if err != nil {
+		return err
+	}
+	if len(certs) == 0 {
+		return fmt.Errorf("the certificate does not contain a PEM encoded CERTIFICATE block")
+	}
+	leaf := certs[0]
+
+	chain, err := parseCertificates(caPEM)
+	if err != nil {
+		return fmt.Errorf("the CA chain is invalid: %w", err)
+	}
+	if len(chain) == 0 {
+		return fmt.Errorf("the CA chain does not contain a PEM encoded CERTIFICATE block")
+	}
+	intermediates := x509.NewCertPool()
+	roots := x509.NewCertPool()
+	for _, c := range append(certs[1:], chain...) {
+		if c.IsCA && c.CheckSignatureFrom(c) == nil {
+			roots.AddCert(c)
+			continue
+		}
+		intermediates.AddCert(c)
+	}
+	_, err = leaf.Verify(x509.VerifyOptions{
+		Intermediates: intermediates,
+		Roots:         roots,
+		// Verify the chain as of when the certificate was issued, as expiry is not checked.
+		CurrentTime: leaf.NotBefore,
+		KeyUsages:   []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	if _, ok := err.(x509.UnknownAuthorityError); ok {
+		for _, c := range chain {
+			if leaf.CheckSignatureFrom(c) == nil {
+				return nil
+			}
+		}
+		return fmt.Errorf("the certificate is not signed by the CA chain")
+	}
+	if err != nil {
+		return fmt.Errorf("the certificate could not be verified against the CA chain: %w", err)
+	}
+	return nil
+}
+
+func parseCertificates(data string) (certs []*x509.Certificate, err error) {
+	rest := []byte(strings.TrimSpace(data))
+	for len(rest) > 0 {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			if len(certs) == 0 {
+				return nil, fmt.Errorf("no PEM encoded data found")
+			}
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			return nil, fmt.Errorf("expected a CERTIFICATE PEM block, found %q", block.Type)
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse certificate: %w", err)
+		}
+		certs = append(certs, cert)
+	}
+	return certs, nil
+}
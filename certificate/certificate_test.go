@@ -0,0 +1,139 @@
+package certificate
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+var now = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+type keyPair struct {
+	cert    *x509.Certificate
+	key     *ecdsa.PrivateKey
+	certPEM string
+	keyPEM  string
+}
+
+func newKeyPair(t *testing.T, template *x509.Certificate, parent *keyPair) keyPair {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("unexpected error generating key: %s", err)
+	}
+	parentCert, parentKey := template, key
+	if parent != nil {
+		parentCert, parentKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parentCert, &key.PublicKey, parentKey)
+	if err != nil {
+		t.Fatalf("unexpected error creating certificate: %s", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("unexpected error parsing certificate: %s", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("unexpected error marshalling key: %s", err)
+	}
+	return keyPair{
+		cert:    cert,
+		key:     key,
+		certPEM: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		keyPEM:  string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})),
+	}
+}
+
+func newCA(t *testing.T, name string, parent *keyPair) keyPair {
+	return newKeyPair(t, &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(365 * 24 * time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}, parent)
+}
+
+func newLeaf(t *testing.T, parent *keyPair, notAfter time.Time) keyPair {
+	return newKeyPair(t, &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "example.com"},
+		DNSNames:     []string{"example.com", "*.example.com"},
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     notAfter,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}, parent)
+}
+
+func TestParse(t *testing.T) {
+	root := newCA(t, "Test Root", nil)
+	leaf := newLeaf(t, &root, now.Add(90*24*time.Hour))
+
+	cert, err := Parse(leaf.certPEM + root.certPEM)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !reflect.DeepEqual(cert.Domains, []string{"example.com", "*.example.com"}) {
+		t.Errorf("unexpected domains %v", cert.Domains)
+	}
+	if !cert.NotAfter.Equal(now.Add(90 * 24 * time.Hour)) {
+		t.Errorf("unexpected expiry %s", cert.NotAfter)
+	}
+	if cert.Issuer != "CN=Test Root" {
+		t.Errorf("unexpected issuer %q", cert.Issuer)
+	}
+	if len(cert.Fingerprint) != 64 {
+		t.Errorf("expected a sha256 hex fingerprint, got %q", cert.Fingerprint)
+	}
+
+	if _, err := Parse("not a certificate"); err == nil {
+		t.Error("expected an error parsing invalid PEM")
+	}
+	if _, err := Parse(leaf.keyPEM); err == nil || !strings.Contains(err.Error(), "expected a CERTIFICATE") {
+		t.Errorf("expected an error parsing a key as a certificate, got %v", err)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	root := newCA(t, "Test Root", nil)
+	intermediate := newCA(t, "Test Intermediate", &root)
+	leaf := newLeaf(t, &intermediate, now.Add(90*24*time.Hour))
+	other := newLeaf(t, &intermediate, now.Add(90*24*time.Hour))
+	unrelated := newCA(t, "Unrelated Root", nil)
+
+	for _, tt := range []struct {
+		name    string
+		cert    string
+		key     string
+		ca      string
+		wantErr string
+	}{
+		{name: "full chain", cert: leaf.certPEM, key: leaf.keyPEM, ca: intermediate.certPEM + root.certPEM},
+		{name: "intermediate only", cert: leaf.certPEM, key: leaf.keyPEM, ca: intermediate.certPEM},
+		{name: "mismatched key", cert: leaf.certPEM, key: other.keyPEM, ca: intermediate.certPEM, wantErr: "private key does not match"},
+		{name: "wrong chain", cert: leaf.certPEM, key: leaf.keyPEM, ca: unrelated.certPEM, wantErr: "not signed by the CA chain"},
+		{name: "empty chain", cert: leaf.certPEM, key: leaf.keyPEM, ca: "", wantErr: "does not contain"},
+		{name: "invalid chain", cert: leaf.certPEM, key: leaf.keyPEM, ca: "garbage", wantErr: "CA chain is invalid"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.cert, tt.key, tt.ca)
+			if tt.wantErr == "" && err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+			if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
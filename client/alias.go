@@ -29,7 +29,7 @@ func (c *Client) CreateAlias(ctx context.Context, request CreateAliasRequest, de
 
 	tflog.Info(ctx, "creating alias", map[string]interface{}{
 		"url":     url,
-		"payload": payload,
+		"payload": redactPayload(payload),
 	})
 	var aliasResponse createAliasResponse
 	err = c.doRequest(clientRequest{
@@ -0,0 +1,172 @@
+package client
+
+import "context"
+
+// ProjectsAPI defines the operations the Vercel API provides for managing projects, and the configuration
+// that belongs to them: environment variables, domains, deploy hooks, git repositories, protection bypasses,
+// Attack Challenge Mode, deployment retention and function CPU.
+type ProjectsAPI interface {
+	CreateProject(ctx context.Context, teamID string, request CreateProjectRequest) (ProjectResponse, error)
+	GetProject(ctx context.Context, projectID, teamID string) (ProjectResponse, error)
+	ListProjects(ctx context.Context, teamID string) ([]ProjectResponse, error)
+	UpdateProject(ctx context.Context, projectID, teamID string, request UpdateProjectRequest) (ProjectResponse, error)
+	DeleteProject(ctx context.Context, projectID, teamID string) error
+
+	UpdateProductionBranch(ctx context.Context, request UpdateProductionBranchRequest) (ProjectResponse, error)
+	LinkGitRepoToProject(ctx context.Context, request LinkGitRepoToProjectRequest) (ProjectResponse, error)
+	UnlinkGitRepoFromProject(ctx context.Context, projectID, teamID string) (ProjectResponse, error)
+	UpdateProtectionBypassForAutomation(ctx context.Context, request UpdateProtectionBypassForAutomationRequest) (string, error)
+
+	CreateDeployHook(ctx context.Context, request CreateDeployHookRequest) (DeployHook, error)
+	DeleteDeployHook(ctx context.Context, request DeleteDeployHookRequest) error
+
+	CreateEnvironmentVariable(ctx context.Context, request CreateEnvironmentVariableRequest) (EnvironmentVariable, error)
+	CreateEnvironmentVariables(ctx context.Context, request CreateEnvironmentVariablesRequest) error
+	GetEnvironmentVariable(ctx context.Context, projectID, teamID, envID string) (EnvironmentVariable, error)
+	GetEnvironmentVariables(ctx context.Context, projectID, teamID string) ([]EnvironmentVariable, error)
+	UpdateEnvironmentVariable(ctx context.Context, request UpdateEnvironmentVariableRequest) (EnvironmentVariable, error)
+	DeleteEnvironmentVariable(ctx context.Context, projectID, teamID, variableID string) error
+
+	CreateProjectDomain(ctx context.Context, projectID, teamID string, request CreateProjectDomainRequest) (ProjectDomainResponse, error)
+	GetProjectDomain(ctx context.Context, projectID, domain, teamID string) (ProjectDomainResponse, error)
+	UpdateProjectDomain(ctx context.Context, projectID, domain, teamID string, request UpdateProjectDomainRequest) (ProjectDomainResponse, error)
+	VerifyProjectDomain(ctx context.Context, projectID, domain, teamID string) (ProjectDomainResponse, error)
+	DeleteProjectDomain(ctx context.Context, projectID, domain, teamID string) error
+
+	GetAttackChallengeMode(ctx context.Context, projectID, teamID string) (AttackChallengeMode, error)
+	UpdateAttackChallengeMode(ctx context.Context, request AttackChallengeMode) (AttackChallengeMode, error)
+
+	GetDeploymentRetention(ctx context.Context, projectID, teamID string) (DeploymentExpiration, error)
+	UpdateDeploymentRetention(ctx context.Context, request UpdateDeploymentRetentionRequest) (DeploymentExpiration, error)
+	DeleteDeploymentRetention(ctx context.Context, projectID, teamID string) error
+
+	GetProjectFunctionCPU(ctx context.Context, projectID, teamID string) (ProjectFunctionCPU, error)
+	UpdateProjectFunctionCPU(ctx context.Context, request ProjectFunctionCPURequest) (ProjectFunctionCPU, error)
+}
+
+// TeamsAPI defines the operations the Vercel API provides for managing the settings of teams, and their members.
+type TeamsAPI interface {
+	Team(ctx context.Context, teamID string) (Team, error)
+	GetTeam(ctx context.Context, idOrSlug string) (Team, error)
+	UpdateTeam(ctx context.Context, request UpdateTeamRequest) (Team, error)
+
+	InviteTeamMember(ctx context.Context, request InviteTeamMemberRequest) (TeamMember, error)
+	GetTeamMember(ctx context.Context, request TeamMemberRequest) (TeamMember, error)
+	ListTeamMembers(ctx context.Context, teamID string) ([]TeamMember, error)
+	UpdateTeamMember(ctx context.Context, request UpdateTeamMemberRequest) error
+	RemoveTeamMember(ctx context.Context, member TeamMember) error
+}
+
+// DeploymentsAPI defines the operations the Vercel API provides for managing deployments, and the files
+// that they are built from.
+type DeploymentsAPI interface {
+	CreateFile(ctx context.Context, request CreateFileRequest) error
+	CreateDeployment(ctx context.Context, request CreateDeploymentRequest, teamID string) (DeploymentResponse, error)
+	GetDeployment(ctx context.Context, deploymentID, teamID string) (DeploymentResponse, error)
+	DeleteDeployment(ctx context.Context, deploymentID string, teamID string) (DeleteDeploymentResponse, error)
+}
+
+// DNSAPI defines the operations the Vercel API provides for managing DNS records.
+type DNSAPI interface {
+	CreateDNSRecord(ctx context.Context, teamID string, request CreateDNSRecordRequest) (DNSRecord, error)
+	GetDNSRecord(ctx context.Context, recordID, teamID string) (DNSRecord, error)
+	ListDNSRecords(ctx context.Context, domain, teamID string) ([]DNSRecord, error)
+	UpdateDNSRecord(ctx context.Context, teamID, recordID string, request UpdateDNSRecordRequest) (DNSRecord, error)
+	DeleteDNSRecord(ctx context.Context, domain, recordID, teamID string) error
+}
+
+// CertificatesAPI defines the operations the Vercel API provides for managing custom TLS certificates.
+type CertificatesAPI interface {
+	UploadCustomCertificate(ctx context.Context, request UploadCustomCertificateRequest) (CustomCertificate, error)
+	GetCustomCertificate(ctx context.Context, id, teamID string) (CustomCertificate, error)
+	DeleteCustomCertificate(ctx context.Context, id, teamID string) error
+}
+
+// DomainsAPI defines the operations the Vercel API provides for managing the domains of an account or team.
+type DomainsAPI interface {
+	CreateDomain(ctx context.Context, teamID string, request CreateDomainRequest) (Domain, error)
+	GetDomain(ctx context.Context, domain, teamID string) (Domain, error)
+	UpdateDomain(ctx context.Context, domain, teamID string, request UpdateDomainRequest) error
+	VerifyDomain(ctx context.Context, domain, teamID string) (Domain, error)
+	DeleteDomain(ctx context.Context, domain, teamID string) error
+	GetDomainConfig(ctx context.Context, domain, projectIDOrName, teamID string) (DomainConfig, error)
+}
+
+// FirewallAPI defines the operations the Vercel API provides for managing the firewall configuration of a
+// project, either as a whole or one rule at a time, along with its system bypass rules.
+type FirewallAPI interface {
+	GetFirewallConfig(ctx context.Context, projectID, teamID string) (FirewallConfig, error)
+	PutFirewallConfig(ctx context.Context, cfg FirewallConfig) (FirewallConfig, error)
+	PatchFirewallConfig(ctx context.Context, request PatchFirewallConfigRequest) error
+
+	CreateFirewallBypass(ctx context.Context, request FirewallBypassRequest) (FirewallBypass, error)
+	GetFirewallBypass(ctx context.Context, request FirewallBypassRequest) (FirewallBypass, error)
+	DeleteFirewallBypass(ctx context.Context, request FirewallBypassRequest) error
+}
+
+// EdgeConfigAPI defines the operations the Vercel API provides for managing Edge Configs, along with their
+// schemas and tokens.
+type EdgeConfigAPI interface {
+	CreateEdgeConfig(ctx context.Context, request CreateEdgeConfigRequest) (EdgeConfig, error)
+	GetEdgeConfig(ctx context.Context, id, teamID string) (EdgeConfig, error)
+	ListEdgeConfigs(ctx context.Context, teamID string) ([]EdgeConfig, error)
+	UpdateEdgeConfig(ctx context.Context, request UpdateEdgeConfigRequest) (EdgeConfig, error)
+	DeleteEdgeConfig(ctx context.Context, id, teamID string) error
+
+	UpsertEdgeConfigSchema(ctx context.Context, request EdgeConfigSchema) (EdgeConfigSchema, error)
+	GetEdgeConfigSchema(ctx context.Context, id, teamID string) (EdgeConfigSchema, error)
+	DeleteEdgeConfigSchema(ctx context.Context, id, teamID string) error
+
+	CreateEdgeConfigToken(ctx context.Context, request CreateEdgeConfigTokenRequest) (EdgeConfigToken, error)
+	GetEdgeConfigToken(ctx context.Context, request EdgeConfigTokenRequest) (EdgeConfigToken, error)
+	DeleteEdgeConfigToken(ctx context.Context, request EdgeConfigTokenRequest) error
+}
+
+// AliasesAPI defines the operations the Vercel API provides for managing the aliases of deployments.
+type AliasesAPI interface {
+	CreateAlias(ctx context.Context, request CreateAliasRequest, deploymentID string, teamID string) (AliasResponse, error)
+	GetAlias(ctx context.Context, alias, teamID string) (AliasResponse, error)
+	DeleteAlias(ctx context.Context, aliasUID string, teamID string) (DeleteAliasResponse, error)
+}
+
+// SharedEnvironmentVariablesAPI defines the operations the Vercel API provides for managing environment
+// variables that are shared between the projects of a team.
+type SharedEnvironmentVariablesAPI interface {
+	CreateSharedEnvironmentVariable(ctx context.Context, request CreateSharedEnvironmentVariableRequest) (SharedEnvironmentVariableResponse, error)
+	GetSharedEnvironmentVariable(ctx context.Context, teamID, envID string) (SharedEnvironmentVariableResponse, error)
+	ListSharedEnvironmentVariables(ctx context.Context, teamID string) ([]SharedEnvironmentVariableResponse, error)
+	UpdateSharedEnvironmentVariable(ctx context.Context, request UpdateSharedEnvironmentVariableRequest) (SharedEnvironmentVariableResponse, error)
+	DeleteSharedEnvironmentVariable(ctx context.Context, teamID, variableID string) error
+}
+
+// LogDrainsAPI defines the operations the Vercel API provides for managing Configurable Log Drains, along
+// with the code their endpoints must return to be verified.
+type LogDrainsAPI interface {
+	CreateLogDrain(ctx context.Context, request CreateLogDrainRequest) (LogDrain, error)
+	GetLogDrain(ctx context.Context, id, teamID string) (LogDrain, error)
+	DeleteLogDrain(ctx context.Context, id, teamID string) error
+	GetEndpointVerificationCode(ctx context.Context, teamID string) (string, error)
+}
+
+// WebhooksAPI defines the operations the Vercel API provides for managing webhooks.
+type WebhooksAPI interface {
+	CreateWebhook(ctx context.Context, request CreateWebhookRequest) (Webhook, error)
+	GetWebhook(ctx context.Context, id, teamID string) (Webhook, error)
+	DeleteWebhook(ctx context.Context, id, teamID string) error
+}
+
+// Ensure the Client implements each of the per-domain interfaces.
+var (
+	_ ProjectsAPI                   = &Client{}
+	_ TeamsAPI                      = &Client{}
+	_ DeploymentsAPI                = &Client{}
+	_ DNSAPI                        = &Client{}
+	_ CertificatesAPI               = &Client{}
+	_ DomainsAPI                    = &Client{}
+	_ FirewallAPI                   = &Client{}
+	_ EdgeConfigAPI                 = &Client{}
+	_ AliasesAPI                    = &Client{}
+	_ SharedEnvironmentVariablesAPI = &Client{}
+	_ LogDrainsAPI                  = &Client{}
+	_ WebhooksAPI                   = &Client{}
+)
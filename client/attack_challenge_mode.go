@@ -9,6 +9,11 @@ type AttackChallengeMode struct {
 	ProjectID string `json:"projectId"`
 	TeamID    string `json:"-"`
 	Enabled   bool   `json:"attackModeEnabled"`
+	// ActiveUntil is the time, in milliseconds since the epoch, that Attack Challenge Mode is automatically
+	// disabled at. If it is not set, Attack Challenge Mode stays enabled until it is disabled.
+	ActiveUntil *int64 `json:"attackModeActiveUntil,omitempty"`
+	// UpdatedAt is the time, in milliseconds since the epoch, that Attack Challenge Mode was last changed.
+	UpdatedAt int64 `json:"-"`
 }
 
 func (c *Client) GetAttackChallengeMode(ctx context.Context, projectID, teamID string) (a AttackChallengeMode, err error) {
@@ -16,15 +21,16 @@ func (c *Client) GetAttackChallengeMode(ctx context.Context, projectID, teamID s
 	if err != nil {
 		return a, err
 	}
-	var enabled bool
-	if project.Security != nil {
-		enabled = project.Security.AttackModeEnabled
-	}
-	return AttackChallengeMode{
+	a = AttackChallengeMode{
 		ProjectID: projectID,
 		TeamID:    teamID,
-		Enabled:   enabled,
-	}, err
+	}
+	if project.Security != nil {
+		a.Enabled = project.Security.AttackModeEnabled
+		a.UpdatedAt = project.Security.AttackModeUpdatedAt
+		a.ActiveUntil = project.Security.AttackModeActiveUntil
+	}
+	return a, err
 }
 
 func (c *Client) UpdateAttackChallengeMode(ctx context.Context, request AttackChallengeMode) (a AttackChallengeMode, err error) {
@@ -35,7 +41,8 @@ func (c *Client) UpdateAttackChallengeMode(ctx context.Context, request AttackCh
 
 	payload := string(mustMarshal(request))
 	var res struct {
-		AttackModeEnabled bool `json:"attackModeEnabled"`
+		AttackModeEnabled   bool  `json:"attackModeEnabled"`
+		AttackModeUpdatedAt int64 `json:"attackModeUpdatedAt"`
 	}
 	err = c.doRequest(clientRequest{
 		ctx:    ctx,
@@ -47,8 +54,10 @@ func (c *Client) UpdateAttackChallengeMode(ctx context.Context, request AttackCh
 		return a, err
 	}
 	return AttackChallengeMode{
-		ProjectID: request.ProjectID,
-		TeamID:    request.TeamID,
-		Enabled:   res.AttackModeEnabled,
+		ProjectID:   request.ProjectID,
+		TeamID:      request.TeamID,
+		Enabled:     res.AttackModeEnabled,
+		ActiveUntil: request.ActiveUntil,
+		UpdatedAt:   res.AttackModeUpdatedAt,
 	}, err
 }
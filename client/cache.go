@@ -0,0 +1,141 @@
+package client
+
+import (
+	"encoding/json"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// readCache caches the responses of cacheable GET requests for the lifetime of a Client, which in the context
+// of terraform is a single run. Concurrent requests for the same url are coalesced into a single request, and
+// cached responses are invalidated whenever a write is made to the same project or team.
+type readCache struct {
+	mu      sync.Mutex
+	entries map[string]*cacheEntry
+}
+
+// cacheEntry holds the response for a single url. The done channel is closed once the response is available,
+// so that any concurrent requests for the same url can wait for it rather than making their own request.
+type cacheEntry struct {
+	scope requestScope
+	done  chan struct{}
+	body  json.RawMessage
+	err   error
+}
+
+// requestScope is the team and project that a request relates to. It is used to work out which cached
+// responses need to be invalidated when a write is made, and which writes need to be serialized.
+type requestScope struct {
+	teamID    string
+	projectID string
+}
+
+func newReadCache() *readCache {
+	return &readCache{
+		entries: map[string]*cacheEntry{},
+	}
+}
+
+// scopeFor determines the team and project a request url relates to. The team comes from the `teamId` query
+// parameter, and the project from the path segment following `projects`, if there is one.
+func scopeFor(rawURL string) requestScope {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return requestScope{}
+	}
+	s := requestScope{
+		teamID: u.Query().Get("teamId"),
+		// Some endpoints, such as the firewall config, take the project as a query parameter.
+		projectID: u.Query().Get("projectId"),
+	}
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	for i, segment := range segments {
+		if segment == "projects" && i+1 < len(segments) {
+			s.projectID = segments[i+1]
+			break
+		}
+	}
+	return s
+}
+
+// affectedBy determines whether a cached response could be changed by a write to a given scope.
+// Projects can be referenced by name as well as by ID, and a name cannot be matched to an ID without
+// a request. So if either the cached read or the write is not keyed by a project ID, every project
+// in the team is conservatively treated as affected.
+func (s requestScope) affectedBy(write requestScope) bool {
+	if write.projectID == "" {
+		return true
+	}
+	if s.teamID != write.teamID {
+		return false
+	}
+	if s.projectID == "" || !isProjectID(s.projectID) || !isProjectID(write.projectID) {
+		return true
+	}
+	return s.projectID == write.projectID
+}
+
+func isProjectID(projectIDOrName string) bool {
+	return strings.HasPrefix(projectIDOrName, "prj_")
+}
+
+// do returns the cached response for a url, or calls fetch to request it. If a request for the same url is
+// already in progress, do waits for that request to complete and shares its response instead, unless the context
+// of the waiting request is cancelled first.
+func (rc *readCache) do(req clientRequest, v interface{}, fetch func(*json.RawMessage) error) error {
+	rc.mu.Lock()
+	entry, ok := rc.entries[req.url]
+	if !ok {
+		entry = &cacheEntry{
+			scope: scopeFor(req.url),
+			done:  make(chan struct{}),
+		}
+		rc.entries[req.url] = entry
+	}
+	rc.mu.Unlock()
+
+	if ok {
+		tflog.Trace(req.ctx, "using cached response", map[string]interface{}{
+			"url": req.url,
+		})
+		select {
+		case <-entry.done:
+		case <-req.ctx.Done():
+			return req.ctx.Err()
+		}
+	} else {
+		entry.err = fetch(&entry.body)
+		rc.mu.Lock()
+		// Errors are never cached, so that a subsequent request can try again.
+		if entry.err != nil && rc.entries[req.url] == entry {
+			delete(rc.entries, req.url)
+		}
+		rc.mu.Unlock()
+		close(entry.done)
+	}
+
+	if entry.err != nil {
+		return entry.err
+	}
+	if v == nil {
+		return nil
+	}
+	// Each caller unmarshals its own copy of the response, so callers are free to modify the result.
+	return json.Unmarshal(entry.body, v)
+}
+
+// invalidate removes any cached responses that could be affected by a write to a url. Any request that is
+// still in progress is also removed, so its response will be shared with existing waiters but not cached.
+func (rc *readCache) invalidate(rawURL string) {
+	write := scopeFor(rawURL)
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	for k, entry := range rc.entries {
+		if entry.scope.affectedBy(write) {
+			delete(rc.entries, k)
+		}
+	}
+}
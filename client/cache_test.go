@@ -0,0 +1,214 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newCachingTestServer returns a client with the read cache enabled, backed by a server that counts
+// the number of GET requests made for each path.
+func newCachingTestServer(t *testing.T, handler http.HandlerFunc) (*Client, map[string]*int64) {
+	counts := map[string]*int64{}
+	var mu sync.Mutex
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			mu.Lock()
+			if counts[r.URL.Path] == nil {
+				counts[r.URL.Path] = new(int64)
+			}
+			atomic.AddInt64(counts[r.URL.Path], 1)
+			mu.Unlock()
+		}
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	c := New("token").WithTeam(Team{ID: "team_123"}).WithReadCache()
+	c.baseURL = server.URL
+	return c, counts
+}
+
+func projectHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" {
+		fmt.Fprint(w, `{}`)
+		return
+	}
+	fmt.Fprintf(w, `{"id":"%s","name":"%s"}`, r.URL.Path[len("/v10/projects/"):], r.URL.Path)
+}
+
+func TestReadCacheCachesProjectReads(t *testing.T) {
+	c, counts := newCachingTestServer(t, projectHandler)
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		p, err := c.GetProject(ctx, "prj_1", "")
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if p.ID != "prj_1" || p.TeamID != "team_123" {
+			t.Errorf("unexpected project %v", p)
+		}
+		// Modifying the response must not affect later reads.
+		p.ID = "modified"
+	}
+	if n := *counts["/v10/projects/prj_1"]; n != 1 {
+		t.Errorf("expected 1 request, got %d", n)
+	}
+}
+
+func TestReadCacheCoalescesConcurrentReads(t *testing.T) {
+	release := make(chan struct{})
+	c, counts := newCachingTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		projectHandler(w, r)
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.GetProject(context.Background(), "prj_1", ""); err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		}()
+	}
+	// Give each goroutine a chance to start its request before the response is released.
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if n := *counts["/v10/projects/prj_1"]; n != 1 {
+		t.Errorf("expected 1 request, got %d", n)
+	}
+}
+
+func TestReadCacheInvalidatesOnWrite(t *testing.T) {
+	c, counts := newCachingTestServer(t, projectHandler)
+	ctx := context.Background()
+
+	read := func(projectID string) {
+		t.Helper()
+		if _, err := c.GetProject(ctx, projectID, ""); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+	read("prj_1")
+	read("prj_2")
+	read("my-project")
+
+	// A write to prj_1 should invalidate prj_1, and any project read by name, but not prj_2.
+	if _, err := c.UpdateProject(ctx, "prj_1", "", UpdateProjectRequest{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	read("prj_1")
+	read("prj_2")
+	read("my-project")
+
+	expected := map[string]int64{
+		"/v10/projects/prj_1":      2,
+		"/v10/projects/prj_2":      1,
+		"/v10/projects/my-project": 2,
+	}
+	for path, n := range expected {
+		if *counts[path] != n {
+			t.Errorf("expected %d requests for %s, got %d", n, path, *counts[path])
+		}
+	}
+}
+
+func TestReadCacheInvalidatesOnWriteByName(t *testing.T) {
+	c, counts := newCachingTestServer(t, projectHandler)
+	ctx := context.Background()
+
+	read := func(projectID string) {
+		t.Helper()
+		if _, err := c.GetProject(ctx, projectID, ""); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+	read("prj_1")
+	read("prj_2")
+
+	// The project a name refers to is not known, so a write by name invalidates every project in the team.
+	if _, err := c.UpdateProject(ctx, "my-project", "", UpdateProjectRequest{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	read("prj_1")
+	read("prj_2")
+
+	for _, path := range []string{"/v10/projects/prj_1", "/v10/projects/prj_2"} {
+		if *counts[path] != 2 {
+			t.Errorf("expected 2 requests for %s, got %d", path, *counts[path])
+		}
+	}
+}
+
+func TestReadCacheDoesNotCacheErrors(t *testing.T) {
+	var fail atomic.Bool
+	fail.Store(true)
+	c, counts := newCachingTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if fail.Load() {
+			w.WriteHeader(404)
+			fmt.Fprint(w, `{"error":{"code":"not_found","message":"Project not found"}}`)
+			return
+		}
+		projectHandler(w, r)
+	})
+	ctx := context.Background()
+
+	if _, err := c.GetProject(ctx, "prj_1", ""); !NotFound(err) {
+		t.Fatalf("expected a not found error, got %v", err)
+	}
+	fail.Store(false)
+	if _, err := c.GetProject(ctx, "prj_1", ""); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if n := *counts["/v10/projects/prj_1"]; n != 2 {
+		t.Errorf("expected 2 requests, got %d", n)
+	}
+}
+
+func TestReadCacheIgnoresUncacheableReads(t *testing.T) {
+	c, counts := newCachingTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":"dpl_1","readyState":"READY"}`)
+	})
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if _, err := c.GetDeployment(ctx, "dpl_1", ""); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+	if n := *counts["/v13/deployments/dpl_1"]; n != 2 {
+		t.Errorf("expected 2 requests, got %d", n)
+	}
+}
+
+func TestReadCacheWaitIsCancellable(t *testing.T) {
+	release := make(chan struct{})
+	c, _ := newCachingTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		projectHandler(w, r)
+	})
+	defer close(release)
+
+	// Start a read that doesn't complete until the test has finished, so that the next read waits for it.
+	go func() {
+		_, _ = c.GetProject(context.Background(), "prj_1", "")
+	}()
+	time.Sleep(20 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := c.GetProject(ctx, "prj_1", ""); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected a read waiting for a coalesced request to be cancelled, got %v", err)
+	}
+}
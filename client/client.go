@@ -3,29 +3,41 @@ package client
 import (
 	"context"
 	"net/http"
+	"sync"
 	"time"
 )
 
 // Client is an API wrapper, providing a high-level interface to the Vercel API.
 type Client struct {
-	token   string
-	client  *http.Client
-	team    Team
-	baseURL string
+	token    string
+	client   *http.Client
+	httpOnce sync.Once
+	team     Team
+	baseURL  string
+	cache    *readCache
+	locks    *projectLocks
 }
 
 func (c *Client) http() *http.Client {
-	if c.client == nil {
-		c.client = &http.Client{
-			// Hopefully it doesn't take more than 5 minutes
-			// to upload a single file for a deployment.
-			Timeout: 5 * 60 * time.Second,
+	// Requests are made concurrently, so the http client must only be created once.
+	c.httpOnce.Do(func() {
+		if c.client == nil {
+			c.client = newHTTPClient(nil)
 		}
-	}
+	})
 
 	return c.client
 }
 
+func newHTTPClient(transport http.RoundTripper) *http.Client {
+	return &http.Client{
+		// Hopefully it doesn't take more than 5 minutes
+		// to upload a single file for a deployment.
+		Timeout:   5 * 60 * time.Second,
+		Transport: transport,
+	}
+}
+
 // New creates a new instace of Client for a given API token.
 func New(token string) *Client {
 	return &Client{
@@ -39,6 +51,30 @@ func (c *Client) WithTeam(team Team) *Client {
 	return c
 }
 
+// WithTransport makes all requests to the Vercel API through the given transport, such as a Recorder.
+func (c *Client) WithTransport(transport http.RoundTripper) *Client {
+	c.client = newHTTPClient(transport)
+	return c
+}
+
+// WithReadCache enables caching of project and team reads for the lifetime of the client.
+// Concurrent identical reads are coalesced into a single request, and cached reads are
+// invalidated by any write made to the same project or team through the client.
+func (c *Client) WithReadCache() *Client {
+	c.cache = newReadCache()
+	return c
+}
+
+// WithSerializedProjectWrites ensures that only one write is made to any given project at a time.
+// This avoids conflicts when many changes are made to a single project in parallel. Reads, and
+// writes to different projects, are unaffected.
+// Projects are identified by the ID or name used in the request url, so a write made using a
+// project's name does not wait for a write made using its ID.
+func (c *Client) WithSerializedProjectWrites() *Client {
+	c.locks = newProjectLocks()
+	return c
+}
+
 func (c *Client) Team(ctx context.Context, teamID string) (Team, error) {
 	if teamID != "" {
 		return c.GetTeam(ctx, teamID)
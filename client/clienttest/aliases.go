@@ -0,0 +1,68 @@
+package clienttest
+
+import (
+	"context"
+	"sync"
+
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+// Aliases is an in-memory implementation of client.AliasesAPI.
+type Aliases struct {
+	mu      sync.Mutex
+	ids     ids
+	aliases map[string]client.AliasResponse
+}
+
+var _ client.AliasesAPI = &Aliases{}
+
+// NewAliases creates an empty in-memory client.AliasesAPI.
+func NewAliases() *Aliases {
+	return &Aliases{
+		aliases: map[string]client.AliasResponse{},
+	}
+}
+
+// get looks up an alias by either its UID or the alias itself, checking that it belongs to the given team.
+// The lock must already be held.
+func (a *Aliases) get(uidOrAlias, teamID string) (client.AliasResponse, error) {
+	for _, alias := range a.aliases {
+		if (alias.UID == uidOrAlias || alias.Alias == uidOrAlias) && alias.TeamID == teamID {
+			return alias, nil
+		}
+	}
+	return client.AliasResponse{}, notFound("Alias %s not found", uidOrAlias)
+}
+
+func (a *Aliases) CreateAlias(_ context.Context, request client.CreateAliasRequest, deploymentID string, teamID string) (client.AliasResponse, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if _, err := a.get(request.Alias, teamID); err == nil {
+		return client.AliasResponse{}, conflict("The alias %s is already in use", request.Alias)
+	}
+	alias := client.AliasResponse{
+		UID:          a.ids.new("alias"),
+		Alias:        request.Alias,
+		DeploymentID: deploymentID,
+		TeamID:       teamID,
+	}
+	a.aliases[alias.UID] = alias
+	return alias, nil
+}
+
+func (a *Aliases) GetAlias(_ context.Context, alias, teamID string) (client.AliasResponse, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.get(alias, teamID)
+}
+
+func (a *Aliases) DeleteAlias(_ context.Context, aliasUID string, teamID string) (client.DeleteAliasResponse, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	alias, ok := a.aliases[aliasUID]
+	if !ok || alias.TeamID != teamID {
+		return client.DeleteAliasResponse{}, notFound("Alias %s not found", aliasUID)
+	}
+	delete(a.aliases, aliasUID)
+	return client.DeleteAliasResponse{Status: "SUCCESS"}, nil
+}
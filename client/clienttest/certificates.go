@@ -0,0 +1,68 @@
+package clienttest
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/vercel/terraform-provider-vercel/certificate"
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+// Certificates is an in-memory implementation of client.CertificatesAPI.
+type Certificates struct {
+	mu           sync.Mutex
+	ids          ids
+	certificates map[string]client.CustomCertificate
+}
+
+var _ client.CertificatesAPI = &Certificates{}
+
+// NewCertificates creates an empty in-memory client.CertificatesAPI.
+func NewCertificates() *Certificates {
+	return &Certificates{
+		certificates: map[string]client.CustomCertificate{},
+	}
+}
+
+func (c *Certificates) UploadCustomCertificate(_ context.Context, request client.UploadCustomCertificateRequest) (client.CustomCertificate, error) {
+	if err := certificate.Validate(request.Certificate, request.PrivateKey, request.CA); err != nil {
+		return client.CustomCertificate{}, badRequest("Invalid certificate: %s", err)
+	}
+	parsed, err := certificate.Parse(request.Certificate)
+	if err != nil {
+		return client.CustomCertificate{}, badRequest("Invalid certificate: %s", err)
+	}
+	cert := client.CustomCertificate{
+		ID:        c.ids.new("cert"),
+		TeamID:    request.TeamID,
+		Domains:   parsed.Domains,
+		CreatedAt: time.Now().UnixMilli(),
+		ExpiresAt: parsed.NotAfter.UnixMilli(),
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.certificates[cert.ID] = cert
+	return cert, nil
+}
+
+func (c *Certificates) GetCustomCertificate(_ context.Context, id, teamID string) (client.CustomCertificate, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	cert, ok := c.certificates[id]
+	if !ok || cert.TeamID != teamID {
+		return client.CustomCertificate{}, notFound("Certificate %s not found", id)
+	}
+	return cert, nil
+}
+
+func (c *Certificates) DeleteCustomCertificate(_ context.Context, id, teamID string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	cert, ok := c.certificates[id]
+	if !ok || cert.TeamID != teamID {
+		return notFound("Certificate %s not found", id)
+	}
+	delete(c.certificates, id)
+	return nil
+}
@@ -0,0 +1,53 @@
+// Package clienttest provides in-memory implementations of the per-domain interfaces exposed by the client
+// package, such as client.ProjectsAPI and client.DNSAPI. These allow code built on top of the Vercel client
+// to be tested without making any HTTP requests.
+//
+// The implementations aim to mirror the behaviour of the Vercel API closely enough for tests: entities are
+// given IDs when they are created, conflicting creates return a 409 client.APIError, and requests for
+// entities that do not exist return a 404 client.APIError, so that client.NotFound and client.Conflict
+// behave as they would against the real API.
+package clienttest
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+// ids generates unique, prefixed IDs in the same style as the Vercel API, e.g. `prj_1`.
+type ids struct {
+	mu   sync.Mutex
+	next int
+}
+
+func (i *ids) new(prefix string) string {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.next++
+	return fmt.Sprintf("%s_%d", prefix, i.next)
+}
+
+func notFound(format string, args ...interface{}) error {
+	return client.APIError{
+		Code:       "not_found",
+		Message:    fmt.Sprintf(format, args...),
+		StatusCode: 404,
+	}
+}
+
+func conflict(format string, args ...interface{}) error {
+	return client.APIError{
+		Code:       "conflict",
+		Message:    fmt.Sprintf(format, args...),
+		StatusCode: 409,
+	}
+}
+
+func badRequest(format string, args ...interface{}) error {
+	return client.APIError{
+		Code:       "bad_request",
+		Message:    fmt.Sprintf(format, args...),
+		StatusCode: 400,
+	}
+}
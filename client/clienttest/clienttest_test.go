@@ -0,0 +1,491 @@
+package clienttest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+func TestProjects(t *testing.T) {
+	ctx := context.Background()
+	p := NewProjects()
+
+	project, err := p.CreateProject(ctx, "team_a", client.CreateProjectRequest{Name: "example"})
+	if err != nil {
+		t.Fatalf("unexpected error creating project: %s", err)
+	}
+	if _, err := p.CreateProject(ctx, "team_a", client.CreateProjectRequest{Name: "example"}); !client.Conflict(err) {
+		t.Errorf("expected a conflict creating a duplicate project, got %v", err)
+	}
+	if _, err := p.CreateProject(ctx, "team_b", client.CreateProjectRequest{Name: "example"}); err != nil {
+		t.Errorf("expected project names to be scoped to a team, got %s", err)
+	}
+
+	got, err := p.GetProject(ctx, "example", "team_a")
+	if err != nil {
+		t.Fatalf("unexpected error getting project by name: %s", err)
+	}
+	if got.ID != project.ID {
+		t.Errorf("expected project %s, got %s", project.ID, got.ID)
+	}
+	if _, err := p.GetProject(ctx, project.ID, "team_b"); !client.NotFound(err) {
+		t.Errorf("expected project in another team to be not found, got %v", err)
+	}
+
+	env := client.EnvironmentVariableRequest{Key: "FOO", Value: "bar", Target: []string{"production", "preview"}, Type: "encrypted"}
+	if _, err := p.CreateEnvironmentVariable(ctx, client.CreateEnvironmentVariableRequest{
+		EnvironmentVariable: env,
+		ProjectID:           project.ID,
+		TeamID:              "team_a",
+	}); err != nil {
+		t.Fatalf("unexpected error creating environment variable: %s", err)
+	}
+	env.Target = []string{"preview"}
+	if _, err := p.CreateEnvironmentVariable(ctx, client.CreateEnvironmentVariableRequest{
+		EnvironmentVariable: env,
+		ProjectID:           project.ID,
+		TeamID:              "team_a",
+	}); !client.Conflict(err) {
+		t.Errorf("expected a conflict creating an overlapping environment variable, got %v", err)
+	}
+	env.Target = []string{"development"}
+	if _, err := p.CreateEnvironmentVariable(ctx, client.CreateEnvironmentVariableRequest{
+		EnvironmentVariable: env,
+		ProjectID:           project.ID,
+		TeamID:              "team_a",
+	}); err != nil {
+		t.Errorf("unexpected error creating a non-overlapping environment variable: %s", err)
+	}
+
+	if err := p.DeleteProject(ctx, project.ID, "team_a"); err != nil {
+		t.Fatalf("unexpected error deleting project: %s", err)
+	}
+	if _, err := p.GetEnvironmentVariables(ctx, project.ID, "team_a"); !client.NotFound(err) {
+		t.Errorf("expected environment variables of a deleted project to be not found, got %v", err)
+	}
+}
+
+func TestProjectsGitRepository(t *testing.T) {
+	ctx := context.Background()
+	p := NewProjects()
+
+	project, err := p.CreateProject(ctx, "", client.CreateProjectRequest{Name: "example"})
+	if err != nil {
+		t.Fatalf("unexpected error creating project: %s", err)
+	}
+	if _, err := p.CreateDeployHook(ctx, client.CreateDeployHookRequest{ProjectID: project.ID, Name: "hook", Ref: "main"}); !client.Validation(err) {
+		t.Errorf("expected a validation error creating a deploy hook without a git repository, got %v", err)
+	}
+
+	project, err = p.LinkGitRepoToProject(ctx, client.LinkGitRepoToProjectRequest{ProjectID: project.ID, Type: "github", Repo: "vercel/next.js"})
+	if err != nil {
+		t.Fatalf("unexpected error linking git repository: %s", err)
+	}
+	if project.Link == nil || project.Link.Org != "vercel" || project.Link.Repo != "next.js" {
+		t.Errorf("expected project to be linked to vercel/next.js, got %+v", project.Link)
+	}
+
+	hook, err := p.CreateDeployHook(ctx, client.CreateDeployHookRequest{ProjectID: project.ID, Name: "hook", Ref: "main"})
+	if err != nil {
+		t.Fatalf("unexpected error creating deploy hook: %s", err)
+	}
+	if err := p.DeleteDeployHook(ctx, client.DeleteDeployHookRequest{ProjectID: project.ID, ID: hook.ID}); err != nil {
+		t.Fatalf("unexpected error deleting deploy hook: %s", err)
+	}
+	project, _ = p.GetProject(ctx, project.ID, "")
+	if len(project.Link.DeployHooks) != 0 {
+		t.Errorf("expected deploy hook to be deleted, got %+v", project.Link.DeployHooks)
+	}
+}
+
+func TestDNS(t *testing.T) {
+	ctx := context.Background()
+	d := NewDNS()
+
+	record, err := d.CreateDNSRecord(ctx, "team_a", client.CreateDNSRecordRequest{
+		Domain:     "example.com",
+		Name:       "",
+		Type:       "MX",
+		Value:      "mail.example.com.",
+		MXPriority: 10,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error creating dns record: %s", err)
+	}
+	if record.Value != "10 mail.example.com." {
+		t.Errorf("expected MX value to include the priority, got %q", record.Value)
+	}
+	if err := d.DeleteDNSRecord(ctx, "example.com", record.ID, "team_a"); err != nil {
+		t.Fatalf("unexpected error deleting dns record: %s", err)
+	}
+	if _, err := d.GetDNSRecord(ctx, record.ID, "team_a"); !client.NotFound(err) {
+		t.Errorf("expected deleted dns record to be not found, got %v", err)
+	}
+}
+
+func TestDomains(t *testing.T) {
+	ctx := context.Background()
+	d := NewDomains()
+
+	domain, err := d.CreateDomain(ctx, "team_a", client.CreateDomainRequest{Name: "Example.com", Method: "add"})
+	if err != nil {
+		t.Fatalf("unexpected error adding domain: %s", err)
+	}
+	if domain.Name != "example.com" || domain.Verified {
+		t.Errorf("expected an unverified, lowercase domain, got %+v", domain)
+	}
+	if _, err := d.CreateDomain(ctx, "team_a", client.CreateDomainRequest{Name: "example.com", Method: "add"}); !client.Conflict(err) {
+		t.Errorf("expected adding a duplicate domain to conflict, got %v", err)
+	}
+	if _, err := d.VerifyDomain(ctx, "example.com", "team_a"); err == nil {
+		t.Error("expected verification to fail for a domain that is not verifiable")
+	}
+	renew := true
+	if err := d.UpdateDomain(ctx, "example.com", "team_a", client.UpdateDomainRequest{Renew: &renew}); err == nil {
+		t.Error("expected renewal to be rejected for an external domain")
+	}
+
+	d.Verifiable["example.com"] = true
+	if domain, err = d.VerifyDomain(ctx, "example.com", "team_a"); err != nil || !domain.Verified {
+		t.Errorf("expected domain to be verified, got %+v, %v", domain, err)
+	}
+	config, err := d.GetDomainConfig(ctx, "example.com", "", "team_a")
+	if err != nil {
+		t.Fatalf("unexpected error getting domain config: %s", err)
+	}
+	if config.Misconfigured {
+		t.Errorf("expected a verified domain to be configured, got %+v", config)
+	}
+
+	if _, err := d.CreateDomain(ctx, "team_a", client.CreateDomainRequest{Name: "transfer.com", Method: "transfer-in"}); err == nil {
+		t.Error("expected a transfer without an auth code to fail")
+	}
+	if err := d.DeleteDomain(ctx, "example.com", "team_a"); err != nil {
+		t.Fatalf("unexpected error deleting domain: %s", err)
+	}
+	if _, err := d.GetDomain(ctx, "example.com", "team_a"); !client.NotFound(err) {
+		t.Errorf("expected deleted domain to be not found, got %v", err)
+	}
+}
+
+func TestFirewall(t *testing.T) {
+	ctx := context.Background()
+	f := NewFirewall()
+
+	for _, name := range []string{"first", "second", "third"} {
+		err := f.PatchFirewallConfig(ctx, client.PatchFirewallConfigRequest{
+			ProjectID: "prj_1",
+			TeamID:    "team_a",
+			Action:    "rules.insert",
+			Value:     client.FirewallRule{Name: name, Active: true},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error inserting rule: %s", err)
+		}
+	}
+	cfg, err := f.GetFirewallConfig(ctx, "prj_1", "team_a")
+	if err != nil {
+		t.Fatalf("unexpected error getting firewall config: %s", err)
+	}
+	if len(cfg.Rules) != 3 || cfg.Rules[0].ID == "" {
+		t.Fatalf("expected three rules with IDs, got %+v", cfg.Rules)
+	}
+
+	err = f.PatchFirewallConfig(ctx, client.PatchFirewallConfigRequest{
+		ProjectID: "prj_1",
+		TeamID:    "team_a",
+		Action:    "rules.priority",
+		ID:        cfg.Rules[2].ID,
+		Value:     0,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error changing rule priority: %s", err)
+	}
+	cfg, _ = f.GetFirewallConfig(ctx, "prj_1", "team_a")
+	if names := []string{cfg.Rules[0].Name, cfg.Rules[1].Name, cfg.Rules[2].Name}; names[0] != "third" || names[1] != "first" || names[2] != "second" {
+		t.Errorf("expected rule to be moved to the front, got %v", names)
+	}
+
+	err = f.PatchFirewallConfig(ctx, client.PatchFirewallConfigRequest{
+		ProjectID: "prj_1",
+		TeamID:    "team_a",
+		Action:    "rules.remove",
+		ID:        "rule_missing",
+	})
+	if !client.NotFound(err) {
+		t.Errorf("expected removing a missing rule to be not found, got %v", err)
+	}
+
+	ipRule := client.IPRule{Hostname: "example.com", IP: "10.0.0.0/8", Action: "deny"}
+	if err := f.PatchFirewallConfig(ctx, client.PatchFirewallConfigRequest{ProjectID: "prj_1", TeamID: "team_a", Action: "ip.insert", Value: ipRule}); err != nil {
+		t.Fatalf("unexpected error inserting ip rule: %s", err)
+	}
+	if err := f.PatchFirewallConfig(ctx, client.PatchFirewallConfigRequest{ProjectID: "prj_1", TeamID: "team_a", Action: "ip.insert", Value: ipRule}); !client.Conflict(err) {
+		t.Errorf("expected inserting a duplicate ip rule to conflict, got %v", err)
+	}
+}
+
+func TestFirewallBypass(t *testing.T) {
+	ctx := context.Background()
+	f := NewFirewall()
+
+	request := client.FirewallBypassRequest{ProjectID: "prj_1", TeamID: "team_a", SourceIP: "10.0.0.0/8", ProjectScope: true}
+	bypass, err := f.CreateFirewallBypass(ctx, request)
+	if err != nil {
+		t.Fatalf("unexpected error creating firewall bypass: %s", err)
+	}
+	if !bypass.IsProjectRule || bypass.ID == "" {
+		t.Errorf("expected a project wide bypass with an ID, got %+v", bypass)
+	}
+	domainRequest := client.FirewallBypassRequest{ProjectID: "prj_1", TeamID: "team_a", SourceIP: "10.0.0.0/8", Domain: "example.com"}
+	if _, err := f.GetFirewallBypass(ctx, domainRequest); !client.NotFound(err) {
+		t.Errorf("expected a bypass for a single domain to be not found, got %v", err)
+	}
+	if _, err := f.GetFirewallBypass(ctx, request); err != nil {
+		t.Errorf("unexpected error getting firewall bypass: %s", err)
+	}
+	if err := f.DeleteFirewallBypass(ctx, request); err != nil {
+		t.Fatalf("unexpected error deleting firewall bypass: %s", err)
+	}
+	if _, err := f.GetFirewallBypass(ctx, request); !client.NotFound(err) {
+		t.Errorf("expected deleted bypass to be not found, got %v", err)
+	}
+}
+
+func TestTeamMembers(t *testing.T) {
+	ctx := context.Background()
+	teams := NewTeams(client.Team{ID: "team_a"})
+	teams.AddUser("user_1", "jane@example.com", "jane")
+
+	invited, err := teams.InviteTeamMember(ctx, client.InviteTeamMemberRequest{Email: "Jane@example.com", Role: "DEVELOPER"})
+	if err != nil {
+		t.Fatalf("unexpected error inviting team member: %s", err)
+	}
+	if invited.UserID != "user_1" || invited.TeamID != "team_a" || invited.Confirmed {
+		t.Errorf("expected an unconfirmed member of the default team with the user's ID, got %+v", invited)
+	}
+	if _, err := teams.InviteTeamMember(ctx, client.InviteTeamMemberRequest{UserID: "user_1", Role: "MEMBER"}); !client.Conflict(err) {
+		t.Errorf("expected a conflict inviting an existing member, got %v", err)
+	}
+	if _, err := teams.InviteTeamMember(ctx, client.InviteTeamMemberRequest{UserID: "user_2", Role: "MEMBER"}); !client.Validation(err) {
+		t.Errorf("expected a validation error inviting an unknown user, got %v", err)
+	}
+
+	pending, err := teams.InviteTeamMember(ctx, client.InviteTeamMemberRequest{Email: "new@example.com", Role: "VIEWER"})
+	if err != nil {
+		t.Fatalf("unexpected error inviting an email address: %s", err)
+	}
+	if pending.UserID != "" || pending.InviteID == "" {
+		t.Errorf("expected a pending invitation without a user ID, got %+v", pending)
+	}
+
+	if err := teams.UpdateTeamMember(ctx, client.UpdateTeamMemberRequest{UserID: "user_1", Role: "OWNER"}); err != nil {
+		t.Fatalf("unexpected error updating team member: %s", err)
+	}
+	got, err := teams.GetTeamMember(ctx, client.TeamMemberRequest{TeamID: "team_a", UserID: "user_1"})
+	if err != nil || got.Role != "OWNER" {
+		t.Errorf("expected the member's role to be updated, got %+v, %v", got, err)
+	}
+
+	if err := teams.RemoveTeamMember(ctx, pending); err != nil {
+		t.Fatalf("unexpected error revoking invitation: %s", err)
+	}
+	if _, err := teams.GetTeamMember(ctx, client.TeamMemberRequest{Email: "new@example.com"}); !client.NotFound(err) {
+		t.Errorf("expected a revoked invitation to be not found, got %v", err)
+	}
+	members, _ := teams.ListTeamMembers(ctx, "team_a")
+	if len(members) != 1 {
+		t.Errorf("expected a single remaining member, got %+v", members)
+	}
+}
+
+func TestTeamsUpdate(t *testing.T) {
+	ctx := context.Background()
+	teams := NewTeams(client.Team{ID: "team_a"})
+	teams.AddTeam(client.Team{ID: "team_a"}, "alpha")
+	teams.AddTeam(client.Team{ID: "team_b"}, "beta")
+
+	policy := "on"
+	updated, err := teams.UpdateTeam(ctx, client.UpdateTeamRequest{TeamID: "alpha", Slug: "gamma", SensitiveEnvironmentVariablePolicy: &policy})
+	if err != nil {
+		t.Fatalf("unexpected error updating team: %s", err)
+	}
+	if updated.ID != "team_a" || updated.Slug != "gamma" || *updated.SensitiveEnvironmentVariablePolicy != "on" {
+		t.Errorf("expected the team's slug and policy to be updated, got %+v", updated)
+	}
+	if _, err := teams.GetTeam(ctx, "alpha"); !client.NotFound(err) {
+		t.Errorf("expected the previous slug to be not found, got %v", err)
+	}
+	if got, err := teams.Team(ctx, ""); err != nil || got.Slug != "gamma" {
+		t.Errorf("expected the default team to be updated, got %+v, %v", got, err)
+	}
+	if _, err := teams.UpdateTeam(ctx, client.UpdateTeamRequest{TeamID: "team_a", Slug: "beta"}); !client.Conflict(err) {
+		t.Errorf("expected a conflict using the slug of another team, got %v", err)
+	}
+}
+
+func TestProjectsSecurityAndSettings(t *testing.T) {
+	ctx := context.Background()
+	p := NewProjects()
+	project, err := p.CreateProject(ctx, "team_a", client.CreateProjectRequest{Name: "example"})
+	if err != nil {
+		t.Fatalf("unexpected error creating project: %s", err)
+	}
+
+	until := int64(1700000000000)
+	if _, err := p.UpdateAttackChallengeMode(ctx, client.AttackChallengeMode{ProjectID: project.ID, TeamID: "team_a", Enabled: true, ActiveUntil: &until}); err != nil {
+		t.Fatalf("unexpected error enabling attack challenge mode: %s", err)
+	}
+	mode, err := p.GetAttackChallengeMode(ctx, project.ID, "team_a")
+	if err != nil || !mode.Enabled || mode.ActiveUntil == nil || *mode.ActiveUntil != until {
+		t.Errorf("expected attack challenge mode to be enabled until %d, got %+v, %v", until, mode, err)
+	}
+	if _, err := p.GetAttackChallengeMode(ctx, project.ID, "team_b"); !client.NotFound(err) {
+		t.Errorf("expected attack challenge mode of a project in another team to be not found, got %v", err)
+	}
+
+	retention, err := p.UpdateDeploymentRetention(ctx, client.UpdateDeploymentRetentionRequest{
+		ProjectID:           project.ID,
+		TeamID:              "team_a",
+		DeploymentRetention: client.DeploymentRetentionRequest{ExpirationPreview: "1w"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error updating deployment retention: %s", err)
+	}
+	if retention.ExpirationPreview != 7 || retention.ExpirationProduction != 36500 {
+		t.Errorf("expected only the preview retention to change, got %+v", retention)
+	}
+	if err := p.DeleteDeploymentRetention(ctx, project.ID, "team_a"); err != nil {
+		t.Fatalf("unexpected error deleting deployment retention: %s", err)
+	}
+	if retention, _ := p.GetDeploymentRetention(ctx, project.ID, "team_a"); retention.ExpirationPreview != 36500 {
+		t.Errorf("expected deleted deployment retention to be unlimited, got %+v", retention)
+	}
+
+	if cpu, _ := p.GetProjectFunctionCPU(ctx, project.ID, "team_a"); cpu.CPU != nil {
+		t.Errorf("expected no function cpu to be set, got %s", *cpu.CPU)
+	}
+	if _, err := p.UpdateProjectFunctionCPU(ctx, client.ProjectFunctionCPURequest{ProjectID: project.ID, TeamID: "team_a", CPU: "huge"}); !client.Validation(err) {
+		t.Errorf("expected a validation error for an unknown function cpu, got %v", err)
+	}
+	if _, err := p.UpdateProjectFunctionCPU(ctx, client.ProjectFunctionCPURequest{ProjectID: project.ID, TeamID: "team_a", CPU: "performance"}); err != nil {
+		t.Fatalf("unexpected error updating function cpu: %s", err)
+	}
+	if cpu, _ := p.GetProjectFunctionCPU(ctx, project.ID, "team_a"); cpu.CPU == nil || *cpu.CPU != "performance" {
+		t.Errorf("expected function cpu to be performance, got %v", cpu.CPU)
+	}
+}
+
+func TestAliases(t *testing.T) {
+	ctx := context.Background()
+	a := NewAliases()
+
+	alias, err := a.CreateAlias(ctx, client.CreateAliasRequest{Alias: "example.vercel.app"}, "dpl_1", "team_a")
+	if err != nil {
+		t.Fatalf("unexpected error creating alias: %s", err)
+	}
+	if _, err := a.CreateAlias(ctx, client.CreateAliasRequest{Alias: "example.vercel.app"}, "dpl_2", "team_a"); !client.Conflict(err) {
+		t.Errorf("expected a conflict creating a duplicate alias, got %v", err)
+	}
+	if got, err := a.GetAlias(ctx, "example.vercel.app", "team_a"); err != nil || got.UID != alias.UID {
+		t.Errorf("expected alias %s to be found by name, got %+v, %v", alias.UID, got, err)
+	}
+	if _, err := a.DeleteAlias(ctx, alias.UID, "team_b"); !client.NotFound(err) {
+		t.Errorf("expected an alias in another team to be not found, got %v", err)
+	}
+	if _, err := a.DeleteAlias(ctx, alias.UID, "team_a"); err != nil {
+		t.Fatalf("unexpected error deleting alias: %s", err)
+	}
+	if _, err := a.GetAlias(ctx, alias.UID, "team_a"); !client.NotFound(err) {
+		t.Errorf("expected a deleted alias to be not found, got %v", err)
+	}
+}
+
+func TestSharedEnvironmentVariables(t *testing.T) {
+	ctx := context.Background()
+	s := NewSharedEnvironmentVariables()
+
+	request := client.CreateSharedEnvironmentVariableRequest{
+		TeamID: "team_a",
+		EnvironmentVariable: client.SharedEnvironmentVariableRequest{
+			Type:                 "encrypted",
+			Target:               []string{"production", "preview"},
+			EnvironmentVariables: []client.SharedEnvVarRequest{{Key: "FOO", Value: "bar"}},
+		},
+	}
+	env, err := s.CreateSharedEnvironmentVariable(ctx, request)
+	if err != nil {
+		t.Fatalf("unexpected error creating shared environment variable: %s", err)
+	}
+	request.EnvironmentVariable.Target = []string{"preview"}
+	if _, err := s.CreateSharedEnvironmentVariable(ctx, request); !client.Conflict(err) {
+		t.Errorf("expected a conflict creating an overlapping shared environment variable, got %v", err)
+	}
+
+	updated, err := s.UpdateSharedEnvironmentVariable(ctx, client.UpdateSharedEnvironmentVariableRequest{
+		EnvID:      env.ID,
+		TeamID:     "team_a",
+		Type:       "encrypted",
+		Target:     []string{"production"},
+		ProjectIDs: []string{"prj_1"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error updating shared environment variable: %s", err)
+	}
+	if updated.Value != "bar" || len(updated.ProjectIDs) != 1 {
+		t.Errorf("expected the value to be kept and the project to be linked, got %+v", updated)
+	}
+	if envs, _ := s.ListSharedEnvironmentVariables(ctx, "team_b"); len(envs) != 0 {
+		t.Errorf("expected no shared environment variables in another team, got %+v", envs)
+	}
+	if err := s.DeleteSharedEnvironmentVariable(ctx, "team_a", env.ID); err != nil {
+		t.Fatalf("unexpected error deleting shared environment variable: %s", err)
+	}
+	if _, err := s.GetSharedEnvironmentVariable(ctx, "team_a", env.ID); !client.NotFound(err) {
+		t.Errorf("expected a deleted shared environment variable to be not found, got %v", err)
+	}
+}
+
+func TestLogDrainsAndWebhooks(t *testing.T) {
+	ctx := context.Background()
+	l := NewLogDrains()
+
+	if _, err := l.CreateLogDrain(ctx, client.CreateLogDrainRequest{TeamID: "team_a", Endpoint: "https://example.com"}); !client.Validation(err) {
+		t.Errorf("expected a validation error creating a log drain without sources, got %v", err)
+	}
+	drain, err := l.CreateLogDrain(ctx, client.CreateLogDrainRequest{
+		TeamID:       "team_a",
+		Endpoint:     "https://example.com",
+		Environments: []string{"production"},
+		Sources:      []string{"static"},
+		SamplingRate: 0.5,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error creating log drain: %s", err)
+	}
+	if got, err := l.GetLogDrain(ctx, drain.ID, "team_a"); err != nil || got.SamplingRate == nil || *got.SamplingRate != 0.5 {
+		t.Errorf("expected the log drain to be read back with its sampling rate, got %+v, %v", got, err)
+	}
+	if err := l.DeleteLogDrain(ctx, drain.ID, "team_a"); err != nil {
+		t.Fatalf("unexpected error deleting log drain: %s", err)
+	}
+	if _, err := l.GetLogDrain(ctx, drain.ID, "team_a"); !client.NotFound(err) {
+		t.Errorf("expected a deleted log drain to be not found, got %v", err)
+	}
+
+	w := NewWebhooks()
+	hook, err := w.CreateWebhook(ctx, client.CreateWebhookRequest{TeamID: "team_a", Endpoint: "https://example.com", Events: []string{"deployment.created"}})
+	if err != nil {
+		t.Fatalf("unexpected error creating webhook: %s", err)
+	}
+	if hook.Secret == "" {
+		t.Errorf("expected the secret to be returned when the webhook is created")
+	}
+	if got, err := w.GetWebhook(ctx, hook.ID, "team_a"); err != nil || got.Secret != "" {
+		t.Errorf("expected the webhook to be read back without its secret, got %+v, %v", got, err)
+	}
+	if err := w.DeleteWebhook(ctx, hook.ID, "team_b"); !client.NotFound(err) {
+		t.Errorf("expected a webhook in another team to be not found, got %v", err)
+	}
+}
@@ -0,0 +1,109 @@
+package clienttest
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+// Deployments is an in-memory implementation of client.DeploymentsAPI. Deployments are completed as soon as
+// they are created, as long as every file they reference has been uploaded.
+type Deployments struct {
+	mu          sync.Mutex
+	ids         ids
+	files       map[string]string
+	deployments map[string]client.DeploymentResponse
+}
+
+var _ client.DeploymentsAPI = &Deployments{}
+
+// NewDeployments creates an empty in-memory client.DeploymentsAPI.
+func NewDeployments() *Deployments {
+	return &Deployments{
+		files:       map[string]string{},
+		deployments: map[string]client.DeploymentResponse{},
+	}
+}
+
+// File returns the content of an uploaded file by its SHA, and whether it has been uploaded.
+func (d *Deployments) File(sha string) (string, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	content, ok := d.files[sha]
+	return content, ok
+}
+
+func (d *Deployments) CreateFile(_ context.Context, request client.CreateFileRequest) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.files[request.SHA] = request.Content
+	return nil
+}
+
+func (d *Deployments) CreateDeployment(_ context.Context, request client.CreateDeploymentRequest, teamID string) (client.DeploymentResponse, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var missing []string
+	for _, f := range request.Files {
+		if _, ok := d.files[f.Sha]; !ok {
+			missing = append(missing, f.Sha)
+		}
+	}
+	if len(missing) > 0 {
+		return client.DeploymentResponse{}, client.MissingFilesError{
+			Code:    "missing_files",
+			Message: "Missing files",
+			Missing: missing,
+		}
+	}
+
+	id := d.ids.new("dpl")
+	r := client.DeploymentResponse{
+		AliasAssigned: true,
+		ID:            id,
+		ProjectID:     request.ProjectID,
+		TeamID:        teamID,
+		ReadyState:    "READY",
+		URL:           fmt.Sprintf("%s-%s.vercel.app", request.ProjectID, id),
+	}
+	r.Aliases = []string{r.URL}
+	if request.Target != "" {
+		target := request.Target
+		r.Target = &target
+	}
+	// Only the names of environment variables are returned by the API.
+	for k := range request.Environment {
+		r.Build.Environment = append(r.Build.Environment, k)
+	}
+	sort.Strings(r.Build.Environment)
+	d.deployments[id] = r
+	return r, nil
+}
+
+func (d *Deployments) GetDeployment(_ context.Context, deploymentID, teamID string) (client.DeploymentResponse, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	r, ok := d.deployments[deploymentID]
+	if !ok || r.TeamID != teamID {
+		return client.DeploymentResponse{}, notFound("Deployment %s not found", deploymentID)
+	}
+	return r, nil
+}
+
+func (d *Deployments) DeleteDeployment(_ context.Context, deploymentID string, teamID string) (client.DeleteDeploymentResponse, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	r, ok := d.deployments[deploymentID]
+	if !ok || r.TeamID != teamID {
+		return client.DeleteDeploymentResponse{}, notFound("Deployment %s not found", deploymentID)
+	}
+	delete(d.deployments, deploymentID)
+	return client.DeleteDeploymentResponse{
+		State: "DELETED",
+		UID:   deploymentID,
+	}, nil
+}
@@ -0,0 +1,174 @@
+package clienttest
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+// DNS is an in-memory implementation of client.DNSAPI.
+type DNS struct {
+	mu      sync.Mutex
+	ids     ids
+	records map[string]client.DNSRecord
+}
+
+var _ client.DNSAPI = &DNS{}
+
+// NewDNS creates an empty in-memory client.DNSAPI.
+func NewDNS() *DNS {
+	return &DNS{
+		records: map[string]client.DNSRecord{},
+	}
+}
+
+// dnsValue builds the value of a record in the same format the Vercel API returns it, where MX, SRV and
+// HTTPS records combine their value with their priority and other fields.
+func dnsValue(recordType, value string, mxPriority int64, srv *client.SRV, https *client.HTTPS) string {
+	switch recordType {
+	case "MX":
+		return fmt.Sprintf("%d %s", mxPriority, value)
+	case "SRV":
+		if srv == nil {
+			return ""
+		}
+		return fmt.Sprintf("%d %d %d %s.", srv.Priority, srv.Weight, srv.Port, srv.Target)
+	case "HTTPS":
+		if https == nil {
+			return ""
+		}
+		return strings.TrimSpace(fmt.Sprintf("%d %s. %s", https.Priority, strings.TrimSuffix(https.Target, "."), https.Params))
+	}
+	return value
+}
+
+func (d *DNS) CreateDNSRecord(_ context.Context, teamID string, request client.CreateDNSRecordRequest) (client.DNSRecord, error) {
+	if request.Type == "SRV" && request.SRV == nil {
+		return client.DNSRecord{}, badRequest("SRV records require an srv block")
+	}
+	if request.Type == "HTTPS" && request.HTTPS == nil {
+		return client.DNSRecord{}, badRequest("HTTPS records require an https block")
+	}
+	if request.Type != "SRV" && request.Type != "HTTPS" && request.Value == "" {
+		return client.DNSRecord{}, badRequest("%s records require a value", request.Type)
+	}
+	ttl := request.TTL
+	if ttl == 0 {
+		ttl = 60
+	}
+	r := client.DNSRecord{
+		ID:         d.ids.new("rec"),
+		Domain:     request.Domain,
+		TeamID:     teamID,
+		Name:       request.Name,
+		TTL:        ttl,
+		Value:      dnsValue(request.Type, request.Value, request.MXPriority, request.SRV, request.HTTPS),
+		RecordType: request.Type,
+		Priority:   request.MXPriority,
+		Comment:    request.Comment,
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.records[r.ID] = r
+	return r, nil
+}
+
+func (d *DNS) GetDNSRecord(_ context.Context, recordID, teamID string) (client.DNSRecord, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	r, ok := d.records[recordID]
+	if !ok || r.TeamID != teamID {
+		return client.DNSRecord{}, notFound("DNS record %s not found", recordID)
+	}
+	return r, nil
+}
+
+func (d *DNS) ListDNSRecords(_ context.Context, domain, teamID string) (records []client.DNSRecord, err error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, r := range d.records {
+		if r.Domain == domain && r.TeamID == teamID {
+			records = append(records, r)
+		}
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].ID < records[j].ID
+	})
+	return records, nil
+}
+
+func (d *DNS) UpdateDNSRecord(_ context.Context, teamID, recordID string, request client.UpdateDNSRecordRequest) (client.DNSRecord, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	r, ok := d.records[recordID]
+	if !ok || r.TeamID != teamID {
+		return client.DNSRecord{}, notFound("DNS record %s not found", recordID)
+	}
+	if request.Name != nil {
+		r.Name = *request.Name
+	}
+	if request.TTL != nil {
+		r.TTL = *request.TTL
+	}
+	value := r.Value
+	if r.RecordType == "MX" {
+		// Strip the priority back off of the value, so that it can be rebuilt below.
+		value = strings.TrimPrefix(value, fmt.Sprintf("%d ", r.Priority))
+	}
+	if request.MXPriority != nil {
+		r.Priority = *request.MXPriority
+	}
+	if request.Value != nil {
+		value = *request.Value
+	}
+	if r.RecordType != "SRV" && r.RecordType != "HTTPS" {
+		r.Value = dnsValue(r.RecordType, value, r.Priority, nil, nil)
+	}
+	if request.SRV != nil {
+		srv := &client.SRV{}
+		if request.SRV.Port != nil {
+			srv.Port = *request.SRV.Port
+		}
+		if request.SRV.Priority != nil {
+			srv.Priority = *request.SRV.Priority
+		}
+		if request.SRV.Target != nil {
+			srv.Target = *request.SRV.Target
+		}
+		if request.SRV.Weight != nil {
+			srv.Weight = *request.SRV.Weight
+		}
+		r.Value = dnsValue(r.RecordType, "", 0, srv, nil)
+	}
+	if request.HTTPS != nil {
+		https := &client.HTTPS{}
+		if request.HTTPS.Priority != nil {
+			https.Priority = *request.HTTPS.Priority
+		}
+		if request.HTTPS.Target != nil {
+			https.Target = *request.HTTPS.Target
+		}
+		if request.HTTPS.Params != nil {
+			https.Params = *request.HTTPS.Params
+		}
+		r.Value = dnsValue(r.RecordType, "", 0, nil, https)
+	}
+	r.Comment = request.Comment
+	d.records[recordID] = r
+	return r, nil
+}
+
+func (d *DNS) DeleteDNSRecord(_ context.Context, domain, recordID, teamID string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	r, ok := d.records[recordID]
+	if !ok || r.Domain != domain || r.TeamID != teamID {
+		return notFound("DNS record %s not found", recordID)
+	}
+	delete(d.records, recordID)
+	return nil
+}
@@ -0,0 +1,150 @@
+package clienttest
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+// vercelNameservers are the nameservers Vercel assigns to every domain.
+var vercelNameservers = []string{"ns1.vercel-dns.com", "ns2.vercel-dns.com"}
+
+// Domains is an in-memory implementation of client.DomainsAPI.
+type Domains struct {
+	mu      sync.Mutex
+	ids     ids
+	domains map[string]client.Domain
+	// Verifiable holds the domains that will be verified when VerifyDomain is called. Domains that are
+	// transferred in are verified immediately.
+	Verifiable map[string]bool
+}
+
+var _ client.DomainsAPI = &Domains{}
+
+// NewDomains creates an empty in-memory client.DomainsAPI.
+func NewDomains() *Domains {
+	return &Domains{
+		domains:    map[string]client.Domain{},
+		Verifiable: map[string]bool{},
+	}
+}
+
+func domainKey(domain, teamID string) string {
+	return teamID + "/" + strings.ToLower(domain)
+}
+
+func (d *Domains) CreateDomain(_ context.Context, teamID string, request client.CreateDomainRequest) (client.Domain, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	key := domainKey(request.Name, teamID)
+	if _, ok := d.domains[key]; ok {
+		return client.Domain{}, conflict("The domain %s already exists", request.Name)
+	}
+	domain := client.Domain{
+		ID:                  d.ids.new("dom"),
+		Name:                strings.ToLower(request.Name),
+		TeamID:              teamID,
+		ServiceType:         "external",
+		IntendedNameservers: vercelNameservers,
+	}
+	switch request.Method {
+	case "add", "move-in":
+	case "transfer-in":
+		if request.AuthCode == "" || request.ExpectedPrice == nil {
+			return client.Domain{}, badRequest("Transferring a domain requires an auth code and expected price")
+		}
+		renew := true
+		domain.ServiceType = "zeit.world"
+		domain.Verified = true
+		domain.Renew = &renew
+	default:
+		return client.Domain{}, badRequest("Invalid method %s", request.Method)
+	}
+	d.domains[key] = domain
+	return domain, nil
+}
+
+func (d *Domains) GetDomain(_ context.Context, domain, teamID string) (client.Domain, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	existing, ok := d.domains[domainKey(domain, teamID)]
+	if !ok {
+		return client.Domain{}, notFound("Domain %s not found", domain)
+	}
+	return existing, nil
+}
+
+func (d *Domains) UpdateDomain(_ context.Context, domain, teamID string, request client.UpdateDomainRequest) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	key := domainKey(domain, teamID)
+	existing, ok := d.domains[key]
+	if !ok {
+		return notFound("Domain %s not found", domain)
+	}
+	if request.Renew != nil {
+		if existing.ServiceType != "zeit.world" {
+			return badRequest("Renewal can only be configured for domains registered with Vercel")
+		}
+		renew := *request.Renew
+		existing.Renew = &renew
+	}
+	if request.CustomNameservers != nil {
+		existing.CustomNameservers = *request.CustomNameservers
+	}
+	d.domains[key] = existing
+	return nil
+}
+
+func (d *Domains) VerifyDomain(_ context.Context, domain, teamID string) (client.Domain, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	key := domainKey(domain, teamID)
+	existing, ok := d.domains[key]
+	if !ok {
+		return client.Domain{}, notFound("Domain %s not found", domain)
+	}
+	if !existing.Verified && !d.Verifiable[strings.ToLower(domain)] {
+		return client.Domain{}, badRequest("The domain %s could not be verified", domain)
+	}
+	existing.Verified = true
+	existing.Nameservers = vercelNameservers
+	d.domains[key] = existing
+	return existing, nil
+}
+
+func (d *Domains) DeleteDomain(_ context.Context, domain, teamID string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	key := domainKey(domain, teamID)
+	if _, ok := d.domains[key]; !ok {
+		return notFound("Domain %s not found", domain)
+	}
+	delete(d.domains, key)
+	return nil
+}
+
+func (d *Domains) GetDomainConfig(_ context.Context, domain, _, teamID string) (client.DomainConfig, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	config := client.DomainConfig{
+		Domain:             domain,
+		TeamID:             teamID,
+		AcceptedChallenges: []string{"http-01"},
+		Misconfigured:      true,
+		ServiceType:        "external",
+		RecommendedIPv4:    []client.RecommendedIPv4{{Rank: 1, Value: []string{"76.76.21.21"}}},
+		RecommendedCNAME:   []client.RecommendedCNAME{{Rank: 1, Value: "cname.vercel-dns.com."}},
+	}
+	if existing, ok := d.domains[domainKey(domain, teamID)]; ok && existing.Verified {
+		configuredBy := "A"
+		config.ConfiguredBy = &configuredBy
+		config.Misconfigured = false
+		config.ServiceType = existing.ServiceType
+		config.Nameservers = existing.Nameservers
+		config.AValues = []string{"76.76.21.21"}
+	}
+	return config, nil
+}
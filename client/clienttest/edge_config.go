@@ -0,0 +1,183 @@
+package clienttest
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+// EdgeConfigs is an in-memory implementation of client.EdgeConfigAPI.
+type EdgeConfigs struct {
+	mu      sync.Mutex
+	ids     ids
+	configs map[string]client.EdgeConfig
+	schemas map[string]any
+	// tokens are keyed by Edge Config ID, then by token.
+	tokens map[string]map[string]client.EdgeConfigToken
+}
+
+var _ client.EdgeConfigAPI = &EdgeConfigs{}
+
+// NewEdgeConfigs creates an empty in-memory client.EdgeConfigAPI.
+func NewEdgeConfigs() *EdgeConfigs {
+	return &EdgeConfigs{
+		configs: map[string]client.EdgeConfig{},
+		schemas: map[string]any{},
+		tokens:  map[string]map[string]client.EdgeConfigToken{},
+	}
+}
+
+// get returns an Edge Config, checking that it belongs to the given team. The lock must already be held.
+func (e *EdgeConfigs) get(id, teamID string) (client.EdgeConfig, error) {
+	ec, ok := e.configs[id]
+	if !ok || ec.TeamID != teamID {
+		return client.EdgeConfig{}, notFound("Edge Config %s not found", id)
+	}
+	return ec, nil
+}
+
+func (e *EdgeConfigs) CreateEdgeConfig(_ context.Context, request client.CreateEdgeConfigRequest) (client.EdgeConfig, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for _, ec := range e.configs {
+		if ec.Slug == request.Name && ec.TeamID == request.TeamID {
+			return client.EdgeConfig{}, conflict("An Edge Config with the slug %s already exists", request.Name)
+		}
+	}
+	ec := client.EdgeConfig{
+		ID:     e.ids.new("ecfg"),
+		Slug:   request.Name,
+		TeamID: request.TeamID,
+	}
+	e.configs[ec.ID] = ec
+	return ec, nil
+}
+
+func (e *EdgeConfigs) GetEdgeConfig(_ context.Context, id, teamID string) (client.EdgeConfig, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.get(id, teamID)
+}
+
+func (e *EdgeConfigs) ListEdgeConfigs(_ context.Context, teamID string) (configs []client.EdgeConfig, err error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for _, ec := range e.configs {
+		if ec.TeamID == teamID {
+			configs = append(configs, ec)
+		}
+	}
+	sort.Slice(configs, func(i, j int) bool {
+		return configs[i].ID < configs[j].ID
+	})
+	return configs, nil
+}
+
+func (e *EdgeConfigs) UpdateEdgeConfig(_ context.Context, request client.UpdateEdgeConfigRequest) (client.EdgeConfig, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	ec, err := e.get(request.ID, request.TeamID)
+	if err != nil {
+		return ec, err
+	}
+	ec.Slug = request.Slug
+	e.configs[ec.ID] = ec
+	return ec, nil
+}
+
+func (e *EdgeConfigs) DeleteEdgeConfig(_ context.Context, id, teamID string) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if _, err := e.get(id, teamID); err != nil {
+		return err
+	}
+	delete(e.configs, id)
+	delete(e.schemas, id)
+	delete(e.tokens, id)
+	return nil
+}
+
+func (e *EdgeConfigs) UpsertEdgeConfigSchema(_ context.Context, request client.EdgeConfigSchema) (client.EdgeConfigSchema, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if _, err := e.get(request.ID, request.TeamID); err != nil {
+		return client.EdgeConfigSchema{}, err
+	}
+	e.schemas[request.ID] = request.Definition
+	return request, nil
+}
+
+func (e *EdgeConfigs) GetEdgeConfigSchema(_ context.Context, id, teamID string) (client.EdgeConfigSchema, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if _, err := e.get(id, teamID); err != nil {
+		return client.EdgeConfigSchema{}, err
+	}
+	definition, ok := e.schemas[id]
+	if !ok {
+		return client.EdgeConfigSchema{}, notFound("Edge Config Schema not found")
+	}
+	return client.EdgeConfigSchema{
+		ID:         id,
+		Definition: definition,
+		TeamID:     teamID,
+	}, nil
+}
+
+func (e *EdgeConfigs) DeleteEdgeConfigSchema(_ context.Context, id, teamID string) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if _, err := e.get(id, teamID); err != nil {
+		return err
+	}
+	delete(e.schemas, id)
+	return nil
+}
+
+func (e *EdgeConfigs) CreateEdgeConfigToken(_ context.Context, request client.CreateEdgeConfigTokenRequest) (client.EdgeConfigToken, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if _, err := e.get(request.EdgeConfigID, request.TeamID); err != nil {
+		return client.EdgeConfigToken{}, err
+	}
+	token := client.EdgeConfigToken{
+		TeamID:       request.TeamID,
+		Token:        e.ids.new("token"),
+		Label:        request.Label,
+		ID:           e.ids.new("ect"),
+		EdgeConfigID: request.EdgeConfigID,
+	}
+	if e.tokens[request.EdgeConfigID] == nil {
+		e.tokens[request.EdgeConfigID] = map[string]client.EdgeConfigToken{}
+	}
+	e.tokens[request.EdgeConfigID][token.Token] = token
+	return token, nil
+}
+
+func (e *EdgeConfigs) GetEdgeConfigToken(_ context.Context, request client.EdgeConfigTokenRequest) (client.EdgeConfigToken, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if _, err := e.get(request.EdgeConfigID, request.TeamID); err != nil {
+		return client.EdgeConfigToken{}, err
+	}
+	token, ok := e.tokens[request.EdgeConfigID][request.Token]
+	if !ok {
+		return client.EdgeConfigToken{}, notFound("Edge Config Token not found")
+	}
+	return token, nil
+}
+
+func (e *EdgeConfigs) DeleteEdgeConfigToken(_ context.Context, request client.EdgeConfigTokenRequest) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if _, err := e.get(request.EdgeConfigID, request.TeamID); err != nil {
+		return err
+	}
+	if _, ok := e.tokens[request.EdgeConfigID][request.Token]; !ok {
+		return notFound("Edge Config Token not found")
+	}
+	delete(e.tokens[request.EdgeConfigID], request.Token)
+	return nil
+}
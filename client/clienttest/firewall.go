@@ -0,0 +1,236 @@
+package clienttest
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+// Firewall is an in-memory implementation of client.FirewallAPI.
+type Firewall struct {
+	mu       sync.Mutex
+	ids      ids
+	configs  map[string]client.FirewallConfig
+	bypasses map[string][]client.FirewallBypass
+}
+
+var _ client.FirewallAPI = &Firewall{}
+
+// NewFirewall creates an in-memory client.FirewallAPI where every project has an empty firewall configuration.
+func NewFirewall() *Firewall {
+	return &Firewall{
+		configs:  map[string]client.FirewallConfig{},
+		bypasses: map[string][]client.FirewallBypass{},
+	}
+}
+
+func firewallKey(projectID, teamID string) string {
+	return teamID + "/" + projectID
+}
+
+func (f *Firewall) GetFirewallConfig(_ context.Context, projectID, teamID string) (client.FirewallConfig, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	cfg, ok := f.configs[firewallKey(projectID, teamID)]
+	if !ok {
+		cfg = client.FirewallConfig{Enabled: true}
+	}
+	cfg.ProjectID = projectID
+	cfg.TeamID = teamID
+	return cfg, nil
+}
+
+func (f *Firewall) PutFirewallConfig(_ context.Context, cfg client.FirewallConfig) (client.FirewallConfig, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for i := range cfg.Rules {
+		if cfg.Rules[i].ID == "" {
+			cfg.Rules[i].ID = f.ids.new("rule")
+		}
+	}
+	for i := range cfg.IPRules {
+		if cfg.IPRules[i].ID == "" {
+			cfg.IPRules[i].ID = f.ids.new("ip")
+		}
+	}
+	f.configs[firewallKey(cfg.ProjectID, cfg.TeamID)] = cfg
+	return cfg, nil
+}
+
+func (f *Firewall) PatchFirewallConfig(_ context.Context, request client.PatchFirewallConfigRequest) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	key := firewallKey(request.ProjectID, request.TeamID)
+	cfg, ok := f.configs[key]
+	if !ok {
+		cfg = client.FirewallConfig{
+			ProjectID: request.ProjectID,
+			TeamID:    request.TeamID,
+			Enabled:   true,
+		}
+	}
+
+	switch request.Action {
+	case "rules.insert":
+		var rule client.FirewallRule
+		if err := convertValue(request.Value, &rule); err != nil {
+			return err
+		}
+		rule.ID = f.ids.new("rule")
+		cfg.Rules = append(cfg.Rules, rule)
+	case "rules.update", "rules.remove", "rules.priority":
+		i := indexOf(len(cfg.Rules), func(i int) bool { return cfg.Rules[i].ID == request.ID })
+		if i < 0 {
+			return notFound("Rule %s not found", request.ID)
+		}
+		switch request.Action {
+		case "rules.update":
+			var rule client.FirewallRule
+			if err := convertValue(request.Value, &rule); err != nil {
+				return err
+			}
+			rule.ID = request.ID
+			cfg.Rules[i] = rule
+		case "rules.remove":
+			cfg.Rules = append(cfg.Rules[:i], cfg.Rules[i+1:]...)
+		case "rules.priority":
+			var priority int
+			if err := convertValue(request.Value, &priority); err != nil {
+				return err
+			}
+			if priority < 0 || priority >= len(cfg.Rules) {
+				return badRequest("Priority %d is out of range", priority)
+			}
+			rule := cfg.Rules[i]
+			cfg.Rules = append(cfg.Rules[:i], cfg.Rules[i+1:]...)
+			cfg.Rules = append(cfg.Rules[:priority], append([]client.FirewallRule{rule}, cfg.Rules[priority:]...)...)
+		}
+	case "ip.insert":
+		var rule client.IPRule
+		if err := convertValue(request.Value, &rule); err != nil {
+			return err
+		}
+		for _, existing := range cfg.IPRules {
+			if existing.Hostname == rule.Hostname && existing.IP == rule.IP {
+				return conflict("An IP rule for %s on %s already exists", rule.IP, rule.Hostname)
+			}
+		}
+		rule.ID = f.ids.new("ip")
+		cfg.IPRules = append(cfg.IPRules, rule)
+	case "ip.update", "ip.remove", "ip.priority":
+		i := indexOf(len(cfg.IPRules), func(i int) bool { return cfg.IPRules[i].ID == request.ID })
+		if i < 0 {
+			return notFound("IP rule %s not found", request.ID)
+		}
+		switch request.Action {
+		case "ip.update":
+			var rule client.IPRule
+			if err := convertValue(request.Value, &rule); err != nil {
+				return err
+			}
+			rule.ID = request.ID
+			cfg.IPRules[i] = rule
+		case "ip.remove":
+			cfg.IPRules = append(cfg.IPRules[:i], cfg.IPRules[i+1:]...)
+		case "ip.priority":
+			var priority int
+			if err := convertValue(request.Value, &priority); err != nil {
+				return err
+			}
+			if priority < 0 || priority >= len(cfg.IPRules) {
+				return badRequest("Priority %d is out of range", priority)
+			}
+			rule := cfg.IPRules[i]
+			cfg.IPRules = append(cfg.IPRules[:i], cfg.IPRules[i+1:]...)
+			cfg.IPRules = append(cfg.IPRules[:priority], append([]client.IPRule{rule}, cfg.IPRules[priority:]...)...)
+		}
+	default:
+		return badRequest("Unknown firewall config action %s", request.Action)
+	}
+	f.configs[key] = cfg
+	return nil
+}
+
+func (f *Firewall) CreateFirewallBypass(_ context.Context, request client.FirewallBypassRequest) (client.FirewallBypass, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if request.SourceIP == "" || request.Domain == "" && !request.ProjectScope {
+		return client.FirewallBypass{}, badRequest("A source IP, and a domain or project scope, are required")
+	}
+	key := firewallKey(request.ProjectID, request.TeamID)
+	bypass := client.FirewallBypass{
+		ID:            f.ids.new("byp"),
+		TeamID:        request.TeamID,
+		ProjectID:     request.ProjectID,
+		Domain:        request.Domain,
+		IP:            request.SourceIP,
+		Note:          request.Note,
+		IsProjectRule: request.ProjectScope,
+	}
+	// Adding an existing bypass replaces it, as the API does.
+	existing := f.bypasses[key]
+	if i := indexOf(len(existing), func(i int) bool { return matchesBypass(existing[i], request) }); i >= 0 {
+		existing[i] = bypass
+	} else {
+		f.bypasses[key] = append(existing, bypass)
+	}
+	return bypass, nil
+}
+
+func (f *Firewall) GetFirewallBypass(_ context.Context, request client.FirewallBypassRequest) (client.FirewallBypass, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, b := range f.bypasses[firewallKey(request.ProjectID, request.TeamID)] {
+		if matchesBypass(b, request) {
+			return b, nil
+		}
+	}
+	return client.FirewallBypass{}, notFound("Firewall bypass for %s not found", request.SourceIP)
+}
+
+func (f *Firewall) DeleteFirewallBypass(_ context.Context, request client.FirewallBypassRequest) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	key := firewallKey(request.ProjectID, request.TeamID)
+	existing := f.bypasses[key]
+	i := indexOf(len(existing), func(i int) bool { return matchesBypass(existing[i], request) })
+	if i < 0 {
+		return notFound("Firewall bypass for %s not found", request.SourceIP)
+	}
+	f.bypasses[key] = append(existing[:i], existing[i+1:]...)
+	return nil
+}
+
+func matchesBypass(b client.FirewallBypass, request client.FirewallBypassRequest) bool {
+	if b.IP != request.SourceIP {
+		return false
+	}
+	if request.ProjectScope {
+		return b.IsProjectRule
+	}
+	return !b.IsProjectRule && b.Domain == request.Domain
+}
+
+func indexOf(n int, match func(i int) bool) int {
+	for i := 0; i < n; i++ {
+		if match(i) {
+			return i
+		}
+	}
+	return -1
+}
+
+// convertValue decodes the value of a patch request into a concrete type, in the same way the API would
+// decode it from the request body.
+func convertValue(value interface{}, target interface{}) error {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return badRequest("Invalid value: %s", err)
+	}
+	if err := json.Unmarshal(b, target); err != nil {
+		return badRequest("Invalid value: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package clienttest
+
+import (
+	"context"
+	"sync"
+
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+// LogDrains is an in-memory implementation of client.LogDrainsAPI.
+type LogDrains struct {
+	mu        sync.Mutex
+	ids       ids
+	logDrains map[string]client.LogDrain
+	// VerificationCode is the code endpoints are expected to return in the x-vercel-verify header.
+	VerificationCode string
+}
+
+var _ client.LogDrainsAPI = &LogDrains{}
+
+// NewLogDrains creates an empty in-memory client.LogDrainsAPI.
+func NewLogDrains() *LogDrains {
+	return &LogDrains{
+		logDrains:        map[string]client.LogDrain{},
+		VerificationCode: "verify",
+	}
+}
+
+func (l *LogDrains) CreateLogDrain(_ context.Context, request client.CreateLogDrainRequest) (client.LogDrain, error) {
+	if len(request.Sources) == 0 || len(request.Environments) == 0 {
+		return client.LogDrain{}, badRequest("A log drain must have at least one source and environment")
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	logDrain := client.LogDrain{
+		ID:             l.ids.new("ld"),
+		TeamID:         request.TeamID,
+		DeliveryFormat: request.DeliveryFormat,
+		Environments:   request.Environments,
+		Headers:        request.Headers,
+		ProjectIDs:     request.ProjectIDs,
+		SamplingRules:  request.SamplingRules,
+		Filter:         request.Filter,
+		Secret:         request.Secret,
+		Sources:        request.Sources,
+		Endpoint:       request.Endpoint,
+	}
+	if request.SamplingRate != 0 {
+		rate := request.SamplingRate
+		logDrain.SamplingRate = &rate
+	}
+	l.logDrains[logDrain.ID] = logDrain
+	return logDrain, nil
+}
+
+func (l *LogDrains) GetLogDrain(_ context.Context, id, teamID string) (client.LogDrain, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	logDrain, ok := l.logDrains[id]
+	if !ok || logDrain.TeamID != teamID {
+		return client.LogDrain{}, notFound("Log drain %s not found", id)
+	}
+	return logDrain, nil
+}
+
+func (l *LogDrains) DeleteLogDrain(_ context.Context, id, teamID string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	logDrain, ok := l.logDrains[id]
+	if !ok || logDrain.TeamID != teamID {
+		return notFound("Log drain %s not found", id)
+	}
+	delete(l.logDrains, id)
+	return nil
+}
+
+func (l *LogDrains) GetEndpointVerificationCode(_ context.Context, _ string) (string, error) {
+	return l.VerificationCode, nil
+}
@@ -0,0 +1,700 @@
+package clienttest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+// Projects is an in-memory implementation of client.ProjectsAPI, covering projects along with their
+// environment variables, domains, deploy hooks, git repositories, protection bypasses, Attack Challenge
+// Mode, deployment retention and function CPU.
+type Projects struct {
+	mu          sync.Mutex
+	ids         ids
+	projects    map[string]client.ProjectResponse
+	envs        map[string][]client.EnvironmentVariable
+	domains     map[string]map[string]client.ProjectDomainResponse
+	functionCPU map[string]string
+	// DomainVerificationAttempts is the number of calls to VerifyProjectDomain it takes for each domain to be
+	// verified, so that waiting for verification can be tested. Domains that are not listed are verified as
+	// soon as they are added.
+	DomainVerificationAttempts map[string]int
+}
+
+var _ client.ProjectsAPI = &Projects{}
+
+// NewProjects creates an empty in-memory client.ProjectsAPI.
+func NewProjects() *Projects {
+	return &Projects{
+		projects:    map[string]client.ProjectResponse{},
+		envs:        map[string][]client.EnvironmentVariable{},
+		domains:     map[string]map[string]client.ProjectDomainResponse{},
+		functionCPU: map[string]string{},
+
+		DomainVerificationAttempts: map[string]int{},
+	}
+}
+
+// get looks up a project by either its ID or name, checking that it belongs to the given team.
+// The lock must already be held.
+func (p *Projects) get(idOrName, teamID string) (client.ProjectResponse, error) {
+	for _, project := range p.projects {
+		if (project.ID == idOrName || project.Name == idOrName) && project.TeamID == teamID {
+			return project, nil
+		}
+	}
+	return client.ProjectResponse{}, notFound("Project %s not found", idOrName)
+}
+
+// setLink links a project to a git repository. The link is populated via JSON, as the API
+// response defines it as an anonymous struct.
+func setLink(project *client.ProjectResponse, repoType, repo string, productionBranch *string) {
+	link := map[string]interface{}{
+		"type":             repoType,
+		"productionBranch": productionBranch,
+	}
+	owner, name, _ := strings.Cut(repo, "/")
+	switch repoType {
+	case "github":
+		link["org"] = owner
+		link["repo"] = name
+	case "bitbucket":
+		link["owner"] = owner
+		link["slug"] = name
+	case "gitlab":
+		link["projectNamespace"] = owner
+		link["projectUrl"] = "https://gitlab.com/" + repo
+	}
+	b, _ := json.Marshal(map[string]interface{}{"link": link})
+	project.Link = nil
+	_ = json.Unmarshal(b, project)
+}
+
+func (p *Projects) CreateProject(_ context.Context, teamID string, request client.CreateProjectRequest) (client.ProjectResponse, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if _, err := p.get(request.Name, teamID); err == nil {
+		return client.ProjectResponse{}, conflict("A project with the name %s already exists", request.Name)
+	}
+
+	project := client.ProjectResponse{
+		ID:                          p.ids.new("prj"),
+		TeamID:                      teamID,
+		Name:                        request.Name,
+		BuildCommand:                request.BuildCommand,
+		CommandForIgnoringBuildStep: request.CommandForIgnoringBuildStep,
+		DevCommand:                  request.DevCommand,
+		Framework:                   request.Framework,
+		InstallCommand:              request.InstallCommand,
+		OutputDirectory:             request.OutputDirectory,
+		PublicSource:                request.PublicSource,
+		RootDirectory:               request.RootDirectory,
+		OIDCTokenConfig:             request.OIDCTokenConfig,
+		ResourceConfig:              request.ResourceConfig,
+		VercelAuthentication: &client.VercelAuthentication{
+			DeploymentType: "standard_protection",
+		},
+	}
+	if request.ServerlessFunctionRegion != "" {
+		region := request.ServerlessFunctionRegion
+		project.ServerlessFunctionRegion = &region
+	}
+	if request.GitRepository != nil {
+		branch := "main"
+		setLink(&project, request.GitRepository.Type, request.GitRepository.Repo, &branch)
+	}
+	p.projects[project.ID] = project
+
+	for _, e := range request.EnvironmentVariables {
+		e.ID = p.ids.new("env")
+		e.TeamID = teamID
+		p.envs[project.ID] = append(p.envs[project.ID], e)
+	}
+	return project, nil
+}
+
+func (p *Projects) GetProject(_ context.Context, projectID, teamID string) (client.ProjectResponse, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.get(projectID, teamID)
+}
+
+func (p *Projects) ListProjects(_ context.Context, teamID string) (projects []client.ProjectResponse, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, project := range p.projects {
+		if project.TeamID == teamID {
+			projects = append(projects, project)
+		}
+	}
+	sort.Slice(projects, func(i, j int) bool {
+		return projects[i].ID < projects[j].ID
+	})
+	return projects, nil
+}
+
+func (p *Projects) UpdateProject(_ context.Context, projectID, teamID string, request client.UpdateProjectRequest) (client.ProjectResponse, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	project, err := p.get(projectID, teamID)
+	if err != nil {
+		return project, err
+	}
+
+	if request.Name != nil {
+		project.Name = *request.Name
+	}
+	if request.ServerlessFunctionRegion != "" {
+		region := request.ServerlessFunctionRegion
+		project.ServerlessFunctionRegion = &region
+	}
+	project.BuildCommand = request.BuildCommand
+	project.CommandForIgnoringBuildStep = request.CommandForIgnoringBuildStep
+	project.DevCommand = request.DevCommand
+	project.Framework = request.Framework
+	project.InstallCommand = request.InstallCommand
+	project.OutputDirectory = request.OutputDirectory
+	project.PublicSource = request.PublicSource
+	project.RootDirectory = request.RootDirectory
+	project.VercelAuthentication = request.VercelAuthentication
+	project.PasswordProtection = nil
+	if request.PasswordProtection != nil {
+		project.PasswordProtection = &client.PasswordProtection{
+			DeploymentType: request.PasswordProtection.DeploymentType,
+		}
+	}
+	project.TrustedIps = request.TrustedIps
+	project.OIDCTokenConfig = request.OIDCTokenConfig
+	project.OptionsAllowlist = request.OptionsAllowlist
+	autoExposeSystemEnvVars := request.AutoExposeSystemEnvVars
+	project.AutoExposeSystemEnvVars = &autoExposeSystemEnvVars
+	project.EnablePreviewFeedback = request.EnablePreviewFeedback
+	project.AutoAssignCustomDomains = request.AutoAssignCustomDomains
+	project.GitLFS = request.GitLFS
+	project.ServerlessFunctionZeroConfigFailover = request.ServerlessFunctionZeroConfigFailover
+	project.CustomerSupportCodeVisibility = request.CustomerSupportCodeVisibility
+	project.GitForkProtection = request.GitForkProtection
+	project.ProductionDeploymentsFastLane = request.ProductionDeploymentsFastLane
+	project.DirectoryListing = request.DirectoryListing
+	project.SkewProtectionMaxAge = request.SkewProtectionMaxAge
+	project.GitComments = request.GitComments
+	if request.ResourceConfig != nil {
+		project.ResourceConfig = request.ResourceConfig
+	}
+
+	p.projects[project.ID] = project
+	return project, nil
+}
+
+func (p *Projects) DeleteProject(_ context.Context, projectID, teamID string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	project, err := p.get(projectID, teamID)
+	if err != nil {
+		return err
+	}
+	delete(p.projects, project.ID)
+	delete(p.envs, project.ID)
+	delete(p.domains, project.ID)
+	delete(p.functionCPU, project.ID)
+	return nil
+}
+
+func (p *Projects) UpdateProductionBranch(_ context.Context, request client.UpdateProductionBranchRequest) (client.ProjectResponse, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	project, err := p.get(request.ProjectID, request.TeamID)
+	if err != nil {
+		return project, err
+	}
+	if project.Link == nil {
+		return project, badRequest("Project %s is not linked to a git repository", request.ProjectID)
+	}
+	branch := request.Branch
+	project.Link.ProductionBranch = &branch
+	p.projects[project.ID] = project
+	return project, nil
+}
+
+func (p *Projects) LinkGitRepoToProject(_ context.Context, request client.LinkGitRepoToProjectRequest) (client.ProjectResponse, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	project, err := p.get(request.ProjectID, request.TeamID)
+	if err != nil {
+		return project, err
+	}
+	branch := "main"
+	setLink(&project, request.Type, request.Repo, &branch)
+	p.projects[project.ID] = project
+	return project, nil
+}
+
+func (p *Projects) UnlinkGitRepoFromProject(_ context.Context, projectID, teamID string) (client.ProjectResponse, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	project, err := p.get(projectID, teamID)
+	if err != nil {
+		return project, err
+	}
+	project.Link = nil
+	p.projects[project.ID] = project
+	return project, nil
+}
+
+func (p *Projects) UpdateProtectionBypassForAutomation(_ context.Context, request client.UpdateProtectionBypassForAutomationRequest) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	project, err := p.get(request.ProjectID, request.TeamID)
+	if err != nil {
+		return "", err
+	}
+	if !request.NewValue {
+		delete(project.ProtectionBypass, request.Secret)
+		p.projects[project.ID] = project
+		return "", nil
+	}
+	secret := p.ids.new("bypass")
+	project.ProtectionBypass = map[string]client.ProtectionBypass{
+		secret: {Scope: "automation-bypass"},
+	}
+	p.projects[project.ID] = project
+	return secret, nil
+}
+
+func (p *Projects) CreateDeployHook(_ context.Context, request client.CreateDeployHookRequest) (client.DeployHook, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	project, err := p.get(request.ProjectID, request.TeamID)
+	if err != nil {
+		return client.DeployHook{}, err
+	}
+	if project.Link == nil {
+		return client.DeployHook{}, badRequest("Project %s is not linked to a git repository", request.ProjectID)
+	}
+	id := p.ids.new("hook")
+	hook := client.DeployHook{
+		ID:   id,
+		Name: request.Name,
+		Ref:  request.Ref,
+		URL:  "https://api.vercel.com/v1/integrations/deploy/" + project.ID + "/" + id,
+	}
+	project.Link.DeployHooks = append(project.Link.DeployHooks, hook)
+	p.projects[project.ID] = project
+	return hook, nil
+}
+
+func (p *Projects) DeleteDeployHook(_ context.Context, request client.DeleteDeployHookRequest) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	project, err := p.get(request.ProjectID, request.TeamID)
+	if err != nil {
+		return err
+	}
+	if project.Link == nil {
+		return notFound("Deploy hook %s not found", request.ID)
+	}
+	project.Link.DeployHooks = slices.DeleteFunc(project.Link.DeployHooks, func(h client.DeployHook) bool {
+		return h.ID == request.ID
+	})
+	p.projects[project.ID] = project
+	return nil
+}
+
+// conflictingEnvironmentVariable checks whether an environment variable with the same key already targets
+// any of the same environments and git branch. The lock must already be held.
+func (p *Projects) conflictingEnvironmentVariable(projectID, excludeID string, key string, target []string, gitBranch *string) bool {
+	for _, e := range p.envs[projectID] {
+		if e.ID == excludeID || e.Key != key {
+			continue
+		}
+		if (e.GitBranch == nil) != (gitBranch == nil) || (e.GitBranch != nil && *e.GitBranch != *gitBranch) {
+			continue
+		}
+		for _, t := range target {
+			if slices.Contains(e.Target, t) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (p *Projects) CreateEnvironmentVariable(_ context.Context, request client.CreateEnvironmentVariableRequest) (client.EnvironmentVariable, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	project, err := p.get(request.ProjectID, request.TeamID)
+	if err != nil {
+		return client.EnvironmentVariable{}, err
+	}
+	r := request.EnvironmentVariable
+	if p.conflictingEnvironmentVariable(project.ID, "", r.Key, r.Target, r.GitBranch) {
+		return client.EnvironmentVariable{}, conflict("A variable with the name %s already exists for the target environments", r.Key)
+	}
+	e := client.EnvironmentVariable{
+		ID:        p.ids.new("env"),
+		Key:       r.Key,
+		Value:     r.Value,
+		Target:    r.Target,
+		GitBranch: r.GitBranch,
+		Type:      r.Type,
+		TeamID:    request.TeamID,
+	}
+	p.envs[project.ID] = append(p.envs[project.ID], e)
+	return e, nil
+}
+
+func (p *Projects) CreateEnvironmentVariables(_ context.Context, request client.CreateEnvironmentVariablesRequest) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	project, err := p.get(request.ProjectID, request.TeamID)
+	if err != nil {
+		return err
+	}
+	for _, r := range request.EnvironmentVariables {
+		if p.conflictingEnvironmentVariable(project.ID, "", r.Key, r.Target, r.GitBranch) {
+			return conflict("A variable with the name %s already exists for the target environments", r.Key)
+		}
+	}
+	for _, r := range request.EnvironmentVariables {
+		p.envs[project.ID] = append(p.envs[project.ID], client.EnvironmentVariable{
+			ID:        p.ids.new("env"),
+			Key:       r.Key,
+			Value:     r.Value,
+			Target:    r.Target,
+			GitBranch: r.GitBranch,
+			Type:      r.Type,
+			TeamID:    request.TeamID,
+		})
+	}
+	return nil
+}
+
+func (p *Projects) GetEnvironmentVariable(_ context.Context, projectID, teamID, envID string) (client.EnvironmentVariable, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	project, err := p.get(projectID, teamID)
+	if err != nil {
+		return client.EnvironmentVariable{}, err
+	}
+	for _, e := range p.envs[project.ID] {
+		if e.ID == envID {
+			return e, nil
+		}
+	}
+	return client.EnvironmentVariable{}, notFound("Environment variable %s not found", envID)
+}
+
+func (p *Projects) GetEnvironmentVariables(_ context.Context, projectID, teamID string) ([]client.EnvironmentVariable, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	project, err := p.get(projectID, teamID)
+	if err != nil {
+		return nil, err
+	}
+	return slices.Clone(p.envs[project.ID]), nil
+}
+
+func (p *Projects) UpdateEnvironmentVariable(_ context.Context, request client.UpdateEnvironmentVariableRequest) (client.EnvironmentVariable, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	project, err := p.get(request.ProjectID, request.TeamID)
+	if err != nil {
+		return client.EnvironmentVariable{}, err
+	}
+	for i, e := range p.envs[project.ID] {
+		if e.ID != request.EnvID {
+			continue
+		}
+		if p.conflictingEnvironmentVariable(project.ID, e.ID, e.Key, request.Target, request.GitBranch) {
+			return client.EnvironmentVariable{}, conflict("A variable with the name %s already exists for the target environments", e.Key)
+		}
+		if request.Value != "" {
+			e.Value = request.Value
+		}
+		e.Target = request.Target
+		e.GitBranch = request.GitBranch
+		e.Type = request.Type
+		p.envs[project.ID][i] = e
+		return e, nil
+	}
+	return client.EnvironmentVariable{}, notFound("Environment variable %s not found", request.EnvID)
+}
+
+func (p *Projects) DeleteEnvironmentVariable(_ context.Context, projectID, teamID, variableID string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	project, err := p.get(projectID, teamID)
+	if err != nil {
+		return err
+	}
+	envs := p.envs[project.ID]
+	idx := slices.IndexFunc(envs, func(e client.EnvironmentVariable) bool {
+		return e.ID == variableID
+	})
+	if idx == -1 {
+		return notFound("Environment variable %s not found", variableID)
+	}
+	p.envs[project.ID] = slices.Delete(envs, idx, idx+1)
+	return nil
+}
+
+func (p *Projects) CreateProjectDomain(_ context.Context, projectID, teamID string, request client.CreateProjectDomainRequest) (client.ProjectDomainResponse, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	project, err := p.get(projectID, teamID)
+	if err != nil {
+		return client.ProjectDomainResponse{}, err
+	}
+	if _, ok := p.domains[project.ID][request.Name]; ok {
+		return client.ProjectDomainResponse{}, conflict("The domain %s is already assigned to the project", request.Name)
+	}
+	d := client.ProjectDomainResponse{
+		Name:      request.Name,
+		ProjectID: project.ID,
+		TeamID:    teamID,
+		Verified:  p.DomainVerificationAttempts[request.Name] <= 0,
+	}
+	if !d.Verified {
+		d.Verification = []client.ProjectDomainVerification{{
+			Type:   "TXT",
+			Domain: "_vercel." + request.Name,
+			Value:  "vc-domain-verify=" + request.Name,
+			Reason: "pending_domain_verification",
+		}}
+	}
+	if request.GitBranch != "" {
+		d.GitBranch = &request.GitBranch
+	}
+	if request.Redirect != "" {
+		d.Redirect = &request.Redirect
+	}
+	if request.RedirectStatusCode != 0 {
+		d.RedirectStatusCode = &request.RedirectStatusCode
+	}
+	if p.domains[project.ID] == nil {
+		p.domains[project.ID] = map[string]client.ProjectDomainResponse{}
+	}
+	p.domains[project.ID][request.Name] = d
+	return d, nil
+}
+
+func (p *Projects) GetProjectDomain(_ context.Context, projectID, domain, teamID string) (client.ProjectDomainResponse, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	project, err := p.get(projectID, teamID)
+	if err != nil {
+		return client.ProjectDomainResponse{}, err
+	}
+	d, ok := p.domains[project.ID][domain]
+	if !ok {
+		return d, notFound("Domain %s not found", domain)
+	}
+	return d, nil
+}
+
+func (p *Projects) UpdateProjectDomain(_ context.Context, projectID, domain, teamID string, request client.UpdateProjectDomainRequest) (client.ProjectDomainResponse, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	project, err := p.get(projectID, teamID)
+	if err != nil {
+		return client.ProjectDomainResponse{}, err
+	}
+	d, ok := p.domains[project.ID][domain]
+	if !ok {
+		return d, notFound("Domain %s not found", domain)
+	}
+	d.GitBranch = request.GitBranch
+	d.Redirect = request.Redirect
+	d.RedirectStatusCode = request.RedirectStatusCode
+	p.domains[project.ID][domain] = d
+	return d, nil
+}
+
+func (p *Projects) VerifyProjectDomain(_ context.Context, projectID, domain, teamID string) (client.ProjectDomainResponse, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	project, err := p.get(projectID, teamID)
+	if err != nil {
+		return client.ProjectDomainResponse{}, err
+	}
+	d, ok := p.domains[project.ID][domain]
+	if !ok {
+		return d, notFound("Domain %s not found", domain)
+	}
+	if d.Verified {
+		return d, nil
+	}
+	p.DomainVerificationAttempts[domain]--
+	if p.DomainVerificationAttempts[domain] > 0 {
+		return d, badRequest("The domain %s could not be verified", domain)
+	}
+	d.Verified = true
+	d.Verification = nil
+	p.domains[project.ID][domain] = d
+	return d, nil
+}
+
+func (p *Projects) DeleteProjectDomain(_ context.Context, projectID, domain, teamID string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	project, err := p.get(projectID, teamID)
+	if err != nil {
+		return err
+	}
+	if _, ok := p.domains[project.ID][domain]; !ok {
+		return notFound("Domain %s not found", domain)
+	}
+	delete(p.domains[project.ID], domain)
+	return nil
+}
+
+func (p *Projects) GetAttackChallengeMode(_ context.Context, projectID, teamID string) (client.AttackChallengeMode, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	project, err := p.get(projectID, teamID)
+	if err != nil {
+		return client.AttackChallengeMode{}, err
+	}
+	a := client.AttackChallengeMode{
+		ProjectID: projectID,
+		TeamID:    teamID,
+	}
+	if project.Security != nil {
+		a.Enabled = project.Security.AttackModeEnabled
+		a.UpdatedAt = project.Security.AttackModeUpdatedAt
+		a.ActiveUntil = project.Security.AttackModeActiveUntil
+	}
+	return a, nil
+}
+
+func (p *Projects) UpdateAttackChallengeMode(_ context.Context, request client.AttackChallengeMode) (client.AttackChallengeMode, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	project, err := p.get(request.ProjectID, request.TeamID)
+	if err != nil {
+		return client.AttackChallengeMode{}, err
+	}
+	project.Security = &client.Security{
+		AttackModeEnabled:   request.Enabled,
+		AttackModeUpdatedAt: time.Now().UnixMilli(),
+	}
+	if request.Enabled {
+		project.Security.AttackModeActiveUntil = request.ActiveUntil
+	}
+	p.projects[project.ID] = project
+	return client.AttackChallengeMode{
+		ProjectID:   request.ProjectID,
+		TeamID:      request.TeamID,
+		Enabled:     project.Security.AttackModeEnabled,
+		ActiveUntil: project.Security.AttackModeActiveUntil,
+		UpdatedAt:   project.Security.AttackModeUpdatedAt,
+	}, nil
+}
+
+func (p *Projects) GetDeploymentRetention(_ context.Context, projectID, teamID string) (client.DeploymentExpiration, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	project, err := p.get(projectID, teamID)
+	if err != nil {
+		return client.DeploymentExpiration{}, err
+	}
+	if project.DeploymentExpiration == nil {
+		return client.DeploymentExpiration{}, fmt.Errorf("deployment retention not found")
+	}
+	return *project.DeploymentExpiration, nil
+}
+
+func setRetention(days *int, value string) {
+	if value != "" {
+		*days = client.DeploymentRetentionStringToDays[value]
+	}
+}
+
+func (p *Projects) UpdateDeploymentRetention(_ context.Context, request client.UpdateDeploymentRetentionRequest) (client.DeploymentExpiration, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	project, err := p.get(request.ProjectID, request.TeamID)
+	if err != nil {
+		return client.DeploymentExpiration{}, err
+	}
+	unlimited := client.DeploymentRetentionStringToDays["unlimited"]
+	d := client.DeploymentExpiration{
+		ExpirationPreview:    unlimited,
+		ExpirationProduction: unlimited,
+		ExpirationCanceled:   unlimited,
+		ExpirationErrored:    unlimited,
+	}
+	if project.DeploymentExpiration != nil {
+		d = *project.DeploymentExpiration
+	}
+	// Unset values are left unchanged, as they are omitted from the request.
+	setRetention(&d.ExpirationPreview, request.DeploymentRetention.ExpirationPreview)
+	setRetention(&d.ExpirationProduction, request.DeploymentRetention.ExpirationProduction)
+	setRetention(&d.ExpirationCanceled, request.DeploymentRetention.ExpirationCanceled)
+	setRetention(&d.ExpirationErrored, request.DeploymentRetention.ExpirationErrored)
+	project.DeploymentExpiration = &d
+	p.projects[project.ID] = project
+	return d, nil
+}
+
+func (p *Projects) DeleteDeploymentRetention(_ context.Context, projectID, teamID string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	project, err := p.get(projectID, teamID)
+	if err != nil {
+		return err
+	}
+	unlimited := client.DeploymentRetentionStringToDays["unlimited"]
+	project.DeploymentExpiration = &client.DeploymentExpiration{
+		ExpirationPreview:    unlimited,
+		ExpirationProduction: unlimited,
+		ExpirationCanceled:   unlimited,
+		ExpirationErrored:    unlimited,
+	}
+	p.projects[project.ID] = project
+	return nil
+}
+
+func (p *Projects) GetProjectFunctionCPU(_ context.Context, projectID, teamID string) (client.ProjectFunctionCPU, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	project, err := p.get(projectID, teamID)
+	if err != nil {
+		return client.ProjectFunctionCPU{}, err
+	}
+	f := client.ProjectFunctionCPU{
+		ProjectID: projectID,
+		TeamID:    teamID,
+	}
+	if cpu, ok := p.functionCPU[project.ID]; ok {
+		f.CPU = &cpu
+	}
+	return f, nil
+}
+
+func (p *Projects) UpdateProjectFunctionCPU(_ context.Context, request client.ProjectFunctionCPURequest) (client.ProjectFunctionCPU, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	project, err := p.get(request.ProjectID, request.TeamID)
+	if err != nil {
+		return client.ProjectFunctionCPU{}, err
+	}
+	if !slices.Contains([]string{"basic", "standard", "performance"}, request.CPU) {
+		return client.ProjectFunctionCPU{}, badRequest("Invalid function CPU %s", request.CPU)
+	}
+	p.functionCPU[project.ID] = request.CPU
+	cpu := request.CPU
+	return client.ProjectFunctionCPU{
+		ProjectID: request.ProjectID,
+		TeamID:    request.TeamID,
+		CPU:       &cpu,
+	}, nil
+}
@@ -0,0 +1,109 @@
+package clienttest
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"sync"
+
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+// SharedEnvironmentVariables is an in-memory implementation of client.SharedEnvironmentVariablesAPI.
+type SharedEnvironmentVariables struct {
+	mu   sync.Mutex
+	ids  ids
+	envs map[string]client.SharedEnvironmentVariableResponse
+}
+
+var _ client.SharedEnvironmentVariablesAPI = &SharedEnvironmentVariables{}
+
+// NewSharedEnvironmentVariables creates an empty in-memory client.SharedEnvironmentVariablesAPI.
+func NewSharedEnvironmentVariables() *SharedEnvironmentVariables {
+	return &SharedEnvironmentVariables{
+		envs: map[string]client.SharedEnvironmentVariableResponse{},
+	}
+}
+
+func (s *SharedEnvironmentVariables) CreateSharedEnvironmentVariable(_ context.Context, request client.CreateSharedEnvironmentVariableRequest) (client.SharedEnvironmentVariableResponse, error) {
+	if len(request.EnvironmentVariable.EnvironmentVariables) != 1 {
+		return client.SharedEnvironmentVariableResponse{}, badRequest("Expected exactly one environment variable")
+	}
+	ev := request.EnvironmentVariable.EnvironmentVariables[0]
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, env := range s.envs {
+		if env.TeamID != request.TeamID || env.Key != ev.Key {
+			continue
+		}
+		for _, target := range request.EnvironmentVariable.Target {
+			if slices.Contains(env.Target, target) {
+				return client.SharedEnvironmentVariableResponse{}, conflict("A shared environment variable with the key %s already exists for the %s target", ev.Key, target)
+			}
+		}
+	}
+	env := client.SharedEnvironmentVariableResponse{
+		ID:         s.ids.new("env"),
+		TeamID:     request.TeamID,
+		Key:        ev.Key,
+		Value:      ev.Value,
+		Type:       request.EnvironmentVariable.Type,
+		Target:     request.EnvironmentVariable.Target,
+		ProjectIDs: request.EnvironmentVariable.ProjectIDs,
+	}
+	s.envs[env.ID] = env
+	return env, nil
+}
+
+func (s *SharedEnvironmentVariables) GetSharedEnvironmentVariable(_ context.Context, teamID, envID string) (client.SharedEnvironmentVariableResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	env, ok := s.envs[envID]
+	if !ok || env.TeamID != teamID {
+		return client.SharedEnvironmentVariableResponse{}, notFound("Shared environment variable %s not found", envID)
+	}
+	return env, nil
+}
+
+func (s *SharedEnvironmentVariables) ListSharedEnvironmentVariables(_ context.Context, teamID string) (envs []client.SharedEnvironmentVariableResponse, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, env := range s.envs {
+		if env.TeamID == teamID {
+			envs = append(envs, env)
+		}
+	}
+	sort.Slice(envs, func(i, j int) bool {
+		return envs[i].ID < envs[j].ID
+	})
+	return envs, nil
+}
+
+func (s *SharedEnvironmentVariables) UpdateSharedEnvironmentVariable(_ context.Context, request client.UpdateSharedEnvironmentVariableRequest) (client.SharedEnvironmentVariableResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	env, ok := s.envs[request.EnvID]
+	if !ok || env.TeamID != request.TeamID {
+		return client.SharedEnvironmentVariableResponse{}, notFound("Shared environment variable %s not found", request.EnvID)
+	}
+	// If no value is given, the existing value is left unchanged.
+	if request.Value != "" {
+		env.Value = request.Value
+	}
+	env.Type = request.Type
+	env.Target = request.Target
+	env.ProjectIDs = request.ProjectIDs
+	s.envs[env.ID] = env
+	return env, nil
+}
+
+func (s *SharedEnvironmentVariables) DeleteSharedEnvironmentVariable(_ context.Context, teamID, variableID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	env, ok := s.envs[variableID]
+	if !ok || env.TeamID != teamID {
+		return notFound("Shared environment variable %s not found", variableID)
+	}
+	delete(s.envs, variableID)
+	return nil
+}
@@ -0,0 +1,198 @@
+package clienttest
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+// Teams is an in-memory implementation of client.TeamsAPI.
+type Teams struct {
+	mu      sync.Mutex
+	ids     ids
+	teams   map[string]client.Team
+	users   []client.TeamMember
+	members map[string][]client.TeamMember
+	// DefaultTeam is returned by Team when no team ID is specified, in the same way as the
+	// default team configured on a client.Client.
+	DefaultTeam client.Team
+}
+
+var _ client.TeamsAPI = &Teams{}
+
+// NewTeams creates an in-memory client.TeamsAPI containing the given teams. The first team is used as the
+// default team.
+func NewTeams(teams ...client.Team) *Teams {
+	t := &Teams{
+		teams:   map[string]client.Team{},
+		members: map[string][]client.TeamMember{},
+	}
+	for _, team := range teams {
+		t.teams[team.ID] = team
+	}
+	if len(teams) > 0 {
+		t.DefaultTeam = teams[0]
+	}
+	return t
+}
+
+// AddTeam adds a team, which can then be looked up by either its ID or the given slug.
+func (t *Teams) AddTeam(team client.Team, slug string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if slug != "" {
+		team.Slug = slug
+		t.teams[slug] = team
+	}
+	t.teams[team.ID] = team
+}
+
+// AddUser adds a Vercel account, which can then be invited to a team by either its user ID or its email
+// address. Invitations to any other email address are left pending, without a user ID.
+func (t *Teams) AddUser(userID, email, username string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.users = append(t.users, client.TeamMember{UserID: userID, Email: email, Username: username})
+}
+
+func (t *Teams) Team(ctx context.Context, teamID string) (client.Team, error) {
+	if teamID != "" {
+		return t.GetTeam(ctx, teamID)
+	}
+	return t.DefaultTeam, nil
+}
+
+func (t *Teams) GetTeam(_ context.Context, idOrSlug string) (client.Team, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	team, ok := t.teams[idOrSlug]
+	if !ok {
+		return team, notFound("Team %s not found", idOrSlug)
+	}
+	return team, nil
+}
+
+func (t *Teams) UpdateTeam(_ context.Context, request client.UpdateTeamRequest) (client.Team, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	team, ok := t.teams[t.teamID(request.TeamID)]
+	if !ok {
+		return team, notFound("Team %s not found", request.TeamID)
+	}
+	if request.Slug != "" && request.Slug != team.Slug {
+		if _, ok := t.teams[request.Slug]; ok {
+			return client.Team{}, conflict("The slug %s is already in use", request.Slug)
+		}
+		delete(t.teams, team.Slug)
+		team.Slug = request.Slug
+	}
+	if request.Name != "" {
+		team.Name = request.Name
+	}
+	if request.SensitiveEnvironmentVariablePolicy != nil {
+		team.SensitiveEnvironmentVariablePolicy = request.SensitiveEnvironmentVariablePolicy
+	}
+	if request.PreviewDeploymentSuffix != nil {
+		team.PreviewDeploymentSuffix = request.PreviewDeploymentSuffix
+	}
+	if request.RemoteCaching != nil {
+		team.RemoteCaching = request.RemoteCaching
+	}
+	if request.HideIPAddresses != nil {
+		team.HideIPAddresses = request.HideIPAddresses
+	}
+	if request.DefaultDeploymentProtection != nil {
+		team.DefaultDeploymentProtection = request.DefaultDeploymentProtection
+	}
+	if request.EnablePreviewFeedback != nil {
+		team.EnablePreviewFeedback = request.EnablePreviewFeedback
+	}
+	t.teams[team.ID] = team
+	if team.Slug != "" {
+		t.teams[team.Slug] = team
+	}
+	if t.DefaultTeam.ID == team.ID {
+		t.DefaultTeam = team
+	}
+	return team, nil
+}
+
+func (t *Teams) teamID(teamID string) string {
+	if teamID != "" {
+		return teamID
+	}
+	return t.DefaultTeam.ID
+}
+
+func (t *Teams) InviteTeamMember(_ context.Context, request client.InviteTeamMemberRequest) (client.TeamMember, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	teamID := t.teamID(request.TeamID)
+	member := client.TeamMember{TeamID: teamID, Email: request.Email, Role: request.Role}
+	for _, u := range t.users {
+		if u.UserID == request.UserID || request.UserID == "" && strings.EqualFold(u.Email, request.Email) {
+			member.UserID = u.UserID
+			member.Email = u.Email
+			member.Username = u.Username
+		}
+	}
+	if request.UserID != "" && member.UserID == "" {
+		return client.TeamMember{}, badRequest("User %s not found", request.UserID)
+	}
+	if member.UserID == "" {
+		member.InviteID = t.ids.new("invite")
+	}
+	for _, m := range t.members[teamID] {
+		if member.UserID != "" && m.UserID == member.UserID || m.Email != "" && strings.EqualFold(m.Email, member.Email) {
+			return client.TeamMember{}, conflict("%s is already a member of team %s", member.Email, teamID)
+		}
+	}
+	t.members[teamID] = append(t.members[teamID], member)
+	return member, nil
+}
+
+func (t *Teams) GetTeamMember(_ context.Context, request client.TeamMemberRequest) (client.TeamMember, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, m := range t.members[t.teamID(request.TeamID)] {
+		if request.UserID != "" && m.UserID == request.UserID || request.UserID == "" && strings.EqualFold(m.Email, request.Email) {
+			return m, nil
+		}
+	}
+	return client.TeamMember{}, notFound("Team member not found")
+}
+
+func (t *Teams) ListTeamMembers(_ context.Context, teamID string) ([]client.TeamMember, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return append([]client.TeamMember(nil), t.members[t.teamID(teamID)]...), nil
+}
+
+func (t *Teams) UpdateTeamMember(_ context.Context, request client.UpdateTeamMemberRequest) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	members := t.members[t.teamID(request.TeamID)]
+	for i := range members {
+		if request.UserID != "" && members[i].UserID == request.UserID {
+			members[i].Role = request.Role
+			return nil
+		}
+	}
+	return notFound("Team member %s not found", request.UserID)
+}
+
+func (t *Teams) RemoveTeamMember(_ context.Context, member client.TeamMember) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	teamID := t.teamID(member.TeamID)
+	members := t.members[teamID]
+	for i, m := range members {
+		if member.UserID != "" && m.UserID == member.UserID || member.UserID == "" && m.InviteID != "" && m.InviteID == member.InviteID {
+			t.members[teamID] = append(members[:i:i], members[i+1:]...)
+			return nil
+		}
+	}
+	return notFound("Team member not found")
+}
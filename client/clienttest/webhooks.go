@@ -0,0 +1,65 @@
+package clienttest
+
+import (
+	"context"
+	"sync"
+
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+// Webhooks is an in-memory implementation of client.WebhooksAPI.
+type Webhooks struct {
+	mu       sync.Mutex
+	ids      ids
+	webhooks map[string]client.Webhook
+}
+
+var _ client.WebhooksAPI = &Webhooks{}
+
+// NewWebhooks creates an empty in-memory client.WebhooksAPI.
+func NewWebhooks() *Webhooks {
+	return &Webhooks{
+		webhooks: map[string]client.Webhook{},
+	}
+}
+
+func (w *Webhooks) CreateWebhook(_ context.Context, request client.CreateWebhookRequest) (client.Webhook, error) {
+	if len(request.Events) == 0 {
+		return client.Webhook{}, badRequest("A webhook must have at least one event")
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	webhook := client.Webhook{
+		ID:         w.ids.new("hook"),
+		Events:     request.Events,
+		Endpoint:   request.Endpoint,
+		TeamID:     request.TeamID,
+		ProjectIDs: request.ProjectIDs,
+		Secret:     w.ids.new("secret"),
+	}
+	w.webhooks[webhook.ID] = webhook
+	return webhook, nil
+}
+
+func (w *Webhooks) GetWebhook(_ context.Context, id, teamID string) (client.Webhook, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	webhook, ok := w.webhooks[id]
+	if !ok || webhook.TeamID != teamID {
+		return client.Webhook{}, notFound("Webhook %s not found", id)
+	}
+	// The secret is only returned when the webhook is created.
+	webhook.Secret = ""
+	return webhook, nil
+}
+
+func (w *Webhooks) DeleteWebhook(_ context.Context, id, teamID string) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	webhook, ok := w.webhooks[id]
+	if !ok || webhook.TeamID != teamID {
+		return notFound("Webhook %s not found", id)
+	}
+	delete(w.webhooks, id)
+	return nil
+}
@@ -0,0 +1,87 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// UploadCustomCertificateRequest defines the information necessary to upload a custom TLS certificate.
+// All fields are PEM encoded. CA holds the chain of certificates that issued the certificate.
+type UploadCustomCertificateRequest struct {
+	TeamID      string `json:"-"`
+	Certificate string `json:"cert"`
+	PrivateKey  string `json:"key"`
+	CA          string `json:"ca"`
+}
+
+// CustomCertificate defines the information Vercel exposes about an uploaded certificate.
+type CustomCertificate struct {
+	ID        string   `json:"id"`
+	TeamID    string   `json:"-"`
+	Domains   []string `json:"cns"`
+	CreatedAt int64    `json:"createdAt"`
+	ExpiresAt int64    `json:"expiresAt"`
+	AutoRenew bool     `json:"autoRenew"`
+}
+
+// UploadCustomCertificate uploads a custom TLS certificate to Vercel.
+func (c *Client) UploadCustomCertificate(ctx context.Context, request UploadCustomCertificateRequest) (r CustomCertificate, err error) {
+	url := fmt.Sprintf("%s/v7/certs", c.baseURL)
+	if c.teamID(request.TeamID) != "" {
+		url = fmt.Sprintf("%s?teamId=%s", url, c.teamID(request.TeamID))
+	}
+
+	payload := string(mustMarshal(request))
+	tflog.Info(ctx, "uploading custom certificate", map[string]interface{}{
+		"url":     url,
+		"payload": redactPayload(payload),
+	})
+	err = c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "PUT",
+		url:    url,
+		body:   payload,
+	}, &r)
+	r.TeamID = c.teamID(request.TeamID)
+	return r, err
+}
+
+// GetCustomCertificate retrieves information about an uploaded certificate from Vercel.
+func (c *Client) GetCustomCertificate(ctx context.Context, id, teamID string) (r CustomCertificate, err error) {
+	url := fmt.Sprintf("%s/v7/certs/%s", c.baseURL, id)
+	if c.teamID(teamID) != "" {
+		url = fmt.Sprintf("%s?teamId=%s", url, c.teamID(teamID))
+	}
+
+	tflog.Info(ctx, "getting custom certificate", map[string]interface{}{
+		"url": url,
+	})
+	err = c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "GET",
+		url:    url,
+		body:   "",
+	}, &r)
+	r.TeamID = c.teamID(teamID)
+	return r, err
+}
+
+// DeleteCustomCertificate removes an uploaded certificate from Vercel.
+func (c *Client) DeleteCustomCertificate(ctx context.Context, id, teamID string) error {
+	url := fmt.Sprintf("%s/v7/certs/%s", c.baseURL, id)
+	if c.teamID(teamID) != "" {
+		url = fmt.Sprintf("%s?teamId=%s", url, c.teamID(teamID))
+	}
+
+	tflog.Info(ctx, "deleting custom certificate", map[string]interface{}{
+		"url": url,
+	})
+	return c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "DELETE",
+		url:    url,
+		body:   "",
+	}, nil)
+}
@@ -30,7 +30,7 @@ func (c *Client) CreateDeployHook(ctx context.Context, request CreateDeployHookR
 	payload := string(mustMarshal(request))
 	tflog.Info(ctx, "creating deploy hook", map[string]interface{}{
 		"url":     url,
-		"payload": payload,
+		"payload": redactPayload(payload),
 	})
 
 	var r ProjectResponse
@@ -69,7 +69,7 @@ func (c *Client) DeleteDeployHook(ctx context.Context, request DeleteDeployHookR
 	payload := string(mustMarshal(request))
 	tflog.Info(ctx, "creating deploy hook", map[string]interface{}{
 		"url":     url,
-		"payload": payload,
+		"payload": redactPayload(payload),
 	})
 
 	err := c.doRequest(clientRequest{
@@ -203,7 +203,7 @@ func (c *Client) CreateDeployment(ctx context.Context, request CreateDeploymentR
 
 	tflog.Info(ctx, "creating deployment", map[string]interface{}{
 		"url":     url,
-		"payload": payload,
+		"payload": redactPayload(payload),
 	})
 	err = c.doRequest(clientRequest{
 		ctx:    ctx,
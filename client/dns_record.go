@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -15,12 +16,20 @@ type SRV struct {
 	Weight   int64  `json:"weight"`
 }
 
+// HTTPS defines the metadata required for creating an HTTPS type DNS Record.
+type HTTPS struct {
+	Priority int64  `json:"priority"`
+	Target   string `json:"target"`
+	Params   string `json:"params,omitempty"`
+}
+
 // CreateDNSRecordRequest defines the information necessary to create a DNS record within Vercel.
 type CreateDNSRecordRequest struct {
 	Domain     string `json:"-"`
 	MXPriority int64  `json:"mxPriority,omitempty"`
 	Name       string `json:"name"`
 	SRV        *SRV   `json:"srv,omitempty"`
+	HTTPS      *HTTPS `json:"https,omitempty"`
 	TTL        int64  `json:"ttl,omitempty"`
 	Type       string `json:"type"`
 	Value      string `json:"value,omitempty"`
@@ -96,29 +105,54 @@ func (c *Client) GetDNSRecord(ctx context.Context, recordID, teamID string) (r D
 	return r, err
 }
 
-// ListDNSRecords is a test helper for listing DNS records that exist for a given domain.
-// We limit this to 100, as this is the largest limit allowed by the API.
-// This is only used by the sweeper script, so this is safe to do so, but converting
-// into a production ready function would require some refactoring.
+// listedDNSRecord is the format of a DNS record when listing records. Unlike a single record, the type,
+// MX priority, SRV and HTTPS fields are returned separately from the value.
+type listedDNSRecord struct {
+	DNSRecord
+	Type       string `json:"type"`
+	MXPriority *int64 `json:"mxPriority"`
+	SRV        *SRV   `json:"srv"`
+	HTTPS      *HTTPS `json:"https"`
+}
+
+// toDNSRecord converts a listed record into the same format as a record returned by GetDNSRecord.
+func (l listedDNSRecord) toDNSRecord() DNSRecord {
+	r := l.DNSRecord
+	if r.RecordType == "" {
+		r.RecordType = l.Type
+	}
+	switch {
+	case r.RecordType == "MX" && l.MXPriority != nil && !strings.Contains(r.Value, " "):
+		r.Value = fmt.Sprintf("%d %s", *l.MXPriority, r.Value)
+	case r.RecordType == "SRV" && l.SRV != nil && !strings.Contains(r.Value, " "):
+		r.Value = fmt.Sprintf("%d %d %d %s", l.SRV.Priority, l.SRV.Weight, l.SRV.Port, l.SRV.Target)
+	case r.RecordType == "HTTPS" && l.HTTPS != nil && !strings.Contains(r.Value, " "):
+		r.Value = strings.TrimSpace(fmt.Sprintf("%d %s %s", l.HTTPS.Priority, l.HTTPS.Target, l.HTTPS.Params))
+	}
+	return r
+}
+
+// ListDNSRecords lists all of the DNS records that exist for a given domain.
+// Records are requested 100 at a time, as this is the largest limit allowed by the API.
 func (c *Client) ListDNSRecords(ctx context.Context, domain, teamID string) (r []DNSRecord, err error) {
 	url := fmt.Sprintf("%s/v4/domains/%s/records?limit=100", c.baseURL, domain)
 	if c.teamID(teamID) != "" {
 		url = fmt.Sprintf("%s&teamId=%s", url, c.teamID(teamID))
 	}
 
-	dr := struct {
-		Records []DNSRecord `json:"records"`
-	}{}
-	err = c.doRequest(clientRequest{
-		ctx:    ctx,
-		method: "GET",
-		url:    url,
-		body:   "",
-	}, &dr)
-	for _, record := range dr.Records {
+	listed, err := listAll[listedDNSRecord](c, listRequest{
+		ctx:     ctx,
+		url:     url,
+		key:     "records",
+		message: "listing DNS records",
+	})
+	for _, l := range listed {
+		record := l.toDNSRecord()
+		record.Domain = domain
 		record.TeamID = c.teamID(teamID)
+		r = append(r, record)
 	}
-	return dr.Records, err
+	return r, err
 }
 
 // SRVUpdate defines the updatable fields within an SRV block of a DNS record.
@@ -129,14 +163,22 @@ type SRVUpdate struct {
 	Weight   *int64  `json:"weight"`
 }
 
+// HTTPSUpdate defines the updatable fields within an HTTPS block of a DNS record.
+type HTTPSUpdate struct {
+	Priority *int64  `json:"priority"`
+	Target   *string `json:"target"`
+	Params   *string `json:"params"`
+}
+
 // UpdateDNSRecordRequest defines the structure of the request body for updating a DNS record.
 type UpdateDNSRecordRequest struct {
-	MXPriority *int64     `json:"mxPriority,omitempty"`
-	Name       *string    `json:"name,omitempty"`
-	SRV        *SRVUpdate `json:"srv,omitempty"`
-	TTL        *int64     `json:"ttl,omitempty"`
-	Value      *string    `json:"value,omitempty"`
-	Comment    string     `json:"comment"`
+	MXPriority *int64       `json:"mxPriority,omitempty"`
+	Name       *string      `json:"name,omitempty"`
+	SRV        *SRVUpdate   `json:"srv,omitempty"`
+	HTTPS      *HTTPSUpdate `json:"https,omitempty"`
+	TTL        *int64       `json:"ttl,omitempty"`
+	Value      *string      `json:"value,omitempty"`
+	Comment    string       `json:"comment"`
 }
 
 // UpdateDNSRecord updates a DNS record for a specified domain name within Vercel.
@@ -149,7 +191,7 @@ func (c *Client) UpdateDNSRecord(ctx context.Context, teamID, recordID string, r
 	payload := string(mustMarshal(request))
 	tflog.Info(ctx, "updating DNS record", map[string]interface{}{
 		"url":     url,
-		"payload": payload,
+		"payload": redactPayload(payload),
 	})
 	err = c.doRequest(clientRequest{
 		ctx:    ctx,
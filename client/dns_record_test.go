@@ -0,0 +1,42 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestListDNSRecordsNormalizesRecords(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"records":[
+			{"id":"rec_1","name":"","type":"A","value":"76.76.21.21","ttl":60,"creator":"system"},
+			{"id":"rec_2","name":"","type":"MX","value":"mail.example.com","mxPriority":10,"ttl":60},
+			{"id":"rec_3","name":"_sip._tcp","type":"SRV","value":"","srv":{"priority":10,"weight":60,"port":5060,"target":"sip.example.com"},"ttl":60}
+		],"pagination":{"count":3,"next":null}}`)
+	}))
+	defer server.Close()
+
+	c := New("token").WithTeam(Team{ID: "team_1"})
+	c.baseURL = server.URL
+	records, err := c.ListDNSRecords(context.Background(), "example.com", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	expected := []DNSRecord{
+		{ID: "rec_1", Name: "", RecordType: "A", Value: "76.76.21.21", TTL: 60, Creator: "system"},
+		{ID: "rec_2", Name: "", RecordType: "MX", Value: "10 mail.example.com", TTL: 60},
+		{ID: "rec_3", Name: "_sip._tcp", RecordType: "SRV", Value: "10 60 5060 sip.example.com", TTL: 60},
+	}
+	if len(records) != len(expected) {
+		t.Fatalf("expected %d records, got %d", len(expected), len(records))
+	}
+	for i, e := range expected {
+		e.Domain = "example.com"
+		e.TeamID = "team_1"
+		if records[i] != e {
+			t.Errorf("record %d: expected %+v, got %+v", i, e, records[i])
+		}
+	}
+}
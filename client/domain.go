@@ -0,0 +1,222 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Domain defines the information Vercel exposes about a domain that has been added to an account or team.
+type Domain struct {
+	ID                  string   `json:"id"`
+	Name                string   `json:"name"`
+	TeamID              string   `json:"-"`
+	ServiceType         string   `json:"serviceType"`
+	Verified            bool     `json:"verified"`
+	Nameservers         []string `json:"nameservers"`
+	IntendedNameservers []string `json:"intendedNameservers"`
+	CustomNameservers   []string `json:"customNameservers"`
+	Renew               *bool    `json:"renew"`
+	BoughtAt            *int64   `json:"boughtAt"`
+	ExpiresAt           *int64   `json:"expiresAt"`
+	TransferredAt       *int64   `json:"transferredAt"`
+	TransferStartedAt   *int64   `json:"transferStartedAt"`
+	CreatedAt           int64    `json:"createdAt"`
+}
+
+// CreateDomainRequest defines the information necessary to add a domain to an account or team.
+//
+// Method is one of `add`, to add a domain that is registered elsewhere, `move-in`, to move a domain from
+// another Vercel account or team, or `transfer-in`, to transfer the registration of a domain to Vercel.
+// Transferring a domain requires the AuthCode from the current registrar, and the ExpectedPrice of the transfer.
+type CreateDomainRequest struct {
+	Name          string   `json:"name"`
+	Method        string   `json:"method"`
+	AuthCode      string   `json:"authCode,omitempty"`
+	ExpectedPrice *float64 `json:"expectedPrice,omitempty"`
+}
+
+// CreateDomain adds a domain to an account or team within Vercel.
+func (c *Client) CreateDomain(ctx context.Context, teamID string, request CreateDomainRequest) (d Domain, err error) {
+	url := fmt.Sprintf("%s/v7/domains", c.baseURL)
+	if c.teamID(teamID) != "" {
+		url = fmt.Sprintf("%s?teamId=%s", url, c.teamID(teamID))
+	}
+
+	payload := string(mustMarshal(request))
+	tflog.Info(ctx, "creating domain", map[string]interface{}{
+		"url":     url,
+		"payload": redactPayload(payload),
+	})
+	var res struct {
+		Domain Domain `json:"domain"`
+	}
+	err = c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "POST",
+		url:    url,
+		body:   payload,
+	}, &res)
+	res.Domain.TeamID = c.teamID(teamID)
+	return res.Domain, err
+}
+
+// GetDomain retrieves information about a domain from Vercel.
+func (c *Client) GetDomain(ctx context.Context, domain, teamID string) (d Domain, err error) {
+	url := fmt.Sprintf("%s/v5/domains/%s", c.baseURL, domain)
+	if c.teamID(teamID) != "" {
+		url = fmt.Sprintf("%s?teamId=%s", url, c.teamID(teamID))
+	}
+
+	tflog.Info(ctx, "getting domain", map[string]interface{}{
+		"url": url,
+	})
+	var res struct {
+		Domain Domain `json:"domain"`
+	}
+	err = c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "GET",
+		url:    url,
+		body:   "",
+	}, &res)
+	res.Domain.TeamID = c.teamID(teamID)
+	return res.Domain, err
+}
+
+// UpdateDomainRequest defines the information necessary to update a domain. Renewal can only be configured
+// for domains that are registered with Vercel. Setting CustomNameservers to an empty list reverts the domain
+// to the Vercel nameservers.
+type UpdateDomainRequest struct {
+	Renew             *bool     `json:"renew,omitempty"`
+	CustomNameservers *[]string `json:"customNameservers,omitempty"`
+}
+
+// UpdateDomain updates the renewal and nameserver settings of a domain.
+func (c *Client) UpdateDomain(ctx context.Context, domain, teamID string, request UpdateDomainRequest) error {
+	url := fmt.Sprintf("%s/v3/domains/%s", c.baseURL, domain)
+	if c.teamID(teamID) != "" {
+		url = fmt.Sprintf("%s?teamId=%s", url, c.teamID(teamID))
+	}
+
+	payload := string(mustMarshal(struct {
+		Op string `json:"op"`
+		UpdateDomainRequest
+	}{
+		Op:                  "update",
+		UpdateDomainRequest: request,
+	}))
+	tflog.Info(ctx, "updating domain", map[string]interface{}{
+		"url":     url,
+		"payload": redactPayload(payload),
+	})
+	return c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "PATCH",
+		url:    url,
+		body:   payload,
+	}, nil)
+}
+
+// VerifyDomain asks Vercel to check the ownership of a domain again. It returns an error if the domain
+// still cannot be verified.
+func (c *Client) VerifyDomain(ctx context.Context, domain, teamID string) (d Domain, err error) {
+	url := fmt.Sprintf("%s/v4/domains/%s/verify", c.baseURL, domain)
+	if c.teamID(teamID) != "" {
+		url = fmt.Sprintf("%s?teamId=%s", url, c.teamID(teamID))
+	}
+
+	tflog.Info(ctx, "verifying domain", map[string]interface{}{
+		"url": url,
+	})
+	var res struct {
+		Domain Domain `json:"domain"`
+	}
+	err = c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "POST",
+		url:    url,
+		body:   "",
+	}, &res)
+	res.Domain.TeamID = c.teamID(teamID)
+	return res.Domain, err
+}
+
+// DeleteDomain removes a domain from an account or team, along with its DNS records and aliases.
+func (c *Client) DeleteDomain(ctx context.Context, domain, teamID string) error {
+	url := fmt.Sprintf("%s/v6/domains/%s", c.baseURL, domain)
+	if c.teamID(teamID) != "" {
+		url = fmt.Sprintf("%s?teamId=%s", url, c.teamID(teamID))
+	}
+
+	tflog.Info(ctx, "deleting domain", map[string]interface{}{
+		"url": url,
+	})
+	return c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "DELETE",
+		url:    url,
+		body:   "",
+	}, nil)
+}
+
+// DomainConfig describes how a domain is configured, and whether it is configured correctly to be served
+// by Vercel.
+type DomainConfig struct {
+	Domain string `json:"-"`
+	TeamID string `json:"-"`
+	// ConfiguredBy is how the domain points at Vercel: `A`, `CNAME`, `http` or `dns-01`. It is empty if the
+	// domain is not configured.
+	ConfiguredBy       *string            `json:"configuredBy"`
+	AcceptedChallenges []string           `json:"acceptedChallenges"`
+	Misconfigured      bool               `json:"misconfigured"`
+	ServiceType        string             `json:"serviceType"`
+	Nameservers        []string           `json:"nameservers"`
+	AValues            []string           `json:"aValues"`
+	CNAMEs             []string           `json:"cnames"`
+	RecommendedIPv4    []RecommendedIPv4  `json:"recommendedIPv4"`
+	RecommendedCNAME   []RecommendedCNAME `json:"recommendedCNAME"`
+}
+
+// RecommendedIPv4 is a set of A record values that a domain can use, ranked by preference.
+type RecommendedIPv4 struct {
+	Rank  int64    `json:"rank"`
+	Value []string `json:"value"`
+}
+
+// RecommendedCNAME is a CNAME record value that a domain can use, ranked by preference.
+type RecommendedCNAME struct {
+	Rank  int64  `json:"rank"`
+	Value string `json:"value"`
+}
+
+// GetDomainConfig retrieves the DNS configuration of a domain. If a project is specified, the recommended
+// values are specific to that project.
+func (c *Client) GetDomainConfig(ctx context.Context, domain, projectIDOrName, teamID string) (d DomainConfig, err error) {
+	query := url.Values{}
+	if projectIDOrName != "" {
+		query.Set("projectIdOrName", projectIDOrName)
+	}
+	if c.teamID(teamID) != "" {
+		query.Set("teamId", c.teamID(teamID))
+	}
+	endpoint := fmt.Sprintf("%s/v6/domains/%s/config", c.baseURL, domain)
+	if len(query) > 0 {
+		endpoint = fmt.Sprintf("%s?%s", endpoint, query.Encode())
+	}
+
+	tflog.Info(ctx, "getting domain config", map[string]interface{}{
+		"url": endpoint,
+	})
+	err = c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "GET",
+		url:    endpoint,
+		body:   "",
+	}, &d)
+	d.Domain = domain
+	d.TeamID = c.teamID(teamID)
+	return d, err
+}
@@ -0,0 +1,38 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetDomainConfigOnlySendsProjectWhenSet(t *testing.T) {
+	var query string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+		fmt.Fprint(w, `{"misconfigured":false}`)
+	}))
+	defer server.Close()
+
+	c := New("token")
+	c.baseURL = server.URL
+	for _, tc := range []struct {
+		project  string
+		teamID   string
+		expected string
+	}{
+		{"", "", ""},
+		{"", "team_1", "teamId=team_1"},
+		{"prj_1", "", "projectIdOrName=prj_1"},
+		{"my project", "team_1", "projectIdOrName=my+project&teamId=team_1"},
+	} {
+		if _, err := c.GetDomainConfig(context.Background(), "example.com", tc.project, tc.teamID); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if query != tc.expected {
+			t.Errorf("GetDomainConfig(%q, %q) sent query %q, want %q", tc.project, tc.teamID, query, tc.expected)
+		}
+	}
+}
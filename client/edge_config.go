@@ -26,7 +26,7 @@ func (c *Client) CreateEdgeConfig(ctx context.Context, request CreateEdgeConfigR
 	payload := string(mustMarshal(request))
 	tflog.Info(ctx, "creating edge config", map[string]interface{}{
 		"url":     url,
-		"payload": payload,
+		"payload": redactPayload(payload),
 	})
 	err = c.doRequest(clientRequest{
 		ctx:    ctx,
@@ -68,7 +68,7 @@ func (c *Client) UpdateEdgeConfig(ctx context.Context, request UpdateEdgeConfigR
 	payload := string(mustMarshal(request))
 	tflog.Trace(ctx, "updating edge config", map[string]interface{}{
 		"url":     url,
-		"payload": payload,
+		"payload": redactPayload(payload),
 	})
 	err = c.doRequest(clientRequest{
 		ctx:    ctx,
@@ -95,18 +95,17 @@ func (c *Client) DeleteEdgeConfig(ctx context.Context, id, teamID string) error
 	}, nil)
 }
 
+// ListEdgeConfigs lists all of the edge configs within Vercel.
 func (c *Client) ListEdgeConfigs(ctx context.Context, teamID string) (e []EdgeConfig, err error) {
 	url := fmt.Sprintf("%s/v1/edge-config", c.baseURL)
 	if c.teamID(teamID) != "" {
 		url = fmt.Sprintf("%s?teamId=%s", url, c.teamID(teamID))
 	}
-	tflog.Info(ctx, "listing edge configs", map[string]interface{}{
-		"url": url,
+	// The edge config list endpoint returns every edge config as a single array.
+	e, err = listAll[EdgeConfig](c, listRequest{
+		ctx:     ctx,
+		url:     url,
+		message: "listing edge configs",
 	})
-	err = c.doRequest(clientRequest{
-		ctx:    ctx,
-		method: "GET",
-		url:    url,
-	}, &e)
 	return e, err
 }
@@ -21,7 +21,7 @@ func (c *Client) UpsertEdgeConfigSchema(ctx context.Context, request EdgeConfigS
 	payload := string(mustMarshal(request))
 	tflog.Info(ctx, "creating edge config schema", map[string]interface{}{
 		"url":     url,
-		"payload": payload,
+		"payload": redactPayload(payload),
 	})
 	err = c.doRequest(clientRequest{
 		ctx:    ctx,
@@ -37,7 +37,7 @@ func (c *Client) CreateEdgeConfigToken(ctx context.Context, request CreateEdgeCo
 	payload := string(mustMarshal(request))
 	tflog.Info(ctx, "creating edge config token", map[string]interface{}{
 		"url":     url,
-		"payload": payload,
+		"payload": redactPayload(payload),
 	})
 	err = c.doRequest(clientRequest{
 		ctx:    ctx,
@@ -72,7 +72,7 @@ func (c *Client) DeleteEdgeConfigToken(ctx context.Context, request EdgeConfigTo
 
 	tflog.Info(ctx, "deleting edge config token", map[string]interface{}{
 		"url":     url,
-		"payload": payload,
+		"payload": redactPayload(payload),
 	})
 	return c.doRequest(clientRequest{
 		ctx:    ctx,
@@ -33,7 +33,7 @@ func (c *Client) CreateEnvironmentVariable(ctx context.Context, request CreateEn
 
 	tflog.Info(ctx, "creating environment variable", map[string]interface{}{
 		"url":     url,
-		"payload": payload,
+		"payload": redactPayload(payload),
 	})
 	err = c.doRequest(clientRequest{
 		ctx:    ctx,
@@ -61,7 +61,7 @@ func (c *Client) CreateEnvironmentVariables(ctx context.Context, request CreateE
 	payload := string(mustMarshal(request.EnvironmentVariables))
 	tflog.Info(ctx, "creating environment variables", map[string]interface{}{
 		"url":     url,
-		"payload": payload,
+		"payload": redactPayload(payload),
 	})
 	return c.doRequest(clientRequest{
 		ctx:    ctx,
@@ -72,9 +72,9 @@ func (c *Client) CreateEnvironmentVariables(ctx context.Context, request CreateE
 }
 
 // UpdateEnvironmentVariableRequest defines the information that needs to be passed to Vercel in order to
-// update an environment variable.
+// update an environment variable. If no Value is specified, the existing value is left unchanged.
 type UpdateEnvironmentVariableRequest struct {
-	Value     string   `json:"value"`
+	Value     string   `json:"value,omitempty"`
 	Target    []string `json:"target"`
 	GitBranch *string  `json:"gitBranch,omitempty"`
 	Type      string   `json:"type"`
@@ -92,7 +92,7 @@ func (c *Client) UpdateEnvironmentVariable(ctx context.Context, request UpdateEn
 	payload := string(mustMarshal(request))
 	tflog.Info(ctx, "updating environment variable", map[string]interface{}{
 		"url":     url,
-		"payload": payload,
+		"payload": redactPayload(payload),
 	})
 	err = c.doRequest(clientRequest{
 		ctx:    ctx,
@@ -129,22 +129,17 @@ func (c *Client) GetEnvironmentVariables(ctx context.Context, projectID, teamID
 		url = fmt.Sprintf("%s&teamId=%s", url, c.teamID(teamID))
 	}
 
-	envResponse := struct {
-		Env []EnvironmentVariable `json:"envs"`
-	}{}
-	tflog.Info(ctx, "getting environment variables", map[string]interface{}{
-		"url": url,
+	envs, err := listAll[EnvironmentVariable](c, listRequest{
+		ctx:       ctx,
+		url:       url,
+		key:       "envs",
+		message:   "getting environment variables",
+		cacheable: true,
 	})
-	err := c.doRequest(clientRequest{
-		ctx:    ctx,
-		method: "GET",
-		url:    url,
-		body:   "",
-	}, &envResponse)
-	for _, env := range envResponse.Env {
-		env.TeamID = c.teamID(teamID)
+	for i := range envs {
+		envs[i].TeamID = c.teamID(teamID)
 	}
-	return envResponse.Env, err
+	return envs, err
 }
 
 // GetEnvironmentVariable gets a singluar environment variable from Vercel based on its ID.
@@ -2,10 +2,108 @@ package client
 
 import "errors"
 
+// Sentinel errors that an APIError can be compared against using errors.Is. These allow callers to
+// check for a category of failure without inspecting status codes or error codes directly.
+var (
+	// ErrNotFound indicates the requested entity does not exist.
+	ErrNotFound = errors.New("not found")
+	// ErrForbidden indicates the API token does not have permission to perform the request.
+	ErrForbidden = errors.New("forbidden")
+	// ErrConflict indicates a conflicting change is being made to the same entity, or the entity already exists.
+	ErrConflict = errors.New("conflict")
+	// ErrRateLimited indicates the API rate limit has been hit.
+	ErrRateLimited = errors.New("rate limited")
+	// ErrPaymentRequired indicates the request requires a plan upgrade, or a feature that is not enabled for the team.
+	ErrPaymentRequired = errors.New("payment required")
+	// ErrValidation indicates the request was rejected as invalid.
+	ErrValidation = errors.New("validation failed")
+)
+
+// sentinel maps an APIError onto one of the sentinel errors, based on its status code.
+func (e APIError) sentinel() error {
+	switch e.StatusCode {
+	case 400, 422:
+		return ErrValidation
+	case 402:
+		return ErrPaymentRequired
+	case 403:
+		return ErrForbidden
+	case 404:
+		return ErrNotFound
+	case 409:
+		return ErrConflict
+	case 429:
+		return ErrRateLimited
+	}
+	return nil
+}
+
+// Is allows an APIError to be compared against the sentinel errors using errors.Is.
+func (e APIError) Is(target error) bool {
+	sentinel := e.sentinel()
+	return sentinel != nil && sentinel == target
+}
+
+// hint gives an actionable suggestion for resolving an APIError, if one is available.
+func (e APIError) hint() string {
+	switch e.sentinel() {
+	case ErrPaymentRequired:
+		return "This may require upgrading your Vercel plan, or enabling the feature for your team."
+	case ErrForbidden:
+		return "Check that the API token has access to the team and resource being managed, and that the correct team is configured."
+	case ErrRateLimited:
+		return "The Vercel API rate limit was hit. Try again later, or reduce the parallelism of Terraform."
+	}
+	return ""
+}
+
 // NotFound detects if an error returned by the Vercel API was the result of an entity not existing.
 func NotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+// Conflict detects if an error returned by the Vercel API was the result of a conflicting change
+// being made to the same entity at the same time.
+func Conflict(err error) bool {
+	return errors.Is(err, ErrConflict)
+}
+
+// Forbidden detects if an error returned by the Vercel API was the result of the API token not
+// having permission to perform a request.
+func Forbidden(err error) bool {
+	return errors.Is(err, ErrForbidden)
+}
+
+// RateLimited detects if an error returned by the Vercel API was the result of hitting a rate limit.
+func RateLimited(err error) bool {
+	return errors.Is(err, ErrRateLimited)
+}
+
+// PaymentRequired detects if an error returned by the Vercel API was the result of a feature not being
+// available on the team's plan.
+func PaymentRequired(err error) bool {
+	return errors.Is(err, ErrPaymentRequired)
+}
+
+// Validation detects if an error returned by the Vercel API was the result of the request being invalid.
+func Validation(err error) bool {
+	return errors.Is(err, ErrValidation)
+}
+
+// HasCode detects if an error returned by the Vercel API has a specific error code, such as `missing_files`.
+func HasCode(err error, code string) bool {
+	var apiErr APIError
+	return errors.As(err, &apiErr) && apiErr.Code == code
+}
+
+// RequestID returns the Vercel request ID (from the `x-vercel-id` header) associated with an error returned
+// by the Vercel API, if there is one. This is useful when contacting Vercel support about a failed request.
+func RequestID(err error) string {
 	var apiErr APIError
-	return err != nil && errors.As(err, &apiErr) && apiErr.StatusCode == 404
+	if errors.As(err, &apiErr) {
+		return apiErr.RequestID
+	}
+	return ""
 }
 
 func noContent(err error) bool {
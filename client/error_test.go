@@ -0,0 +1,118 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestAPIErrorIs(t *testing.T) {
+	tests := []struct {
+		statusCode int
+		sentinel   error
+		helper     func(error) bool
+	}{
+		{statusCode: 400, sentinel: ErrValidation, helper: Validation},
+		{statusCode: 402, sentinel: ErrPaymentRequired, helper: PaymentRequired},
+		{statusCode: 403, sentinel: ErrForbidden, helper: Forbidden},
+		{statusCode: 404, sentinel: ErrNotFound, helper: NotFound},
+		{statusCode: 409, sentinel: ErrConflict, helper: Conflict},
+		{statusCode: 422, sentinel: ErrValidation, helper: Validation},
+		{statusCode: 429, sentinel: ErrRateLimited, helper: RateLimited},
+	}
+	sentinels := []error{ErrNotFound, ErrForbidden, ErrConflict, ErrRateLimited, ErrPaymentRequired, ErrValidation}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.statusCode), func(t *testing.T) {
+			err := fmt.Errorf("wrapped: %w", APIError{StatusCode: tt.statusCode})
+			if !errors.Is(err, tt.sentinel) {
+				t.Errorf("expected errors.Is(%d, %s) to be true", tt.statusCode, tt.sentinel)
+			}
+			if !tt.helper(err) {
+				t.Errorf("expected helper to detect status code %d", tt.statusCode)
+			}
+			for _, s := range sentinels {
+				if s != tt.sentinel && errors.Is(err, s) {
+					t.Errorf("expected errors.Is(%d, %s) to be false", tt.statusCode, s)
+				}
+			}
+		})
+	}
+
+	t.Run("non api error", func(t *testing.T) {
+		err := errors.New("some error")
+		for _, s := range sentinels {
+			if errors.Is(err, s) {
+				t.Errorf("expected errors.Is(%s) to be false", s)
+			}
+		}
+		if NotFound(nil) {
+			t.Errorf("expected a nil error to not be NotFound")
+		}
+	})
+}
+
+func TestAPIErrorFromResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("x-vercel-id", "iad1::abc123")
+		switch r.URL.Path {
+		case "/payment":
+			w.WriteHeader(402)
+			fmt.Fprint(w, `{"error":{"code":"payment_required","message":"This feature is only available on the Pro plan."}}`)
+		case "/empty":
+			w.WriteHeader(403)
+		default:
+			w.WriteHeader(404)
+			fmt.Fprint(w, `{"error":{"code":"not_found","message":"Project not found"}}`)
+		}
+	}))
+	defer server.Close()
+
+	c := New("token")
+	c.baseURL = server.URL
+	do := func(path string) error {
+		return c.doRequest(clientRequest{
+			ctx:    context.Background(),
+			method: "GET",
+			url:    server.URL + path,
+		}, nil)
+	}
+
+	err := do("/payment")
+	var apiErr APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected an APIError, got %T", err)
+	}
+	if apiErr.Code != "payment_required" {
+		t.Errorf("expected code payment_required, got %s", apiErr.Code)
+	}
+	if apiErr.RequestID != "iad1::abc123" || RequestID(err) != "iad1::abc123" {
+		t.Errorf("expected request ID iad1::abc123, got %s", apiErr.RequestID)
+	}
+	if !PaymentRequired(err) {
+		t.Errorf("expected a payment required error")
+	}
+	if !HasCode(err, "payment_required") {
+		t.Errorf("expected error to have code payment_required")
+	}
+	if !strings.Contains(err.Error(), "plan") || !strings.Contains(err.Error(), "(request ID: iad1::abc123)") {
+		t.Errorf("expected error message to include a hint and the request ID, got %q", err.Error())
+	}
+
+	err = do("/empty")
+	if !Forbidden(err) || RequestID(err) != "iad1::abc123" {
+		t.Errorf("expected a forbidden error with a request ID, got %v", err)
+	}
+	if !strings.HasPrefix(err.Error(), "403 - Forbidden") {
+		t.Errorf("expected error message to fall back to the status code, got %q", err.Error())
+	}
+
+	err = do("/missing")
+	if !NotFound(err) || HasCode(err, "payment_required") {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+}
@@ -1,8 +1,13 @@
 package client
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 type FirewallConfig struct {
@@ -38,6 +43,48 @@ type Condition struct {
 	Neg   bool   `json:"neg"`
 	Key   string `json:"key"`
 	Value string `json:"value"`
+	// Values is sent in place of Value for the `inc` and `ninc` operators, which match against a list.
+	Values []string `json:"-"`
+}
+
+// MarshalJSON encodes the condition, sending Values as the value of the condition if it is set.
+func (c Condition) MarshalJSON() ([]byte, error) {
+	type condition Condition
+	if c.Values == nil {
+		return json.Marshal(condition(c))
+	}
+	return json.Marshal(struct {
+		condition
+		Value []string `json:"value"`
+	}{
+		condition: condition(c),
+		Value:     c.Values,
+	})
+}
+
+// UnmarshalJSON decodes a condition, whose value may be a string, a number or a list of strings.
+func (c *Condition) UnmarshalJSON(b []byte) error {
+	type condition Condition
+	var raw struct {
+		condition
+		Value json.RawMessage `json:"value"`
+	}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	*c = Condition(raw.condition)
+	value := bytes.TrimSpace(raw.Value)
+	switch {
+	case len(value) == 0 || bytes.Equal(value, []byte("null")):
+	case value[0] == '[':
+		return json.Unmarshal(value, &c.Values)
+	case value[0] == '"':
+		return json.Unmarshal(value, &c.Value)
+	default:
+		// Numeric values, such as AS numbers, are kept in their textual form.
+		c.Value = string(value)
+	}
+	return nil
 }
 
 type Action struct {
@@ -118,3 +165,160 @@ func (c *Client) PutFirewallConfig(ctx context.Context, cfg FirewallConfig) (Fir
 	res.Active.TeamID = teamId
 	return res.Active, err
 }
+
+// PatchFirewallConfigRequest describes a single change to the firewall configuration of a project. Unlike
+// PutFirewallConfig, this leaves the rest of the configuration untouched, so separate owners can manage
+// individual rules.
+//
+// Action is one of `rules.insert`, `rules.update`, `rules.remove` or `rules.priority` for custom rules, or
+// `ip.insert`, `ip.update`, `ip.remove` or `ip.priority` for IP rules. ID identifies the rule for every action
+// other than an insert. Value is the rule for inserts and updates, and the new zero-based position of the
+// rule for priority changes.
+type PatchFirewallConfigRequest struct {
+	ProjectID string      `json:"-"`
+	TeamID    string      `json:"-"`
+	Action    string      `json:"action"`
+	ID        string      `json:"id,omitempty"`
+	Value     interface{} `json:"value"`
+}
+
+// PatchFirewallConfig applies a single change to the firewall configuration of a project.
+func (c *Client) PatchFirewallConfig(ctx context.Context, request PatchFirewallConfigRequest) error {
+	teamId := c.teamID(request.TeamID)
+	url := fmt.Sprintf(
+		"%s/v1/security/firewall/config?projectId=%s&teamId=%s",
+		c.baseURL,
+		request.ProjectID,
+		teamId,
+	)
+
+	payload := string(mustMarshal(request))
+	tflog.Info(ctx, "patching firewall config", map[string]interface{}{
+		"url":     url,
+		"payload": redactPayload(payload),
+	})
+	return c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "PATCH",
+		url:    url,
+		body:   payload,
+	}, nil)
+}
+
+// FirewallBypass is a system bypass rule, which allows requests from an IP address or CIDR range to skip the
+// system mitigations of a project, such as managed rulesets and Attack Challenge Mode.
+type FirewallBypass struct {
+	ID        string `json:"Id"`
+	TeamID    string `json:"-"`
+	ProjectID string `json:"ProjectId"`
+	// Domain is the domain the bypass applies to, or empty if the bypass applies to every domain of the project.
+	Domain        string `json:"Domain"`
+	IP            string `json:"Ip"`
+	Note          string `json:"Note"`
+	IsProjectRule bool   `json:"IsProjectRule"`
+}
+
+// FirewallBypassRequest identifies a system bypass rule. A bypass applies either to a single domain, or to every
+// domain of the project if ProjectScope is set.
+type FirewallBypassRequest struct {
+	ProjectID    string `json:"-"`
+	TeamID       string `json:"-"`
+	Domain       string `json:"domain,omitempty"`
+	SourceIP     string `json:"sourceIp"`
+	ProjectScope bool   `json:"projectScope,omitempty"`
+	Note         string `json:"note,omitempty"`
+}
+
+func (c *Client) firewallBypassURL(request FirewallBypassRequest, filter bool) string {
+	query := url.Values{}
+	query.Set("projectId", request.ProjectID)
+	if c.teamID(request.TeamID) != "" {
+		query.Set("teamId", c.teamID(request.TeamID))
+	}
+	if filter {
+		query.Set("sourceIp", request.SourceIP)
+		if request.Domain != "" {
+			query.Set("domain", request.Domain)
+		}
+	}
+	return fmt.Sprintf("%s/v1/security/firewall/bypass?%s", c.baseURL, query.Encode())
+}
+
+// CreateFirewallBypass adds a system bypass rule to a project.
+func (c *Client) CreateFirewallBypass(ctx context.Context, request FirewallBypassRequest) (b FirewallBypass, err error) {
+	endpoint := c.firewallBypassURL(request, false)
+	payload := string(mustMarshal(request))
+	tflog.Info(ctx, "creating firewall bypass", map[string]interface{}{
+		"url":     endpoint,
+		"payload": redactPayload(payload),
+	})
+	var res struct {
+		Result []FirewallBypass `json:"result"`
+	}
+	err = c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "POST",
+		url:    endpoint,
+		body:   payload,
+	}, &res)
+	if err != nil {
+		return b, err
+	}
+	if len(res.Result) == 0 {
+		return b, fmt.Errorf("the firewall bypass for %s was created, but not returned by the API", request.SourceIP)
+	}
+	b = res.Result[0]
+	b.TeamID = c.teamID(request.TeamID)
+	return b, nil
+}
+
+// GetFirewallBypass retrieves the system bypass rule for a source IP address or CIDR range, and domain. A 404
+// APIError is returned if there is no such rule.
+func (c *Client) GetFirewallBypass(ctx context.Context, request FirewallBypassRequest) (b FirewallBypass, err error) {
+	endpoint := c.firewallBypassURL(request, true)
+	tflog.Info(ctx, "getting firewall bypass", map[string]interface{}{
+		"url": endpoint,
+	})
+	var res struct {
+		Result []FirewallBypass `json:"result"`
+	}
+	err = c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "GET",
+		url:    endpoint,
+	}, &res)
+	if err != nil {
+		return b, err
+	}
+	for _, r := range res.Result {
+		if r.IP == request.SourceIP && (r.Domain == request.Domain || request.ProjectScope && r.IsProjectRule) {
+			r.TeamID = c.teamID(request.TeamID)
+			return r, nil
+		}
+	}
+	return b, APIError{
+		StatusCode: 404,
+		Message:    "Firewall bypass not found",
+		Code:       "not_found",
+	}
+}
+
+// DeleteFirewallBypass removes a system bypass rule from a project.
+func (c *Client) DeleteFirewallBypass(ctx context.Context, request FirewallBypassRequest) error {
+	endpoint := c.firewallBypassURL(request, false)
+	payload := string(mustMarshal(FirewallBypassRequest{
+		Domain:       request.Domain,
+		SourceIP:     request.SourceIP,
+		ProjectScope: request.ProjectScope,
+	}))
+	tflog.Info(ctx, "deleting firewall bypass", map[string]interface{}{
+		"url":     endpoint,
+		"payload": redactPayload(payload),
+	})
+	return c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "DELETE",
+		url:    endpoint,
+		body:   payload,
+	}, nil)
+}
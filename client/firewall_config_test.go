@@ -0,0 +1,53 @@
+package client
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestConditionJSON(t *testing.T) {
+	for _, tt := range []struct {
+		json      string
+		condition Condition
+		encoded   string
+	}{
+		{
+			json:      `{"type":"path","op":"eq","neg":false,"key":"","value":"/api"}`,
+			condition: Condition{Type: "path", Op: "eq", Value: "/api"},
+		},
+		{
+			json:      `{"type":"geo_country","op":"inc","neg":true,"key":"","value":["US","CA"]}`,
+			condition: Condition{Type: "geo_country", Op: "inc", Neg: true, Values: []string{"US", "CA"}},
+		},
+		{
+			json:      `{"type":"header","op":"ex","key":"x-debug"}`,
+			condition: Condition{Type: "header", Op: "ex", Key: "x-debug"},
+			encoded:   `{"type":"header","op":"ex","neg":false,"key":"x-debug","value":""}`,
+		},
+		{
+			json:      `{"type":"geo_as_number","op":"gt","neg":false,"key":"","value":13335}`,
+			condition: Condition{Type: "geo_as_number", Op: "gt", Value: "13335"},
+			encoded:   `{"type":"geo_as_number","op":"gt","neg":false,"key":"","value":"13335"}`,
+		},
+	} {
+		var c Condition
+		if err := json.Unmarshal([]byte(tt.json), &c); err != nil {
+			t.Fatalf("unexpected error decoding %s: %s", tt.json, err)
+		}
+		if !reflect.DeepEqual(c, tt.condition) {
+			t.Errorf("decoding %s: expected %+v, got %+v", tt.json, tt.condition, c)
+		}
+		b, err := json.Marshal(c)
+		if err != nil {
+			t.Fatalf("unexpected error encoding %+v: %s", c, err)
+		}
+		expected := tt.json
+		if tt.encoded != "" {
+			expected = tt.encoded
+		}
+		if string(b) != expected {
+			t.Errorf("encoding %+v: expected %s, got %s", c, expected, b)
+		}
+	}
+}
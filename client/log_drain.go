@@ -7,29 +7,39 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+// LogDrainSamplingRule sets the ratio of logs from a single source that are sent to a log drain.
+type LogDrainSamplingRule struct {
+	Source string  `json:"source"`
+	Rate   float64 `json:"rate"`
+}
+
 type LogDrain struct {
-	ID             string            `json:"id"`
-	TeamID         string            `json:"ownerId"`
-	DeliveryFormat string            `json:"deliveryFormat"`
-	Environments   []string          `json:"environments"`
-	Headers        map[string]string `json:"headers"`
-	ProjectIDs     []string          `json:"projectIds"`
-	SamplingRate   *float64          `json:"samplingRate"`
-	Secret         string            `json:"secret"`
-	Sources        []string          `json:"sources"`
-	Endpoint       string            `json:"url"`
+	ID             string                 `json:"id"`
+	TeamID         string                 `json:"ownerId"`
+	DeliveryFormat string                 `json:"deliveryFormat"`
+	Environments   []string               `json:"environments"`
+	Headers        map[string]string      `json:"headers"`
+	ProjectIDs     []string               `json:"projectIds"`
+	SamplingRate   *float64               `json:"samplingRate"`
+	SamplingRules  []LogDrainSamplingRule `json:"samplingRules"`
+	Filter         string                 `json:"filter"`
+	Secret         string                 `json:"secret"`
+	Sources        []string               `json:"sources"`
+	Endpoint       string                 `json:"url"`
 }
 
 type CreateLogDrainRequest struct {
-	TeamID         string            `json:"-"`
-	DeliveryFormat string            `json:"deliveryFormat"`
-	Environments   []string          `json:"environments"`
-	Headers        map[string]string `json:"headers,omitempty"`
-	ProjectIDs     []string          `json:"projectIds,omitempty"`
-	SamplingRate   float64           `json:"samplingRate,omitempty"`
-	Secret         string            `json:"secret,omitempty"`
-	Sources        []string          `json:"sources"`
-	Endpoint       string            `json:"url"`
+	TeamID         string                 `json:"-"`
+	DeliveryFormat string                 `json:"deliveryFormat"`
+	Environments   []string               `json:"environments"`
+	Headers        map[string]string      `json:"headers,omitempty"`
+	ProjectIDs     []string               `json:"projectIds,omitempty"`
+	SamplingRate   float64                `json:"samplingRate,omitempty"`
+	SamplingRules  []LogDrainSamplingRule `json:"samplingRules,omitempty"`
+	Filter         string                 `json:"filter,omitempty"`
+	Secret         string                 `json:"secret,omitempty"`
+	Sources        []string               `json:"sources"`
+	Endpoint       string                 `json:"url"`
 }
 
 func (c *Client) CreateLogDrain(ctx context.Context, request CreateLogDrainRequest) (l LogDrain, err error) {
@@ -40,7 +50,7 @@ func (c *Client) CreateLogDrain(ctx context.Context, request CreateLogDrainReque
 	payload := string(mustMarshal(request))
 	tflog.Info(ctx, "creating log drain", map[string]interface{}{
 		"url":     url,
-		"payload": payload,
+		"payload": redactPayload(payload),
 	})
 	err = c.doRequest(clientRequest{
 		ctx:    ctx,
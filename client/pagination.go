@@ -0,0 +1,114 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// pagination is the continuation information returned alongside a page of results by the Vercel API.
+type pagination struct {
+	Count int             `json:"count"`
+	Next  json.RawMessage `json:"next"`
+}
+
+// next returns the cursor for the following page of results, or an empty string if there are no more pages.
+// The cursor is usually a timestamp, but is treated as an opaque value.
+func (p pagination) next() string {
+	next := strings.Trim(string(p.Next), `"`)
+	if next == "null" {
+		return ""
+	}
+	return next
+}
+
+// listRequest defines a request to a paginated list endpoint.
+type listRequest struct {
+	ctx context.Context
+	// url is the url of the first page of results.
+	url string
+	// key is the field of the response that holds the results. If key is empty, the response is
+	// expected to be a bare JSON array, which cannot be paginated.
+	key string
+	// message is logged for each page that is requested.
+	message string
+	// cacheable marks each page as safe to serve from the read cache, if one is enabled.
+	cacheable bool
+}
+
+// listAll requests every page of results from a Vercel list endpoint, following the `pagination.next`
+// cursor until there are no more results. Each subsequent page is requested by passing the cursor
+// as the `until` query parameter.
+func listAll[T any](c *Client, req listRequest) (results []T, err error) {
+	pageURL := req.url
+	seen := map[string]bool{}
+	for {
+		tflog.Info(req.ctx, req.message, map[string]interface{}{
+			"url": pageURL,
+		})
+		if req.key == "" {
+			var page []T
+			err = c.doRequest(clientRequest{
+				ctx:    req.ctx,
+				method: "GET",
+				url:    pageURL,
+				body:   "",
+			}, &page)
+			return append(results, page...), err
+		}
+
+		var page map[string]json.RawMessage
+		err = c.doRequest(clientRequest{
+			ctx:       req.ctx,
+			method:    "GET",
+			url:       pageURL,
+			body:      "",
+			cacheable: req.cacheable,
+		}, &page)
+		if err != nil {
+			return results, err
+		}
+
+		var items []T
+		if raw, ok := page[req.key]; ok {
+			if err := json.Unmarshal(raw, &items); err != nil {
+				return results, fmt.Errorf("error unmarshaling %s: %w", req.key, err)
+			}
+		}
+		results = append(results, items...)
+
+		var p pagination
+		if raw, ok := page["pagination"]; ok {
+			if err := json.Unmarshal(raw, &p); err != nil {
+				return results, fmt.Errorf("error unmarshaling pagination: %w", err)
+			}
+		}
+		next := p.next()
+		// Guard against an endpoint returning the same cursor twice, which would otherwise loop forever.
+		if next == "" || len(items) == 0 || seen[next] {
+			return results, nil
+		}
+		seen[next] = true
+
+		pageURL, err = withQueryParam(req.url, "until", next)
+		if err != nil {
+			return results, err
+		}
+	}
+}
+
+// withQueryParam sets a query parameter on a url, replacing any existing value.
+func withQueryParam(rawURL, key, value string) (string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", fmt.Errorf("error parsing url %s: %w", rawURL, err)
+	}
+	q := u.Query()
+	q.Set(key, value)
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
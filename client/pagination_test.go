@@ -0,0 +1,110 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestListProjectsPagination(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.RawQuery)
+		if r.URL.Query().Get("teamId") != "team_123" || r.URL.Query().Get("limit") != "100" {
+			t.Errorf("expected teamId and limit to be preserved, got %s", r.URL.RawQuery)
+		}
+		switch r.URL.Query().Get("until") {
+		case "":
+			fmt.Fprint(w, `{"projects":[{"id":"prj_1"},{"id":"prj_2"}],"pagination":{"count":2,"next":1700000000002,"prev":null}}`)
+		case "1700000000002":
+			fmt.Fprint(w, `{"projects":[{"id":"prj_3"}],"pagination":{"count":1,"next":1700000000003,"prev":1700000000002}}`)
+		case "1700000000003":
+			fmt.Fprint(w, `{"projects":[{"id":"prj_4"}],"pagination":{"count":1,"next":null,"prev":1700000000003}}`)
+		default:
+			t.Errorf("unexpected cursor %s", r.URL.Query().Get("until"))
+			w.WriteHeader(400)
+		}
+	}))
+	defer server.Close()
+
+	c := New("token")
+	c.baseURL = server.URL
+	projects, err := c.ListProjects(context.Background(), "team_123")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(requests) != 3 {
+		t.Errorf("expected 3 requests, got %d", len(requests))
+	}
+	if len(projects) != 4 {
+		t.Fatalf("expected 4 projects, got %d", len(projects))
+	}
+	for i, p := range projects {
+		if p.ID != fmt.Sprintf("prj_%d", i+1) {
+			t.Errorf("expected project %d to be prj_%d, got %s", i, i+1, p.ID)
+		}
+		if p.TeamID != "team_123" {
+			t.Errorf("expected project %s to have team ID team_123, got %s", p.ID, p.TeamID)
+		}
+	}
+}
+
+func TestListAllStopsOnRepeatedCursor(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprint(w, `{"records":[{"id":"rec_1"}],"pagination":{"count":1,"next":"abc"}}`)
+	}))
+	defer server.Close()
+
+	c := New("token")
+	c.baseURL = server.URL
+	records, err := c.ListDNSRecords(context.Background(), "example.com", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if requests != 2 || len(records) != 2 {
+		t.Errorf("expected 2 requests and 2 records, got %d requests and %d records", requests, len(records))
+	}
+}
+
+func TestListAllUnpaginatedArray(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id":"ecfg_1","slug":"one"},{"id":"ecfg_2","slug":"two"}]`)
+	}))
+	defer server.Close()
+
+	c := New("token")
+	c.baseURL = server.URL
+	edgeConfigs, err := c.ListEdgeConfigs(context.Background(), "")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(edgeConfigs) != 2 {
+		t.Errorf("expected 2 edge configs, got %d", len(edgeConfigs))
+	}
+}
+
+func TestListAllError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("until") == "" {
+			fmt.Fprint(w, `{"data":[{"id":"env_1"}],"pagination":{"count":1,"next":1}}`)
+			return
+		}
+		w.WriteHeader(403)
+		fmt.Fprint(w, `{"error":{"code":"forbidden","message":"Not authorized"}}`)
+	}))
+	defer server.Close()
+
+	c := New("token")
+	c.baseURL = server.URL
+	envs, err := c.ListSharedEnvironmentVariables(context.Background(), "team_123")
+	if !Forbidden(err) {
+		t.Errorf("expected a forbidden error, got %v", err)
+	}
+	if len(envs) != 1 {
+		t.Errorf("expected the first page of results to be returned, got %d", len(envs))
+	}
+}
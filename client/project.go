@@ -66,7 +66,7 @@ func (c *Client) CreateProject(ctx context.Context, teamID string, request Creat
 	payload := string(mustMarshal(request))
 	tflog.Info(ctx, "creating project", map[string]interface{}{
 		"url":     url,
-		"payload": payload,
+		"payload": redactPayload(payload),
 	})
 	err = c.doRequest(clientRequest{
 		ctx:    ctx,
@@ -207,7 +207,9 @@ type GitComments struct {
 }
 
 type Security struct {
-	AttackModeEnabled bool `json:"attackModeEnabled"`
+	AttackModeEnabled     bool   `json:"attackModeEnabled"`
+	AttackModeUpdatedAt   int64  `json:"attackModeUpdatedAt"`
+	AttackModeActiveUntil *int64 `json:"attackModeActiveUntil"`
 }
 
 type ResourceConfig struct {
@@ -225,10 +227,11 @@ func (c *Client) GetProject(ctx context.Context, projectID, teamID string) (r Pr
 		"url": url,
 	})
 	err = c.doRequest(clientRequest{
-		ctx:    ctx,
-		method: "GET",
-		url:    url,
-		body:   "",
+		ctx:       ctx,
+		method:    "GET",
+		url:       url,
+		body:      "",
+		cacheable: true,
 	}, &r)
 	if err != nil {
 		return r, fmt.Errorf("unable to get project: %w", err)
@@ -238,29 +241,24 @@ func (c *Client) GetProject(ctx context.Context, projectID, teamID string) (r Pr
 	return r, err
 }
 
-// ListProjects lists the top 100 projects (no pagination) from within Vercel.
+// ListProjects lists all of the projects from within Vercel.
+// Projects are requested 100 at a time, following the pagination cursor until all have been read.
 func (c *Client) ListProjects(ctx context.Context, teamID string) (r []ProjectResponse, err error) {
 	url := fmt.Sprintf("%s/v8/projects?limit=100", c.baseURL)
 	if c.teamID(teamID) != "" {
 		url = fmt.Sprintf("%s&teamId=%s", url, c.teamID(teamID))
 	}
 
-	pr := struct {
-		Projects []ProjectResponse `json:"projects"`
-	}{}
-	tflog.Info(ctx, "listing projects", map[string]interface{}{
-		"url": url,
+	r, err = listAll[ProjectResponse](c, listRequest{
+		ctx:     ctx,
+		url:     url,
+		key:     "projects",
+		message: "listing projects",
 	})
-	err = c.doRequest(clientRequest{
-		ctx:    ctx,
-		method: "GET",
-		url:    url,
-		body:   "",
-	}, &pr)
-	for _, p := range pr.Projects {
-		p.TeamID = c.teamID(teamID)
+	for i := range r {
+		r[i].TeamID = c.teamID(teamID)
 	}
-	return pr.Projects, err
+	return r, err
 }
 
 // UpdateProjectRequest defines the possible fields that can be updated within a vercel project.
@@ -308,7 +306,7 @@ func (c *Client) UpdateProject(ctx context.Context, projectID, teamID string, re
 	payload := string(mustMarshal(request))
 	tflog.Info(ctx, "updating project", map[string]interface{}{
 		"url":     url,
-		"payload": payload,
+		"payload": redactPayload(payload),
 	})
 	err = c.doRequest(clientRequest{
 		ctx:    ctx,
@@ -338,7 +336,7 @@ func (c *Client) UpdateProductionBranch(ctx context.Context, request UpdateProdu
 	payload := string(mustMarshal(request))
 	tflog.Info(ctx, "updating project production branch", map[string]interface{}{
 		"url":     url,
-		"payload": payload,
+		"payload": redactPayload(payload),
 	})
 	err = c.doRequest(clientRequest{
 		ctx:    ctx,
@@ -35,7 +35,7 @@ func (c *Client) DeleteDeploymentRetention(ctx context.Context, projectID, teamI
 
 	tflog.Info(ctx, "updating deployment expiration", map[string]interface{}{
 		"url":     url,
-		"payload": payload,
+		"payload": redactPayload(payload),
 	})
 	err := c.doRequest(clientRequest{
 		ctx:    ctx,
@@ -96,7 +96,7 @@ func (c *Client) UpdateDeploymentRetention(ctx context.Context, request UpdateDe
 
 	tflog.Info(ctx, "updating deployment expiration", map[string]interface{}{
 		"url":     url,
-		"payload": payload,
+		"payload": redactPayload(payload),
 	})
 	var d deploymentExpirationResponse
 	err := c.doRequest(clientRequest{
@@ -28,7 +28,7 @@ func (c *Client) CreateProjectDomain(ctx context.Context, projectID, teamID stri
 	payload := string(mustMarshal(request))
 	tflog.Info(ctx, "creating project domain", map[string]interface{}{
 		"url":     url,
-		"payload": payload,
+		"payload": redactPayload(payload),
 	})
 	err = c.doRequest(clientRequest{
 		ctx:    ctx,
@@ -61,12 +61,23 @@ func (c *Client) DeleteProjectDomain(ctx context.Context, projectID, domain, tea
 // ProjectDomainResponse defines the information that Vercel exposes about a domain that is
 // associated with a vercel project.
 type ProjectDomainResponse struct {
-	Name               string  `json:"name"`
-	ProjectID          string  `json:"projectId"`
-	TeamID             string  `json:"-"`
-	Redirect           *string `json:"redirect"`
-	RedirectStatusCode *int64  `json:"redirectStatusCode"`
-	GitBranch          *string `json:"gitBranch"`
+	Name               string                      `json:"name"`
+	ProjectID          string                      `json:"projectId"`
+	TeamID             string                      `json:"-"`
+	Redirect           *string                     `json:"redirect"`
+	RedirectStatusCode *int64                      `json:"redirectStatusCode"`
+	GitBranch          *string                     `json:"gitBranch"`
+	Verified           bool                        `json:"verified"`
+	Verification       []ProjectDomainVerification `json:"verification"`
+}
+
+// ProjectDomainVerification is a DNS record that must be created to prove ownership of a project domain
+// that is in use by another Vercel account or team. Type is typically `TXT`.
+type ProjectDomainVerification struct {
+	Type   string `json:"type"`
+	Domain string `json:"domain"`
+	Value  string `json:"value"`
+	Reason string `json:"reason"`
 }
 
 // GetProjectDomain retrieves information about a project domain from Vercel.
@@ -106,7 +117,7 @@ func (c *Client) UpdateProjectDomain(ctx context.Context, projectID, domain, tea
 	payload := string(mustMarshal(request))
 	tflog.Info(ctx, "updating project domain", map[string]interface{}{
 		"url":     url,
-		"payload": payload,
+		"payload": redactPayload(payload),
 	})
 	err = c.doRequest(clientRequest{
 		ctx:    ctx,
@@ -117,3 +128,24 @@ func (c *Client) UpdateProjectDomain(ctx context.Context, projectID, domain, tea
 	r.TeamID = c.teamID(teamID)
 	return r, err
 }
+
+// VerifyProjectDomain asks Vercel to check the verification records of a project domain again. It returns an
+// error if the domain still cannot be verified.
+func (c *Client) VerifyProjectDomain(ctx context.Context, projectID, domain, teamID string) (r ProjectDomainResponse, err error) {
+	url := fmt.Sprintf("%s/v9/projects/%s/domains/%s/verify", c.baseURL, projectID, domain)
+	if c.teamID(teamID) != "" {
+		url = fmt.Sprintf("%s?teamId=%s", url, c.teamID(teamID))
+	}
+
+	tflog.Info(ctx, "verifying project domain", map[string]interface{}{
+		"url": url,
+	})
+	err = c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "POST",
+		url:    url,
+		body:   "",
+	}, &r)
+	r.TeamID = c.teamID(teamID)
+	return r, err
+}
@@ -0,0 +1,51 @@
+package client
+
+import (
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// projectLocks serializes writes made to the same project, so that Terraform applying many changes to a single
+// project in parallel does not cause conflicting updates. Reads are never locked, and writes to different
+// projects continue to run in parallel.
+type projectLocks struct {
+	mu    sync.Mutex
+	locks map[requestScope]chan struct{}
+}
+
+func newProjectLocks() *projectLocks {
+	return &projectLocks{
+		locks: map[requestScope]chan struct{}{},
+	}
+}
+
+// lock acquires the write lock for the project a request relates to, and returns a function to release it.
+// Requests that do not relate to a single project are not locked. If the context of the request is cancelled
+// while waiting for the lock, the context's error is returned instead.
+func (l *projectLocks) lock(req clientRequest) (func(), error) {
+	scope := scopeFor(req.url)
+	if scope.projectID == "" {
+		return func() {}, nil
+	}
+
+	l.mu.Lock()
+	// Each lock is a channel with a single slot, which is held while the slot is filled.
+	m, ok := l.locks[scope]
+	if !ok {
+		m = make(chan struct{}, 1)
+		l.locks[scope] = m
+	}
+	l.mu.Unlock()
+
+	tflog.Trace(req.ctx, "waiting for project write lock", map[string]interface{}{
+		"project_id": scope.projectID,
+		"team_id":    scope.teamID,
+	})
+	select {
+	case m <- struct{}{}:
+		return func() { <-m }, nil
+	case <-req.ctx.Done():
+		return nil, req.ctx.Err()
+	}
+}
@@ -0,0 +1,128 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// concurrencyServer records the maximum number of requests that were in flight at once.
+type concurrencyServer struct {
+	mu       sync.Mutex
+	inFlight int
+	max      int
+}
+
+func (s *concurrencyServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.inFlight++
+	if s.inFlight > s.max {
+		s.max = s.inFlight
+	}
+	s.mu.Unlock()
+
+	time.Sleep(20 * time.Millisecond)
+
+	s.mu.Lock()
+	s.inFlight--
+	s.mu.Unlock()
+	fmt.Fprint(w, `{}`)
+}
+
+func TestSerializedProjectWrites(t *testing.T) {
+	tests := []struct {
+		name        string
+		request     func(c *Client, i int) error
+		expectedMax func(max int) bool
+	}{
+		{
+			name: "writes to the same project are serialized",
+			request: func(c *Client, i int) error {
+				return c.DeleteEnvironmentVariable(context.Background(), "prj_1", "", fmt.Sprintf("env_%d", i))
+			},
+			expectedMax: func(max int) bool { return max == 1 },
+		},
+		{
+			name: "writes to different projects run in parallel",
+			request: func(c *Client, i int) error {
+				return c.DeleteEnvironmentVariable(context.Background(), fmt.Sprintf("prj_%d", i), "", "env_1")
+			},
+			expectedMax: func(max int) bool { return max > 1 },
+		},
+		{
+			name: "firewall changes to the same project are serialized",
+			request: func(c *Client, i int) error {
+				return c.PatchFirewallConfig(context.Background(), PatchFirewallConfigRequest{
+					ProjectID: "prj_1",
+					Action:    "rules.remove",
+					ID:        fmt.Sprintf("rule_%d", i),
+				})
+			},
+			expectedMax: func(max int) bool { return max == 1 },
+		},
+		{
+			name: "reads run in parallel",
+			request: func(c *Client, i int) error {
+				_, err := c.GetEnvironmentVariable(context.Background(), "prj_1", "", fmt.Sprintf("env_%d", i))
+				return err
+			},
+			expectedMax: func(max int) bool { return max > 1 },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &concurrencyServer{}
+			server := httptest.NewServer(s)
+			defer server.Close()
+			c := New("token").WithSerializedProjectWrites()
+			c.baseURL = server.URL
+
+			var wg sync.WaitGroup
+			for i := 0; i < 5; i++ {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+					if err := tt.request(c, i); err != nil {
+						t.Errorf("unexpected error: %s", err)
+					}
+				}(i)
+			}
+			wg.Wait()
+
+			if !tt.expectedMax(s.max) {
+				t.Errorf("unexpected maximum concurrency of %d", s.max)
+			}
+		})
+	}
+}
+
+func TestSerializedProjectWritesCancelled(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		fmt.Fprint(w, `{}`)
+	}))
+	defer server.Close()
+	defer close(release)
+	c := New("token").WithSerializedProjectWrites()
+	c.baseURL = server.URL
+
+	// Hold the lock for prj_1 with a request that doesn't complete until the test has finished.
+	go func() {
+		_ = c.DeleteEnvironmentVariable(context.Background(), "prj_1", "", "env_1")
+	}()
+	time.Sleep(20 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	err := c.DeleteEnvironmentVariable(ctx, "prj_1", "", "env_2")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected a write waiting for the lock to be cancelled, got %v", err)
+	}
+}
@@ -43,7 +43,7 @@ func (c *Client) UpdateProtectionBypassForAutomation(ctx context.Context, reques
 	payload := getUpdateBypassProtectionRequestBody(request.NewValue, request.Secret)
 	tflog.Info(ctx, "updating protection bypass", map[string]interface{}{
 		"url":      url,
-		"payload":  payload,
+		"payload":  redactPayload(payload),
 		"newValue": request.NewValue,
 	})
 	response := struct {
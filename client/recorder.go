@@ -0,0 +1,306 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// RecorderMode determines whether a Recorder records real API interactions, or replays previously
+// recorded ones.
+type RecorderMode string
+
+const (
+	// RecorderModeRecord passes requests through to the API, saving each interaction to a cassette.
+	RecorderModeRecord RecorderMode = "record"
+	// RecorderModeReplay serves responses from a cassette, without making any requests to the API.
+	RecorderModeReplay RecorderMode = "replay"
+)
+
+// ErrNoInteraction is returned by a replaying Recorder when a request does not match any remaining
+// interaction in the cassette.
+var ErrNoInteraction = errors.New("no recorded interaction matches the request")
+
+// cassette is the on-disk format of a set of recorded interactions.
+type cassette struct {
+	Interactions []interaction `json:"interactions"`
+}
+
+type interaction struct {
+	Request  recordedRequest  `json:"request"`
+	Response recordedResponse `json:"response"`
+}
+
+type recordedRequest struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Body   string `json:"body,omitempty"`
+}
+
+type recordedResponse struct {
+	StatusCode int         `json:"statusCode"`
+	Headers    http.Header `json:"headers,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// recordedHeaders are the response headers that the client makes use of, and so are kept in cassettes.
+var recordedHeaders = []string{"Content-Type", "Retry-After", "X-Vercel-Id"}
+
+// Recorder is an http.RoundTripper that records API interactions to, or replays them from, a cassette
+// file. It is intended to allow the acceptance tests to be run without access to the Vercel API.
+//
+// Recorded cassettes are sanitized: the Authorization header is never saved, request bodies are
+// redacted as they are when logged, credentials within response bodies are redacted, and any values
+// registered with Sanitize are replaced with their placeholders. As secrets are redacted, requests are matched against the cassette
+// after being sanitized in the same way. Each recorded interaction is replayed at most once, and
+// identical requests are replayed in the order they were recorded.
+type Recorder struct {
+	mode      RecorderMode
+	transport http.RoundTripper
+
+	mu           sync.Mutex
+	path         string
+	interactions []interaction
+	used         []bool
+	replacer     []string
+}
+
+// NewRecorder creates a Recorder in the given mode. In record mode, requests are made using the given
+// transport, or http.DefaultTransport if it is nil.
+func NewRecorder(mode RecorderMode, transport http.RoundTripper) (*Recorder, error) {
+	if mode != RecorderModeRecord && mode != RecorderModeReplay {
+		return nil, fmt.Errorf("invalid recorder mode %q, expected %q or %q", mode, RecorderModeRecord, RecorderModeReplay)
+	}
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	return &Recorder{
+		mode:      mode,
+		transport: transport,
+	}, nil
+}
+
+// Mode returns the mode of the Recorder.
+func (r *Recorder) Mode() RecorderMode {
+	return r.mode
+}
+
+// Cassette returns the path of the cassette currently in use, if any.
+func (r *Recorder) Cassette() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.path
+}
+
+// Start begins using the cassette at the given path, discarding any previous interactions and
+// sanitized values. In replay mode, the cassette is loaded from disk, and an error wrapping
+// os.ErrNotExist is returned if it has not been recorded.
+func (r *Recorder) Start(path string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.path = path
+	r.interactions = nil
+	r.used = nil
+	r.replacer = nil
+	if r.mode == RecorderModeRecord {
+		return nil
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("error reading cassette: %w", err)
+	}
+	var c cassette
+	if err := json.Unmarshal(b, &c); err != nil {
+		return fmt.Errorf("error parsing cassette %s: %w", path, err)
+	}
+	r.interactions = c.Interactions
+	r.used = make([]bool, len(c.Interactions))
+	return nil
+}
+
+// Sanitize replaces every occurrence of value with placeholder in recorded interactions. This is used
+// to keep values that differ between runs, such as randomly generated resource names, out of cassettes,
+// so that replayed requests can be matched deterministically. It has no effect in replay mode.
+func (r *Recorder) Sanitize(value, placeholder string) {
+	if value == "" || r.mode != RecorderModeRecord {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.replacer = append(r.replacer, value, placeholder)
+}
+
+// Stop finishes using the current cassette. In record mode, the recorded interactions are saved to it.
+func (r *Recorder) Stop() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.path == "" {
+		return nil
+	}
+	path := r.path
+	r.path = ""
+	if r.mode != RecorderModeRecord {
+		return nil
+	}
+
+	b, err := json.MarshalIndent(cassette{Interactions: r.interactions}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error marshaling cassette: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("error creating cassette directory: %w", err)
+	}
+	if err := os.WriteFile(path, append(b, '\n'), 0o644); err != nil {
+		return fmt.Errorf("error writing cassette: %w", err)
+	}
+	return nil
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	if r.mode == RecorderModeReplay {
+		return r.replay(req, r.sanitizeRequest(req, body))
+	}
+	return r.record(req, body)
+}
+
+func (r *Recorder) record(req *http.Request, body []byte) (*http.Response, error) {
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	recorded := recordedResponse{
+		StatusCode: resp.StatusCode,
+		Headers:    http.Header{},
+		Body:       r.replace(redactSecrets(string(respBody))),
+	}
+	for _, h := range recordedHeaders {
+		if v := resp.Header.Get(h); v != "" {
+			recorded.Headers.Set(h, v)
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.interactions = append(r.interactions, interaction{
+		Request:  r.sanitizeRequestLocked(req, body),
+		Response: recorded,
+	})
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request, recorded recordedRequest) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, in := range r.interactions {
+		if r.used[i] || in.Request != recorded {
+			continue
+		}
+		r.used[i] = true
+		header := in.Response.Headers.Clone()
+		if header == nil {
+			header = http.Header{}
+		}
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", in.Response.StatusCode, http.StatusText(in.Response.StatusCode)),
+			StatusCode:    in.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          io.NopCloser(strings.NewReader(in.Response.Body)),
+			ContentLength: int64(len(in.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+	return nil, fmt.Errorf("%w: %s %s in cassette %s", ErrNoInteraction, recorded.Method, recorded.URL, r.path)
+}
+
+func (r *Recorder) sanitizeRequest(req *http.Request, body []byte) recordedRequest {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.sanitizeRequestLocked(req, body)
+}
+
+func (r *Recorder) sanitizeRequestLocked(req *http.Request, body []byte) recordedRequest {
+	replacer := strings.NewReplacer(r.replacer...)
+	return recordedRequest{
+		Method: req.Method,
+		URL:    replacer.Replace(req.URL.String()),
+		Body:   replacer.Replace(redactPayload(string(body))),
+	}
+}
+
+func (r *Recorder) replace(s string) string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return strings.NewReplacer(r.replacer...).Replace(s)
+}
+
+// credentialFields are JSON fields of a response that hold a credential, which are redacted in cassettes.
+// Other values that redactPayload masks in logs, such as email addresses, webhook secrets and Edge Config
+// tokens, are kept, as the provider and the acceptance tests compare them against configuration.
+var credentialFields = map[string]bool{
+	"authCode": true,
+	"password": true,
+}
+
+// redactSecrets masks credentials within a JSON response body.
+func redactSecrets(body string) string {
+	var v interface{}
+	if err := json.Unmarshal([]byte(body), &v); err != nil {
+		return body
+	}
+	b, err := json.Marshal(redactSecretFields(v))
+	if err != nil {
+		return body
+	}
+	return string(b)
+}
+
+func redactSecretFields(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, value := range v {
+			if credentialFields[k] {
+				v[k] = redactAll(value)
+				continue
+			}
+			v[k] = redactSecretFields(value)
+		}
+		return v
+	case []interface{}:
+		for i := range v {
+			v[i] = redactSecretFields(v[i])
+		}
+		return v
+	default:
+		return v
+	}
+}
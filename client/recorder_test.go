@@ -0,0 +1,124 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRecorderRecordAndReplay(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("x-vercel-id", "req_1")
+		w.Header().Set("Set-Cookie", "session=secret")
+		if r.Method == "POST" {
+			fmt.Fprintf(w, `{"id":"ecfg_1","token":"tkn_1","authCode":"auth_secret","request":%s}`, body)
+			return
+		}
+		fmt.Fprint(w, `{"id":"ecfg_1","slug":"config-abc123"}`)
+	}))
+	t.Cleanup(server.Close)
+	cassette := filepath.Join(t.TempDir(), "cassettes", "test.json")
+
+	recorder, err := NewRecorder(RecorderModeRecord, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := recorder.Start(cassette); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	recorder.Sanitize("abc123", "placeholder")
+	c := New("token").WithTransport(recorder)
+	c.baseURL = server.URL
+	ctx := context.Background()
+
+	created, err := c.CreateEdgeConfig(ctx, CreateEdgeConfigRequest{Name: "config-abc123", TeamID: "team_1"})
+	if err != nil {
+		t.Fatalf("unexpected error recording: %s", err)
+	}
+	if created.ID != "ecfg_1" {
+		t.Errorf("expected the real response while recording, got %v", created)
+	}
+	if _, err := c.GetEdgeConfig(ctx, "ecfg_1", "team_1"); err != nil {
+		t.Fatalf("unexpected error recording: %s", err)
+	}
+	if err := recorder.Stop(); err != nil {
+		t.Fatalf("unexpected error saving cassette: %s", err)
+	}
+
+	b, err := os.ReadFile(cassette)
+	if err != nil {
+		t.Fatalf("unexpected error reading cassette: %s", err)
+	}
+	for _, secret := range []string{"auth_secret", "session=secret", "Bearer", "abc123"} {
+		if strings.Contains(string(b), secret) {
+			t.Errorf("expected cassette to be sanitized, but found %q in %s", secret, b)
+		}
+	}
+	// Values the acceptance tests compare against, such as tokens, are kept.
+	if !strings.Contains(string(b), "tkn_1") {
+		t.Errorf("expected cassette to keep the token, got %s", b)
+	}
+
+	recorder, err = NewRecorder(RecorderModeReplay, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := recorder.Start(cassette); err != nil {
+		t.Fatalf("unexpected error loading cassette: %s", err)
+	}
+	c = New("token").WithTransport(recorder)
+	c.baseURL = server.URL
+	server.Close()
+
+	// Requests are matched after sanitization, so the placeholder must be used when replaying.
+	created, err = c.CreateEdgeConfig(ctx, CreateEdgeConfigRequest{Name: "config-placeholder", TeamID: "team_1"})
+	if err != nil {
+		t.Fatalf("unexpected error replaying: %s", err)
+	}
+	if created.ID != "ecfg_1" {
+		t.Errorf("unexpected replayed response %v", created)
+	}
+	got, err := c.GetEdgeConfig(ctx, "ecfg_1", "team_1")
+	if err != nil {
+		t.Fatalf("unexpected error replaying: %s", err)
+	}
+	if got.Slug != "config-placeholder" {
+		t.Errorf("expected replayed response to be sanitized, got %v", got)
+	}
+	if requests != 2 {
+		t.Errorf("expected no requests to be made while replaying, got %d requests", requests)
+	}
+
+	// Each interaction is only replayed once.
+	_, err = c.GetEdgeConfig(ctx, "ecfg_1", "team_1")
+	if !errors.Is(err, ErrNoInteraction) {
+		t.Errorf("expected ErrNoInteraction, got %v", err)
+	}
+}
+
+func TestRecorderReplayMissingCassette(t *testing.T) {
+	recorder, err := NewRecorder(RecorderModeReplay, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	err = recorder.Start(filepath.Join(t.TempDir(), "missing.json"))
+	if !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("expected os.ErrNotExist, got %v", err)
+	}
+}
+
+func TestNewRecorderInvalidMode(t *testing.T) {
+	if _, err := NewRecorder("rewind", nil); err == nil {
+		t.Error("expected an error for an invalid mode")
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -21,17 +22,146 @@ import (
 var version = "dev"
 
 // APIError is an error type that exposes additional information about why an API request failed.
+// It can be compared against the sentinel errors, such as ErrNotFound, using errors.Is.
 type APIError struct {
 	Code       string `json:"code"`
 	Message    string `json:"message"`
 	StatusCode int
 	RawMessage []byte
+	// RequestID is the value of the `x-vercel-id` header, which identifies the request to Vercel support.
+	RequestID string
+	// retryAfter is how long to wait, in seconds, before retrying a rate limited request.
 	retryAfter int
 }
 
 // Error provides a user friendly error message.
 func (e APIError) Error() string {
-	return fmt.Sprintf("%s - %s", e.Code, e.Message)
+	code, message := e.Code, e.Message
+	if code == "" && message == "" {
+		code, message = strconv.Itoa(e.StatusCode), http.StatusText(e.StatusCode)
+	}
+	msg := fmt.Sprintf("%s - %s", code, message)
+	if hint := e.hint(); hint != "" {
+		msg = fmt.Sprintf("%s. %s", strings.TrimSuffix(msg, "."), hint)
+	}
+	if e.RequestID != "" {
+		msg = fmt.Sprintf("%s (request ID: %s)", msg, e.RequestID)
+	}
+	return msg
+}
+
+// redacted is the value that secrets are replaced with when a payload is logged.
+const redacted = "***"
+
+// secretFields are JSON fields that hold a secret value wherever they appear in a request payload.
+// Email addresses are not secret, but are personal data that should not end up in logs.
+var secretFields = map[string]bool{
+	"authCode": true,
+	"email":    true,
+	"password": true,
+	"secret":   true,
+	"token":    true,
+	"tokens":   true,
+}
+
+// secretMapFields are JSON fields holding an object where every value is potentially secret, such as
+// the `env` and `build.env` of a deployment, or the headers of a log drain.
+var secretMapFields = map[string]bool{
+	"env":     true,
+	"headers": true,
+}
+
+// privateKeyPEM matches a PEM encoded private key, such as the `key` of a custom certificate, which is
+// redacted wherever it appears.
+var privateKeyPEM = regexp.MustCompile(`-----BEGIN [A-Z0-9 ]*PRIVATE KEY-----`)
+
+// redactPayload masks any secret values within a JSON request payload, so that the payload can be
+// safely logged. This covers known secret fields, the values of environment variables that are not
+// of the `plain` type, the contents of environment and header maps, and PEM encoded private keys.
+// Payloads that cannot be parsed as JSON are redacted entirely, as their contents are unknown.
+func redactPayload(payload string) string {
+	if payload == "" {
+		return payload
+	}
+	var v interface{}
+	if err := json.Unmarshal([]byte(payload), &v); err != nil {
+		return redacted
+	}
+	b, err := json.Marshal(redactValue(v))
+	if err != nil {
+		return redacted
+	}
+	return string(b)
+}
+
+func redactValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, value := range v {
+			switch {
+			case value == nil:
+			case secretFields[k], secretMapFields[k]:
+				v[k] = redactAll(value)
+			default:
+				v[k] = redactValue(value)
+			}
+		}
+		if isEnvironmentVariable(v) {
+			v["value"] = redacted
+		}
+		return v
+	case []interface{}:
+		for i := range v {
+			v[i] = redactValue(v[i])
+		}
+		return v
+	case string:
+		if privateKeyPEM.MatchString(v) {
+			return redacted
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// redactAll replaces every scalar value within v, preserving its structure so that the shape
+// of the payload is still visible in logs.
+func redactAll(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k := range v {
+			v[k] = redactAll(v[k])
+		}
+		return v
+	case []interface{}:
+		for i := range v {
+			v[i] = redactAll(v[i])
+		}
+		return v
+	case string:
+		return redacted
+	default:
+		return v
+	}
+}
+
+// isEnvironmentVariable detects whether a JSON object is an environment variable with a value that
+// should not be logged. Environment variables are identified by having a `value` alongside either a
+// `target`, or a `key` (and not a firewall condition `op`). Environment variables explicitly of the
+// `plain` type are not considered secret.
+func isEnvironmentVariable(v map[string]interface{}) bool {
+	value, ok := v["value"].(string)
+	if !ok || value == "" {
+		return false
+	}
+	if t, ok := v["type"].(string); ok && t == "plain" {
+		return false
+	}
+	_, hasTarget := v["target"]
+	_, hasKey := v["key"]
+	_, hasOp := v["op"]
+	return hasTarget || (hasKey && !hasOp)
 }
 
 type clientRequest struct {
@@ -40,6 +170,9 @@ type clientRequest struct {
 	url              string
 	body             string
 	errorOnNoContent bool
+	// cacheable marks a GET request as safe to serve from the read cache, if one is enabled.
+	// Requests that poll for changes, such as waiting for a deployment, must not be cacheable.
+	cacheable bool
 }
 
 func (cr *clientRequest) toHTTPRequest() (*http.Request, error) {
@@ -61,6 +194,8 @@ func (cr *clientRequest) toHTTPRequest() (*http.Request, error) {
 
 // doRequest is a helper function for consistently requesting data from vercel.
 // This manages:
+// - Serving cacheable reads from the read cache, and invalidating it on writes
+// - Serializing writes to the same project, if enabled
 // - Setting the default Content-Type for requests with a body
 // - Setting the User-Agent
 // - Authorization via the Bearer token
@@ -69,6 +204,33 @@ func (cr *clientRequest) toHTTPRequest() (*http.Request, error) {
 // - Parsing a Retry-After header in the case of rate limits being hit
 // - In the case of a rate-limit being hit, trying again aftera period of time
 func (c *Client) doRequest(req clientRequest, v interface{}) error {
+	if c.locks != nil && req.method != "GET" {
+		unlock, err := c.locks.lock(req)
+		if err != nil {
+			return err
+		}
+		defer unlock()
+	}
+	if c.cache == nil {
+		return c.doUncachedRequest(req, v)
+	}
+	if req.method == "GET" {
+		if req.cacheable {
+			return c.cache.do(req, v, func(body *json.RawMessage) error {
+				return c.doUncachedRequest(req, body)
+			})
+		}
+		return c.doUncachedRequest(req, v)
+	}
+	// Invalidate both before and after the write, so that any read made while the write is
+	// in progress is not cached.
+	c.cache.invalidate(req.url)
+	defer c.cache.invalidate(req.url)
+	return c.doUncachedRequest(req, v)
+}
+
+// doUncachedRequest makes a request to the Vercel API, retrying if a rate limit is hit.
+func (c *Client) doUncachedRequest(req clientRequest, v interface{}) error {
 	r, err := req.toHTTPRequest()
 	if err != nil {
 		return err
@@ -76,15 +238,19 @@ func (c *Client) doRequest(req clientRequest, v interface{}) error {
 	err = c._doRequest(r, v, req.errorOnNoContent)
 	for retries := 0; retries < 3; retries++ {
 		var apiErr APIError
-		if errors.As(err, &apiErr) && // we received an api error
-			apiErr.StatusCode == 429 && // and it was a rate limit
+		if RateLimited(err) && // the rate limit was hit
+			errors.As(err, &apiErr) && // and we received an api error
 			apiErr.retryAfter > 0 && // and there was a retry time
 			apiErr.retryAfter < 5*60 { // and the retry time is less than 5 minutes
 			tflog.Error(req.ctx, "Rate limit was hit", map[string]interface{}{
 				"error":      apiErr,
 				"retryAfter": apiErr.retryAfter,
 			})
-			time.Sleep(time.Duration(apiErr.retryAfter) * time.Second)
+			select {
+			case <-req.ctx.Done():
+				return req.ctx.Err()
+			case <-time.After(time.Duration(apiErr.retryAfter) * time.Second):
+			}
 			r, err = req.toHTTPRequest()
 			if err != nil {
 				return err
@@ -116,7 +282,9 @@ func (c *Client) _doRequest(req *http.Request, v interface{}, errorOnNoContent b
 	}
 
 	if resp.StatusCode >= 300 {
-		var errorResponse APIError
+		errorResponse := APIError{
+			RequestID: resp.Header.Get("x-vercel-id"),
+		}
 		if string(responseBody) == "" {
 			errorResponse.StatusCode = resp.StatusCode
 			return errorResponse
@@ -131,7 +299,7 @@ func (c *Client) _doRequest(req *http.Request, v interface{}, errorOnNoContent b
 		}
 		errorResponse.StatusCode = resp.StatusCode
 		errorResponse.RawMessage = responseBody
-		errorResponse.retryAfter = 1000 // set a sensible default for retrying. This is in milliseconds.
+		errorResponse.retryAfter = 1 // set a sensible default for retrying. This is in seconds.
 		if resp.StatusCode == 429 {
 			retryAfterRaw := resp.Header.Get("Retry-After")
 			if retryAfterRaw != "" {
@@ -43,7 +43,7 @@ func (c *Client) CreateSharedEnvironmentVariable(ctx context.Context, request Cr
 	payload := string(mustMarshal(request.EnvironmentVariable))
 	tflog.Info(ctx, "creating shared environment variable", map[string]interface{}{
 		"url":     url,
-		"payload": payload,
+		"payload": redactPayload(payload),
 	})
 	var response struct {
 		Created []SharedEnvironmentVariableResponse `json:"created"`
@@ -108,32 +108,27 @@ func (c *Client) GetSharedEnvironmentVariable(ctx context.Context, teamID, envID
 	return e, err
 }
 
+// ListSharedEnvironmentVariables lists all of the shared environment variables within a team.
 func (c *Client) ListSharedEnvironmentVariables(ctx context.Context, teamID string) ([]SharedEnvironmentVariableResponse, error) {
 	url := fmt.Sprintf("%s/v1/env/all", c.baseURL)
 	if c.teamID(teamID) != "" {
 		url = fmt.Sprintf("%s?teamId=%s", url, c.teamID(teamID))
 	}
 
-	tflog.Info(ctx, "listing shared environment variables", map[string]interface{}{
-		"url": url,
+	res, err := listAll[SharedEnvironmentVariableResponse](c, listRequest{
+		ctx:     ctx,
+		url:     url,
+		key:     "data",
+		message: "listing shared environment variables",
 	})
-	res := struct {
-		Data []SharedEnvironmentVariableResponse `json:"data"`
-	}{}
-	err := c.doRequest(clientRequest{
-		ctx:    ctx,
-		method: "GET",
-		url:    url,
-		body:   "",
-	}, &res)
-	for _, v := range res.Data {
-		v.TeamID = c.teamID(teamID)
+	for i := range res {
+		res[i].TeamID = c.teamID(teamID)
 	}
-	return res.Data, err
+	return res, err
 }
 
 type UpdateSharedEnvironmentVariableRequest struct {
-	Value      string   `json:"value"`
+	Value      string   `json:"value,omitempty"`
 	Type       string   `json:"type"`
 	ProjectIDs []string `json:"projectId"`
 	Target     []string `json:"target"`
@@ -141,6 +136,8 @@ type UpdateSharedEnvironmentVariableRequest struct {
 	EnvID      string   `json:"-"`
 }
 
+// UpdateSharedEnvironmentVariable will update an existing shared environment variable to the latest information.
+// If no Value is specified, the existing value is left unchanged.
 func (c *Client) UpdateSharedEnvironmentVariable(ctx context.Context, request UpdateSharedEnvironmentVariableRequest) (e SharedEnvironmentVariableResponse, err error) {
 	url := fmt.Sprintf("%s/v1/env", c.baseURL)
 	if c.teamID(request.TeamID) != "" {
@@ -156,7 +153,7 @@ func (c *Client) UpdateSharedEnvironmentVariable(ctx context.Context, request Up
 
 	tflog.Info(ctx, "updating shared environment variable", map[string]interface{}{
 		"url":     url,
-		"payload": payload,
+		"payload": redactPayload(payload),
 	})
 	var response struct {
 		Updated []SharedEnvironmentVariableResponse `json:"updated"`
@@ -175,5 +172,6 @@ func (c *Client) UpdateSharedEnvironmentVariable(ctx context.Context, request Up
 	}
 	// Override the value, as it returns the encrypted value.
 	response.Updated[0].Value = request.Value
+	response.Updated[0].TeamID = c.teamID(request.TeamID)
 	return response.Updated[0], err
 }
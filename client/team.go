@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -13,10 +14,27 @@ type TeamCreateRequest struct {
 	Name string `json:"name"`
 }
 
-// Team is the information returned by the vercel api when a team is created.
+// Team is the information returned by the vercel api about a team, including its team-level settings.
 type Team struct {
-	ID                                 string  `json:"id"`
-	SensitiveEnvironmentVariablePolicy *string `json:"sensitiveEnvironmentVariablePolicy"`
+	ID                                 string                    `json:"id"`
+	Slug                               string                    `json:"slug,omitempty"`
+	Name                               string                    `json:"name,omitempty"`
+	SensitiveEnvironmentVariablePolicy *string                   `json:"sensitiveEnvironmentVariablePolicy"`
+	PreviewDeploymentSuffix            *string                   `json:"previewDeploymentSuffix,omitempty"`
+	RemoteCaching                      *TeamRemoteCaching        `json:"remoteCaching,omitempty"`
+	HideIPAddresses                    *bool                     `json:"hideIpAddresses,omitempty"`
+	DefaultDeploymentProtection        *TeamDeploymentProtection `json:"defaultDeploymentProtection,omitempty"`
+	EnablePreviewFeedback              *string                   `json:"enablePreviewFeedback,omitempty"`
+}
+
+// TeamRemoteCaching defines whether Remote Caching is enabled for the builds of a team.
+type TeamRemoteCaching struct {
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// TeamDeploymentProtection is the deployment protection applied by default to new projects within a team.
+type TeamDeploymentProtection struct {
+	VercelAuthentication *VercelAuthentication `json:"ssoProtection,omitempty"`
 }
 
 // CreateTeam creates a team within vercel.
@@ -26,7 +44,7 @@ func (c *Client) CreateTeam(ctx context.Context, request TeamCreateRequest) (r T
 	payload := string(mustMarshal(request))
 	tflog.Info(ctx, "creating team", map[string]interface{}{
 		"url":     url,
-		"payload": payload,
+		"payload": redactPayload(payload),
 	})
 	err = c.doRequest(clientRequest{
 		ctx:    ctx,
@@ -57,11 +75,219 @@ func (c *Client) GetTeam(ctx context.Context, idOrSlug string) (r Team, err erro
 	tflog.Info(ctx, "getting team", map[string]interface{}{
 		"url": url,
 	})
+	err = c.doRequest(clientRequest{
+		ctx:       ctx,
+		method:    "GET",
+		url:       url,
+		body:      "",
+		cacheable: true,
+	}, &r)
+	return r, err
+}
+
+// UpdateTeamRequest defines the team-level settings that can be changed. Any setting that is not specified is
+// left unchanged.
+type UpdateTeamRequest struct {
+	TeamID                             string                    `json:"-"`
+	Slug                               string                    `json:"slug,omitempty"`
+	Name                               string                    `json:"name,omitempty"`
+	SensitiveEnvironmentVariablePolicy *string                   `json:"sensitiveEnvironmentVariablePolicy,omitempty"`
+	PreviewDeploymentSuffix            *string                   `json:"previewDeploymentSuffix,omitempty"`
+	RemoteCaching                      *TeamRemoteCaching        `json:"remoteCaching,omitempty"`
+	HideIPAddresses                    *bool                     `json:"hideIpAddresses,omitempty"`
+	DefaultDeploymentProtection        *TeamDeploymentProtection `json:"defaultDeploymentProtection,omitempty"`
+	EnablePreviewFeedback              *string                   `json:"enablePreviewFeedback,omitempty"`
+}
+
+// UpdateTeam changes the settings of an existing team within vercel.
+func (c *Client) UpdateTeam(ctx context.Context, request UpdateTeamRequest) (r Team, err error) {
+	url := fmt.Sprintf("%s/v2/teams/%s", c.baseURL, c.teamID(request.TeamID))
+	payload := string(mustMarshal(request))
+	tflog.Info(ctx, "updating team", map[string]interface{}{
+		"url":     url,
+		"payload": redactPayload(payload),
+	})
 	err = c.doRequest(clientRequest{
 		ctx:    ctx,
-		method: "GET",
+		method: "PATCH",
 		url:    url,
-		body:   "",
+		body:   payload,
 	}, &r)
 	return r, err
 }
+
+// TeamMemberRoles are the roles a member of a team can be given.
+var TeamMemberRoles = []string{"OWNER", "MEMBER", "DEVELOPER", "SECURITY", "BILLING", "VIEWER", "CONTRIBUTOR"}
+
+// TeamMember is a member of a team, or a pending invitation to join a team.
+type TeamMember struct {
+	TeamID    string `json:"-"`
+	UserID    string `json:"uid"`
+	Email     string `json:"email"`
+	Username  string `json:"username"`
+	Name      string `json:"name"`
+	Role      string `json:"role"`
+	Confirmed bool   `json:"confirmed"`
+	// InviteID is set for an invitation sent to an email address that does not yet belong to a Vercel
+	// account. These invitations have no user ID until they are accepted.
+	InviteID string `json:"-"`
+}
+
+// TeamMemberRequest identifies a member of a team, by either their user ID or their email address.
+type TeamMemberRequest struct {
+	TeamID string
+	UserID string
+	Email  string
+}
+
+func (r TeamMemberRequest) matches(m TeamMember) bool {
+	if r.UserID != "" {
+		return m.UserID == r.UserID
+	}
+	return r.Email != "" && strings.EqualFold(m.Email, r.Email)
+}
+
+// InviteTeamMemberRequest defines the information needed to invite a user to a team. Either a UserID or an
+// Email should be specified.
+type InviteTeamMemberRequest struct {
+	TeamID string `json:"-"`
+	UserID string `json:"uid,omitempty"`
+	Email  string `json:"email,omitempty"`
+	Role   string `json:"role"`
+}
+
+// InviteTeamMember invites a user to a team. The invitation is then read back, as the response to an
+// invitation does not include whether it has been accepted.
+func (c *Client) InviteTeamMember(ctx context.Context, request InviteTeamMemberRequest) (m TeamMember, err error) {
+	url := fmt.Sprintf("%s/v1/teams/%s/members", c.baseURL, c.teamID(request.TeamID))
+	payload := string(mustMarshal(request))
+	tflog.Info(ctx, "inviting team member", map[string]interface{}{
+		"url":     url,
+		"payload": redactPayload(payload),
+	})
+	var invited TeamMember
+	err = c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "POST",
+		url:    url,
+		body:   payload,
+	}, &invited)
+	if err != nil {
+		return m, err
+	}
+	get := TeamMemberRequest{TeamID: request.TeamID, UserID: request.UserID, Email: request.Email}
+	if get.UserID == "" {
+		get.UserID = invited.UserID
+	}
+	return c.GetTeamMember(ctx, get)
+}
+
+type teamInviteCode struct {
+	ID    string `json:"id"`
+	Email string `json:"email"`
+	Role  string `json:"role"`
+}
+
+// ListTeamMembers returns every member of a team, followed by any pending invitations to email addresses
+// that do not yet belong to a Vercel account.
+func (c *Client) ListTeamMembers(ctx context.Context, teamID string) (r []TeamMember, err error) {
+	url := fmt.Sprintf("%s/v2/teams/%s/members?limit=100", c.baseURL, c.teamID(teamID))
+	members, err := listAll[TeamMember](c, listRequest{
+		ctx:     ctx,
+		url:     url,
+		key:     "members",
+		message: "listing team members",
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range members {
+		m.TeamID = c.teamID(teamID)
+		r = append(r, m)
+	}
+
+	// Pending invitations are not paginated, and are returned in full alongside the first page of members.
+	tflog.Info(ctx, "listing team invitations", map[string]interface{}{
+		"url": url,
+	})
+	var invites struct {
+		EmailInviteCodes []teamInviteCode `json:"emailInviteCodes"`
+	}
+	err = c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "GET",
+		url:    url,
+		body:   "",
+	}, &invites)
+	if err != nil {
+		return nil, err
+	}
+	for _, i := range invites.EmailInviteCodes {
+		r = append(r, TeamMember{
+			TeamID:   c.teamID(teamID),
+			Email:    i.Email,
+			Role:     i.Role,
+			InviteID: i.ID,
+		})
+	}
+	return r, nil
+}
+
+// GetTeamMember returns a single member of a team, or a pending invitation to join it.
+func (c *Client) GetTeamMember(ctx context.Context, request TeamMemberRequest) (m TeamMember, err error) {
+	members, err := c.ListTeamMembers(ctx, request.TeamID)
+	if err != nil {
+		return m, err
+	}
+	for _, member := range members {
+		if request.matches(member) {
+			return member, nil
+		}
+	}
+	return m, APIError{
+		StatusCode: 404,
+		Message:    "Team member not found",
+		Code:       "not_found",
+	}
+}
+
+// UpdateTeamMemberRequest defines the information needed to change the role of a team member.
+type UpdateTeamMemberRequest struct {
+	TeamID string `json:"-"`
+	UserID string `json:"-"`
+	Role   string `json:"role"`
+}
+
+// UpdateTeamMember changes the role of an existing member of a team.
+func (c *Client) UpdateTeamMember(ctx context.Context, request UpdateTeamMemberRequest) error {
+	url := fmt.Sprintf("%s/v1/teams/%s/members/%s", c.baseURL, c.teamID(request.TeamID), request.UserID)
+	payload := string(mustMarshal(request))
+	tflog.Info(ctx, "updating team member", map[string]interface{}{
+		"url":     url,
+		"payload": redactPayload(payload),
+	})
+	return c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "PATCH",
+		url:    url,
+		body:   payload,
+	}, nil)
+}
+
+// RemoveTeamMember removes a member from a team. If the member is a pending invitation to an email address,
+// the invitation is revoked instead.
+func (c *Client) RemoveTeamMember(ctx context.Context, member TeamMember) error {
+	url := fmt.Sprintf("%s/v1/teams/%s/members/%s", c.baseURL, c.teamID(member.TeamID), member.UserID)
+	if member.UserID == "" {
+		url = fmt.Sprintf("%s/v1/teams/%s/invites/%s", c.baseURL, c.teamID(member.TeamID), member.InviteID)
+	}
+	tflog.Info(ctx, "removing team member", map[string]interface{}{
+		"url": url,
+	})
+	return c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "DELETE",
+		url:    url,
+		body:   "",
+	}, nil)
+}
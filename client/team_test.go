@@ -0,0 +1,54 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestListTeamMembersIncludesInvitations(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/teams/team_1/members" {
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+		fmt.Fprint(w, `{"members":[
+			{"uid":"user_1","email":"jane@example.com","username":"jane","name":"Jane","role":"OWNER","confirmed":true},
+			{"uid":"user_2","email":"joe@example.com","username":"joe","role":"DEVELOPER","confirmed":false}
+		],"emailInviteCodes":[{"id":"invite_1","email":"new@example.com","role":"VIEWER"}],
+		"pagination":{"count":2,"next":null}}`)
+	}))
+	defer server.Close()
+
+	c := New("token").WithTeam(Team{ID: "team_1"})
+	c.baseURL = server.URL
+	members, err := c.ListTeamMembers(context.Background(), "")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	expected := []TeamMember{
+		{TeamID: "team_1", UserID: "user_1", Email: "jane@example.com", Username: "jane", Name: "Jane", Role: "OWNER", Confirmed: true},
+		{TeamID: "team_1", UserID: "user_2", Email: "joe@example.com", Username: "joe", Role: "DEVELOPER"},
+		{TeamID: "team_1", Email: "new@example.com", Role: "VIEWER", InviteID: "invite_1"},
+	}
+	if len(members) != len(expected) {
+		t.Fatalf("expected %d members, got %d", len(expected), len(members))
+	}
+	for i, e := range expected {
+		if members[i] != e {
+			t.Errorf("member %d: expected %+v, got %+v", i, e, members[i])
+		}
+	}
+
+	member, err := c.GetTeamMember(context.Background(), TeamMemberRequest{Email: "New@Example.com"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if member.InviteID != "invite_1" {
+		t.Errorf("expected the invitation to be matched by email, got %+v", member)
+	}
+	if _, err := c.GetTeamMember(context.Background(), TeamMemberRequest{UserID: "user_3"}); !NotFound(err) {
+		t.Errorf("expected an unknown member to be not found, got %v", err)
+	}
+}
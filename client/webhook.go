@@ -31,7 +31,7 @@ func (c *Client) CreateWebhook(ctx context.Context, request CreateWebhookRequest
 	payload := string(mustMarshal(request))
 	tflog.Info(ctx, "creating webhook", map[string]interface{}{
 		"url":     url,
-		"payload": payload,
+		"payload": redactPayload(payload),
 	})
 	err = c.doRequest(clientRequest{
 		ctx:    ctx,
@@ -0,0 +1,209 @@
+package delivery
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// The event types a webhook can subscribe to.
+const (
+	EventDeploymentCreated          = "deployment.created"
+	EventDeploymentSucceeded        = "deployment.succeeded"
+	EventDeploymentReady            = "deployment.ready"
+	EventDeploymentPromoted         = "deployment.promoted"
+	EventDeploymentError            = "deployment.error"
+	EventDeploymentCanceled         = "deployment.canceled"
+	EventDeploymentCheckRerequested = "deployment.check-rerequested"
+	EventProjectCreated             = "project.created"
+	EventProjectRemoved             = "project.removed"
+	EventDomainCreated              = "domain.created"
+)
+
+// EventType describes a type of event a webhook can subscribe to.
+type EventType struct {
+	Name        string
+	Description string
+}
+
+// EventTypes is the catalogue of event types a webhook can subscribe to.
+var EventTypes = []EventType{
+	{Name: EventDeploymentCreated, Description: "A deployment was created."},
+	{Name: EventDeploymentSucceeded, Description: "A deployment finished building."},
+	{Name: EventDeploymentReady, Description: "A deployment is ready to receive traffic, after its checks have passed."},
+	{Name: EventDeploymentPromoted, Description: "A deployment was promoted to production."},
+	{Name: EventDeploymentError, Description: "A deployment failed to build."},
+	{Name: EventDeploymentCanceled, Description: "A deployment was canceled."},
+	{Name: EventDeploymentCheckRerequested, Description: "A check of a deployment was requested to run again."},
+	{Name: EventProjectCreated, Description: "A project was created."},
+	{Name: EventProjectRemoved, Description: "A project was removed."},
+	{Name: EventDomainCreated, Description: "A domain was added."},
+}
+
+// Events lists the names of the event types a webhook can subscribe to.
+var Events = func() []string {
+	names := make([]string, len(EventTypes))
+	for i, e := range EventTypes {
+		names[i] = e.Name
+	}
+	return names
+}()
+
+// ValidEvent reports whether a webhook can subscribe to an event type.
+func ValidEvent(name string) bool {
+	return slices.Contains(Events, name)
+}
+
+// SuggestEvent returns the event type closest to a name that is not valid, such as deployment.canceled for
+// deployment.cancelled, or an empty string if no event type is close enough to be a likely typo.
+func SuggestEvent(name string) string {
+	name = strings.ToLower(name)
+	best, bestDistance := "", max(2, len(name)/5)+1
+	for _, e := range Events {
+		if d := levenshtein(name, e); d < bestDistance {
+			best, bestDistance = e, d
+		}
+	}
+	return best
+}
+
+// levenshtein returns the number of single character insertions, deletions or substitutions needed to turn
+// one string into another.
+func levenshtein(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
+
+// Event is the envelope every webhook delivery is sent in. The payload depends on the type of the event, and
+// can be decoded with Decode.
+type Event struct {
+	ID string `json:"id"`
+	// Type is the type of the event, such as deployment.created.
+	Type string `json:"type"`
+	// CreatedAt is the time the event happened, in milliseconds since the epoch.
+	CreatedAt int64           `json:"createdAt"`
+	Region    string          `json:"region,omitempty"`
+	Payload   json.RawMessage `json:"payload"`
+}
+
+// ParseEvent parses the body of a webhook delivery. The signature of the body should be verified first.
+func ParseEvent(body []byte) (Event, error) {
+	var e Event
+	if err := json.Unmarshal(body, &e); err != nil {
+		return e, fmt.Errorf("error decoding webhook event: %w", err)
+	}
+	if e.Type == "" {
+		return e, fmt.Errorf("error decoding webhook event: the event has no type")
+	}
+	return e, nil
+}
+
+// Team identifies the team an event happened within. It is not set for events of personal accounts.
+type Team struct {
+	ID string `json:"id"`
+}
+
+// User identifies the user that caused an event.
+type User struct {
+	ID string `json:"id"`
+}
+
+// Deployment is the deployment a deployment event is about.
+type Deployment struct {
+	ID   string            `json:"id"`
+	Name string            `json:"name"`
+	URL  string            `json:"url"`
+	Meta map[string]string `json:"meta,omitempty"`
+}
+
+// DeploymentLinks are links to a deployment, and its project, in the Vercel dashboard.
+type DeploymentLinks struct {
+	Deployment string `json:"deployment"`
+	Project    string `json:"project"`
+}
+
+// Project is the project an event is about.
+type Project struct {
+	ID   string `json:"id"`
+	Name string `json:"name,omitempty"`
+}
+
+// DeploymentEvent is an event about a deployment, such as deployment.created or deployment.succeeded.
+type DeploymentEvent struct {
+	Event      `json:"-"`
+	Team       *Team           `json:"team,omitempty"`
+	User       *User           `json:"user,omitempty"`
+	Project    Project         `json:"project"`
+	Deployment Deployment      `json:"deployment"`
+	Links      DeploymentLinks `json:"links"`
+	// Target is the environment the deployment targets, such as production, or empty for a preview deployment.
+	Target  string   `json:"target,omitempty"`
+	Plan    string   `json:"plan,omitempty"`
+	Regions []string `json:"regions,omitempty"`
+}
+
+// ProjectEvent is a project.created or project.removed event.
+type ProjectEvent struct {
+	Event   `json:"-"`
+	Team    *Team   `json:"team,omitempty"`
+	User    *User   `json:"user,omitempty"`
+	Project Project `json:"project"`
+}
+
+// Domain is the domain a domain event is about.
+type Domain struct {
+	Name      string `json:"name"`
+	Delegated bool   `json:"delegated"`
+}
+
+// DomainEvent is a domain.created event.
+type DomainEvent struct {
+	Event  `json:"-"`
+	Team   *Team  `json:"team,omitempty"`
+	User   *User  `json:"user,omitempty"`
+	Domain Domain `json:"domain"`
+}
+
+// Decode decodes the payload of an event into the struct for its type: a DeploymentEvent for deployment
+// events, a ProjectEvent for project events, or a DomainEvent for domain events. An error is returned for any
+// other type of event, in which case the payload can still be decoded from Payload.
+func (e Event) Decode() (interface{}, error) {
+	switch e.Type {
+	case EventDeploymentCreated, EventDeploymentSucceeded, EventDeploymentReady, EventDeploymentPromoted,
+		EventDeploymentError, EventDeploymentCanceled, EventDeploymentCheckRerequested:
+		d := DeploymentEvent{Event: e}
+		if err := json.Unmarshal(e.Payload, &d); err != nil {
+			return nil, fmt.Errorf("error decoding %s event payload: %w", e.Type, err)
+		}
+		return d, nil
+	case EventProjectCreated, EventProjectRemoved:
+		p := ProjectEvent{Event: e}
+		if err := json.Unmarshal(e.Payload, &p); err != nil {
+			return nil, fmt.Errorf("error decoding %s event payload: %w", e.Type, err)
+		}
+		return p, nil
+	case EventDomainCreated:
+		d := DomainEvent{Event: e}
+		if err := json.Unmarshal(e.Payload, &d); err != nil {
+			return nil, fmt.Errorf("error decoding %s event payload: %w", e.Type, err)
+		}
+		return d, nil
+	}
+	return nil, fmt.Errorf("cannot decode %s event, it can be decoded from the payload directly", e.Type)
+}
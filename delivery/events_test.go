@@ -0,0 +1,102 @@
+package delivery
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDecodeEvent(t *testing.T) {
+	e, err := ParseEvent([]byte(`{
+		"id": "evt_1",
+		"type": "deployment.succeeded",
+		"createdAt": 1700000000000,
+		"region": "iad1",
+		"payload": {
+			"team": {"id": "team_1"},
+			"user": {"id": "user_1"},
+			"project": {"id": "prj_1"},
+			"deployment": {"id": "dpl_1", "name": "web", "url": "web-abc.vercel.app", "meta": {"githubCommitRef": "main"}},
+			"links": {"deployment": "https://vercel.com/team/web/dpl_1", "project": "https://vercel.com/team/web"},
+			"target": "production",
+			"plan": "pro",
+			"regions": ["iad1"]
+		}
+	}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	decoded, err := e.Decode()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	d, ok := decoded.(DeploymentEvent)
+	if !ok {
+		t.Fatalf("expected a DeploymentEvent, got %T", decoded)
+	}
+	if d.ID != "evt_1" || d.Team.ID != "team_1" || d.Deployment.URL != "web-abc.vercel.app" || d.Target != "production" || d.Deployment.Meta["githubCommitRef"] != "main" {
+		t.Errorf("unexpected deployment event: %+v", d)
+	}
+
+	e, err = ParseEvent([]byte(`{"id":"evt_2","type":"project.removed","payload":{"user":{"id":"user_1"},"project":{"id":"prj_1","name":"web"}}}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	decoded, err = e.Decode()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	p, ok := decoded.(ProjectEvent)
+	if !ok {
+		t.Fatalf("expected a ProjectEvent, got %T", decoded)
+	}
+	if p.Type != EventProjectRemoved || p.Team != nil || p.Project.Name != "web" {
+		t.Errorf("unexpected project event: %+v", p)
+	}
+
+	e, err = ParseEvent([]byte(`{"id":"evt_3","type":"domain.created","payload":{"domain":{"name":"example.com","delegated":true}}}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	decoded, err = e.Decode()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if d, ok := decoded.(DomainEvent); !ok || d.Domain.Name != "example.com" || !d.Domain.Delegated {
+		t.Errorf("unexpected domain event: %+v", decoded)
+	}
+
+	e, err = ParseEvent([]byte(`{"id":"evt_5","type":"integration-configuration.removed","payload":{}}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, err := e.Decode(); err == nil || !strings.Contains(err.Error(), "cannot decode integration-configuration.removed event") {
+		t.Errorf("expected an error decoding an unknown event, got %v", err)
+	}
+
+	if _, err := ParseEvent([]byte(`{"id":"evt_4"}`)); err == nil {
+		t.Errorf("expected an error parsing an event without a type")
+	}
+}
+
+func TestSuggestEvent(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		want string
+	}{
+		{name: "deployment.cancelled", want: EventDeploymentCanceled},
+		{name: "deployment_created", want: EventDeploymentCreated},
+		{name: "Deployment.Succeeded", want: EventDeploymentSucceeded},
+		{name: "deployment-ready", want: EventDeploymentReady},
+		{name: "project.deleted", want: ""},
+		{name: "invoice.paid", want: ""},
+	} {
+		if got := SuggestEvent(tt.name); got != tt.want {
+			t.Errorf("%s: expected suggestion %q, got %q", tt.name, tt.want, got)
+		}
+	}
+	for _, e := range Events {
+		if !ValidEvent(e) {
+			t.Errorf("expected %s to be valid", e)
+		}
+	}
+}
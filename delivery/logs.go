@@ -0,0 +1,79 @@
+package delivery
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// Log is a single log line delivered to a log drain.
+type Log struct {
+	ID      string `json:"id"`
+	Message string `json:"message,omitempty"`
+	// Timestamp is the time the log was written, in milliseconds since the epoch.
+	Timestamp    int64  `json:"timestamp"`
+	Type         string `json:"type,omitempty"`
+	Source       string `json:"source"`
+	ProjectID    string `json:"projectId"`
+	DeploymentID string `json:"deploymentId"`
+	BuildID      string `json:"buildId,omitempty"`
+	Host         string `json:"host"`
+	Path         string `json:"path,omitempty"`
+	Entrypoint   string `json:"entrypoint,omitempty"`
+	RequestID    string `json:"requestId,omitempty"`
+	StatusCode   int    `json:"statusCode,omitempty"`
+	Destination  string `json:"destination,omitempty"`
+	Environment  string `json:"environment,omitempty"`
+	Level        string `json:"level,omitempty"`
+	Proxy        *Proxy `json:"proxy,omitempty"`
+}
+
+// Proxy describes the request that caused a log, for logs of requests served by Vercel.
+type Proxy struct {
+	Timestamp   int64    `json:"timestamp"`
+	Method      string   `json:"method"`
+	Scheme      string   `json:"scheme,omitempty"`
+	Host        string   `json:"host"`
+	Path        string   `json:"path"`
+	UserAgent   []string `json:"userAgent,omitempty"`
+	Referer     string   `json:"referer,omitempty"`
+	StatusCode  int      `json:"statusCode,omitempty"`
+	ClientIP    string   `json:"clientIp,omitempty"`
+	Region      string   `json:"region,omitempty"`
+	CacheID     string   `json:"cacheId,omitempty"`
+	VercelCache string   `json:"vercelCache,omitempty"`
+}
+
+// ParseLogs parses a batch of logs delivered to a log drain in the json or ndjson format. The json format is a
+// list of logs, and the ndjson format is a log on each line. The signature of the body should be verified first.
+func ParseLogs(format string, body []byte) ([]Log, error) {
+	switch format {
+	case "json":
+		var logs []Log
+		if err := json.Unmarshal(body, &logs); err != nil {
+			return nil, fmt.Errorf("error decoding logs: %w", err)
+		}
+		return logs, nil
+	case "ndjson":
+		logs := []Log{}
+		scanner := bufio.NewScanner(bytes.NewReader(body))
+		scanner.Buffer(make([]byte, 0, 64*1024), MaxBodySize)
+		for line := 1; scanner.Scan(); line++ {
+			b := bytes.TrimSpace(scanner.Bytes())
+			if len(b) == 0 {
+				continue
+			}
+			var log Log
+			if err := json.Unmarshal(b, &log); err != nil {
+				return nil, fmt.Errorf("error decoding log on line %d: %w", line, err)
+			}
+			logs = append(logs, log)
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, fmt.Errorf("error reading logs: %w", err)
+		}
+		return logs, nil
+	}
+	return nil, fmt.Errorf("cannot parse logs in the %s format, expected json or ndjson", format)
+}
@@ -0,0 +1,36 @@
+package delivery
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseLogs(t *testing.T) {
+	logs, err := ParseLogs("json", []byte(`[
+		{"id": "1", "message": "hello", "timestamp": 1700000000000, "source": "lambda", "projectId": "prj_1", "deploymentId": "dpl_1", "host": "web.vercel.app", "statusCode": 200},
+		{"id": "2", "source": "edge", "proxy": {"method": "GET", "host": "web.vercel.app", "path": "/api", "statusCode": 502, "userAgent": ["curl/8.0"], "clientIp": "203.0.113.7"}}
+	]`))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(logs) != 2 || logs[0].Message != "hello" || logs[1].Proxy == nil || logs[1].Proxy.StatusCode != 502 {
+		t.Errorf("unexpected logs: %+v", logs)
+	}
+
+	logs, err = ParseLogs("ndjson", []byte("{\"id\":\"1\",\"source\":\"build\"}\n\n{\"id\":\"2\",\"source\":\"static\"}\n"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(logs) != 2 || logs[1].Source != "static" {
+		t.Errorf("unexpected logs: %+v", logs)
+	}
+
+	_, err = ParseLogs("ndjson", []byte("{\"id\":\"1\"}\n{\"id\":"))
+	if err == nil || !strings.Contains(err.Error(), "line 2") {
+		t.Errorf("expected an error on line 2, got %v", err)
+	}
+	_, err = ParseLogs("syslog", []byte("<134>1 hello"))
+	if err == nil || !strings.Contains(err.Error(), "expected json or ndjson") {
+		t.Errorf("expected an error parsing syslog, got %v", err)
+	}
+}
@@ -0,0 +1,75 @@
+// Package delivery verifies and parses the requests Vercel delivers to webhook and log drain endpoints, so that
+// receivers written in Go do not need to reimplement signature checks or the shape of each payload.
+//
+// Vercel signs every delivery with the secret of the webhook or log drain, which is returned when it is
+// created, and sends the signature in the x-vercel-signature header.
+package delivery
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// SignatureHeader is the header Vercel sends the signature of a delivery in.
+const SignatureHeader = "x-vercel-signature"
+
+// MaxBodySize is the largest request body ReadRequest will read, in bytes.
+const MaxBodySize = 10 << 20
+
+var (
+	// ErrMissingSignature is returned when a request does not have a signature.
+	ErrMissingSignature = errors.New("the request is not signed, the " + SignatureHeader + " header is missing")
+	// ErrInvalidSignature is returned when a signature does not match the body of a delivery.
+	ErrInvalidSignature = errors.New("the signature does not match the request body")
+)
+
+// Sign returns the signature Vercel sends for a body: the hex encoded HMAC-SHA1 of the body, keyed by the
+// secret. It can be used to send signed test deliveries to an endpoint.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha1.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks that a signature was made from the body with the secret. The comparison takes constant time,
+// so that it does not reveal how much of a forged signature is correct.
+func Verify(secret string, body []byte, signature string) error {
+	if signature == "" {
+		return ErrMissingSignature
+	}
+	got, err := hex.DecodeString(signature)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+	mac := hmac.New(sha1.New, []byte(secret))
+	mac.Write(body)
+	if !hmac.Equal(got, mac.Sum(nil)) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// ReadRequest reads the body of a delivery and verifies its signature. The body is only returned if the
+// signature is valid. Bodies larger than MaxBodySize are rejected.
+func ReadRequest(r *http.Request, secret string) ([]byte, error) {
+	signature := r.Header.Get(SignatureHeader)
+	if signature == "" {
+		return nil, ErrMissingSignature
+	}
+	body, err := io.ReadAll(io.LimitReader(r.Body, MaxBodySize+1))
+	if err != nil {
+		return nil, fmt.Errorf("error reading request body: %w", err)
+	}
+	if len(body) > MaxBodySize {
+		return nil, fmt.Errorf("the request body is larger than %d bytes", MaxBodySize)
+	}
+	if err := Verify(secret, body, signature); err != nil {
+		return nil, err
+	}
+	return body, nil
+}
@@ -0,0 +1,63 @@
+package delivery
+
+import (
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestVerify(t *testing.T) {
+	secret := "a_very_long_and_very_well_specified_secret"
+	body := []byte(`{"id":"evt_1","type":"project.created","payload":{}}`)
+	signature := Sign(secret, body)
+	if len(signature) != 40 {
+		t.Fatalf("expected a hex encoded SHA1 signature, got %q", signature)
+	}
+
+	for _, tt := range []struct {
+		name      string
+		secret    string
+		body      []byte
+		signature string
+		wantErr   error
+	}{
+		{name: "valid", secret: secret, body: body, signature: signature},
+		{name: "uppercase", secret: secret, body: body, signature: strings.ToUpper(signature)},
+		{name: "missing", secret: secret, body: body, wantErr: ErrMissingSignature},
+		{name: "wrong secret", secret: "another_secret", body: body, signature: signature, wantErr: ErrInvalidSignature},
+		{name: "tampered body", secret: secret, body: append([]byte(" "), body...), signature: signature, wantErr: ErrInvalidSignature},
+		{name: "not hex", secret: secret, body: body, signature: "sha1=" + signature, wantErr: ErrInvalidSignature},
+	} {
+		err := Verify(tt.secret, tt.body, tt.signature)
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("%s: expected error %v, got %v", tt.name, tt.wantErr, err)
+		}
+	}
+}
+
+func TestReadRequest(t *testing.T) {
+	secret := "a_very_long_and_very_well_specified_secret"
+	body := `[{"id":"1","message":"hello","source":"lambda"}]`
+
+	r := httptest.NewRequest("POST", "/logs", strings.NewReader(body))
+	r.Header.Set(SignatureHeader, Sign(secret, []byte(body)))
+	got, err := ReadRequest(r, secret)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if string(got) != body {
+		t.Errorf("expected body %q, got %q", body, got)
+	}
+
+	r = httptest.NewRequest("POST", "/logs", strings.NewReader(body))
+	r.Header.Set(SignatureHeader, Sign("another_secret", []byte(body)))
+	if _, err := ReadRequest(r, secret); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("expected an invalid signature error, got %v", err)
+	}
+
+	r = httptest.NewRequest("POST", "/logs", strings.NewReader(body))
+	if _, err := ReadRequest(r, secret); !errors.Is(err, ErrMissingSignature) {
+		t.Errorf("expected a missing signature error, got %v", err)
+	}
+}
@@ -0,0 +1,238 @@
+package dns
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// maxTXTLength is the longest TXT value that fits within a single DNS record. Values longer than a single
+// 255 byte character-string are split into several strings, each adding a length byte, and the record
+// data as a whole is limited to 65535 bytes.
+const maxTXTLength = 65535 - 65535/256
+
+// caaTags are the CAA property tags defined by RFC 8659 and its extensions.
+var caaTags = map[string]bool{
+	"issue":        true,
+	"issuewild":    true,
+	"iodef":        true,
+	"issuemail":    true,
+	"issuevmc":     true,
+	"contactemail": true,
+	"contactphone": true,
+}
+
+// ValidateValue validates the value of a DNS record of the given type, in the format used by Vercel. Types
+// whose values are not validated, such as SRV and HTTPS records whose fields are set separately, are accepted.
+func ValidateValue(recordType, value string) error {
+	switch recordType {
+	case "A":
+		addr, err := netip.ParseAddr(value)
+		if err != nil || !addr.Is4() {
+			return fmt.Errorf("%q is not a valid IPv4 address", value)
+		}
+	case "AAAA":
+		addr, err := netip.ParseAddr(value)
+		if err != nil || !addr.Is6() {
+			return fmt.Errorf("%q is not a valid IPv6 address", value)
+		}
+	case "ALIAS", "CNAME", "NS":
+		if _, err := netip.ParseAddr(value); err == nil {
+			return fmt.Errorf("%s records must point at a hostname, not an IP address, got %q", recordType, value)
+		}
+		return ValidateHostname(value)
+	case "MX":
+		if value == "." {
+			// A null MX record, indicating the domain does not accept email.
+			return nil
+		}
+		if _, err := netip.ParseAddr(value); err == nil {
+			return fmt.Errorf("MX records must point at a mail server hostname, not an IP address, got %q", value)
+		}
+		if fields := strings.Fields(value); len(fields) > 1 {
+			return fmt.Errorf("MX record values should only contain the mail server hostname, with the priority set separately, got %q", value)
+		}
+		return ValidateHostname(value)
+	case "TXT":
+		return validateTXT(value)
+	case "CAA":
+		return validateCAA(value)
+	}
+	return nil
+}
+
+// ValidateHostname validates a fully qualified domain name, which may have a trailing dot.
+// Underscores are allowed, as they are used by service records such as `_dmarc`.
+func ValidateHostname(name string) error {
+	trimmed := strings.TrimSuffix(name, ".")
+	if trimmed == "" {
+		return fmt.Errorf("hostname must not be empty")
+	}
+	if len(trimmed) > 253 {
+		return fmt.Errorf("hostname %q must be at most 253 characters, got %d", name, len(trimmed))
+	}
+	for _, label := range strings.Split(trimmed, ".") {
+		if label == "" {
+			return fmt.Errorf("hostname %q must not contain empty labels", name)
+		}
+		if len(label) > 63 {
+			return fmt.Errorf("hostname %q has a label %q longer than 63 characters", name, label)
+		}
+		if strings.HasPrefix(label, "-") || strings.HasSuffix(label, "-") {
+			return fmt.Errorf("hostname %q has a label %q that starts or ends with a hyphen", name, label)
+		}
+		for _, c := range label {
+			if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-' || c == '_') {
+				return fmt.Errorf("hostname %q contains an invalid character %q", name, c)
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateTarget validates the target of an SRV or HTTPS record, which is either a hostname or `.`.
+func ValidateTarget(target string) error {
+	if target == "." {
+		return nil
+	}
+	return ValidateHostname(target)
+}
+
+func validateTXT(value string) error {
+	if value == "" {
+		return fmt.Errorf("TXT records must not be empty")
+	}
+	if strings.HasPrefix(value, `"`) {
+		// The value is made up of one or more quoted character-strings, which are each limited to 255 bytes.
+		lines, err := tokenize(value)
+		if err != nil || len(lines) != 1 {
+			return fmt.Errorf("TXT value %q is not a valid set of quoted strings", value)
+		}
+		for _, t := range lines[0].tokens {
+			if !t.quoted {
+				return fmt.Errorf("TXT value must either be a plain string, or consist entirely of quoted strings, found %q", t.text)
+			}
+			if len(t.text) > 255 {
+				return fmt.Errorf("each quoted string within a TXT value must be at most 255 bytes, got %d bytes", len(t.text))
+			}
+		}
+		return nil
+	}
+	if len(value) > maxTXTLength {
+		return fmt.Errorf("TXT values must be at most %d bytes, got %d bytes", maxTXTLength, len(value))
+	}
+	return nil
+}
+
+func validateCAA(value string) error {
+	fields := strings.SplitN(value, " ", 3)
+	if len(fields) != 3 {
+		return fmt.Errorf(`CAA values must be in the format '{flags} {tag} "{value}"', for example '0 issue "letsencrypt.org"', got %q`, value)
+	}
+	flags, tag, tagValue := fields[0], fields[1], fields[2]
+	if _, err := strconv.ParseUint(flags, 10, 8); err != nil {
+		return fmt.Errorf("CAA flags must be a number between 0 and 255, got %q", flags)
+	}
+	if !caaTags[tag] {
+		return fmt.Errorf("CAA tag %q is not valid, expected one of issue, issuewild, iodef, issuemail, issuevmc, contactemail or contactphone", tag)
+	}
+	if len(tagValue) < 2 || !strings.HasPrefix(tagValue, `"`) || !strings.HasSuffix(tagValue, `"`) {
+		return fmt.Errorf(`CAA value must be enclosed in double quotes, for example '0 %s "letsencrypt.org"', got %s`, tag, tagValue)
+	}
+	tagValue = tagValue[1 : len(tagValue)-1]
+
+	switch tag {
+	case "issue", "issuewild", "issuemail", "issuevmc":
+		// The value is an optional issuer domain, followed by optional parameters.
+		issuer, _, _ := strings.Cut(tagValue, ";")
+		issuer = strings.TrimSpace(issuer)
+		if issuer == "" {
+			return nil
+		}
+		if err := ValidateHostname(issuer); err != nil {
+			return fmt.Errorf("CAA %s value has an invalid issuer domain: %w", tag, err)
+		}
+	case "iodef":
+		u, err := url.Parse(tagValue)
+		if err != nil || (u.Scheme != "mailto" && u.Scheme != "http" && u.Scheme != "https") {
+			return fmt.Errorf("CAA iodef value must be a mailto:, http: or https: URL, got %q", tagValue)
+		}
+	}
+	return nil
+}
+
+// ValidateSvcParams validates the SvcParams of an HTTPS record, as defined by RFC 9460. Params are space
+// separated `key=value` pairs, such as `alpn=h2,h3 port=8443`. Vercel does not support generic SVCB records,
+// only the HTTPS form of them, so the keys are not checked against any other service.
+func ValidateSvcParams(params string) error {
+	seen := map[string]bool{}
+	for _, param := range strings.Fields(params) {
+		key, value, hasValue := strings.Cut(param, "=")
+		value = strings.Trim(value, `"`)
+		if seen[key] {
+			return fmt.Errorf("SvcParam %q is specified more than once", key)
+		}
+		seen[key] = true
+
+		switch key {
+		case "no-default-alpn":
+			if hasValue {
+				return fmt.Errorf("SvcParam no-default-alpn must not have a value")
+			}
+			continue
+		case "mandatory", "alpn", "port", "ipv4hint", "ipv6hint", "ech":
+		default:
+			if _, err := strconv.ParseUint(strings.TrimPrefix(key, "key"), 10, 16); !strings.HasPrefix(key, "key") || err != nil {
+				return fmt.Errorf("SvcParam key %q is not valid, expected one of mandatory, alpn, no-default-alpn, port, ipv4hint, ech, ipv6hint or keyNNNNN", key)
+			}
+			continue
+		}
+		if value == "" {
+			return fmt.Errorf("SvcParam %s must have a value", key)
+		}
+
+		for _, item := range strings.Split(value, ",") {
+			var err error
+			switch key {
+			case "port":
+				_, err = strconv.ParseUint(item, 10, 16)
+			case "ipv4hint":
+				var addr netip.Addr
+				if addr, err = netip.ParseAddr(item); err == nil && !addr.Is4() {
+					err = fmt.Errorf("not IPv4")
+				}
+			case "ipv6hint":
+				var addr netip.Addr
+				if addr, err = netip.ParseAddr(item); err == nil && !addr.Is6() {
+					err = fmt.Errorf("not IPv6")
+				}
+			case "ech":
+				_, err = base64.StdEncoding.DecodeString(item)
+			default:
+				if item == "" {
+					err = fmt.Errorf("empty")
+				}
+			}
+			if err != nil {
+				return fmt.Errorf("SvcParam %s has an invalid value %q", key, item)
+			}
+		}
+	}
+	if seen["mandatory"] {
+		for _, param := range strings.Fields(params) {
+			key, value, _ := strings.Cut(param, "=")
+			if key != "mandatory" {
+				continue
+			}
+			for _, m := range strings.Split(strings.Trim(value, `"`), ",") {
+				if m == "mandatory" || !seen[m] {
+					return fmt.Errorf("SvcParam mandatory lists %q, which must be a different key that is also specified", m)
+				}
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package dns
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateValue(t *testing.T) {
+	for _, tt := range []struct {
+		recordType string
+		value      string
+		wantErr    string
+	}{
+		{recordType: "A", value: "76.76.21.21"},
+		{recordType: "A", value: "2001:db8::1", wantErr: "not a valid IPv4 address"},
+		{recordType: "A", value: "example.com", wantErr: "not a valid IPv4 address"},
+		{recordType: "AAAA", value: "2001:db8::1"},
+		{recordType: "AAAA", value: "76.76.21.21", wantErr: "not a valid IPv6 address"},
+		{recordType: "CNAME", value: "cname.vercel-dns.com."},
+		{recordType: "CNAME", value: "76.76.21.21", wantErr: "not an IP address"},
+		{recordType: "CNAME", value: "bad_host-.com", wantErr: "starts or ends with a hyphen"},
+		{recordType: "ALIAS", value: "cname.vercel-dns.com"},
+		{recordType: "NS", value: "ns1..example.com", wantErr: "empty labels"},
+		{recordType: "MX", value: "mail.example.com"},
+		{recordType: "MX", value: "."},
+		{recordType: "MX", value: "10 mail.example.com", wantErr: "priority set separately"},
+		{recordType: "MX", value: "76.76.21.21", wantErr: "not an IP address"},
+		{recordType: "TXT", value: "v=spf1 include:_spf.example.com ~all"},
+		{recordType: "TXT", value: `"` + strings.Repeat("a", 255) + `" "b"`},
+		{recordType: "TXT", value: `"` + strings.Repeat("a", 256) + `"`, wantErr: "at most 255 bytes"},
+		{recordType: "TXT", value: `"a" b`, wantErr: "consist entirely of quoted strings"},
+		{recordType: "TXT", value: strings.Repeat("a", 70000), wantErr: "at most 65280 bytes"},
+		{recordType: "TXT", value: "", wantErr: "must not be empty"},
+		{recordType: "CAA", value: `0 issue "letsencrypt.org"`},
+		{recordType: "CAA", value: `0 issue ";"`},
+		{recordType: "CAA", value: `128 iodef "mailto:security@example.com"`},
+		{recordType: "CAA", value: `0 issue letsencrypt.org`, wantErr: "enclosed in double quotes"},
+		{recordType: "CAA", value: `256 issue "letsencrypt.org"`, wantErr: "flags must be a number"},
+		{recordType: "CAA", value: `0 issues "letsencrypt.org"`, wantErr: `tag "issues" is not valid`},
+		{recordType: "CAA", value: `0 issue "lets encrypt"`, wantErr: "invalid issuer domain"},
+		{recordType: "CAA", value: `0 iodef "ftp://example.com"`, wantErr: "iodef value must be"},
+		{recordType: "CAA", value: `0 issue`, wantErr: "must be in the format"},
+		{recordType: "SRV", value: "anything"},
+	} {
+		err := ValidateValue(tt.recordType, tt.value)
+		if tt.wantErr == "" && err != nil {
+			t.Errorf("%s %q: unexpected error: %s", tt.recordType, tt.value, err)
+		}
+		if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+			t.Errorf("%s %q: expected error containing %q, got %v", tt.recordType, tt.value, tt.wantErr, err)
+		}
+	}
+}
+
+func TestValidateSvcParams(t *testing.T) {
+	for _, tt := range []struct {
+		params  string
+		wantErr string
+	}{
+		{params: ""},
+		{params: "alpn=h2,h3 port=8443 ipv4hint=76.76.21.21 ipv6hint=2001:db8::1"},
+		{params: `alpn="h3" no-default-alpn`},
+		{params: "mandatory=alpn alpn=h2"},
+		{params: "key65000=abc ech=AEn+DQBFKwAgACA="},
+		{params: "alpn=h2 alpn=h3", wantErr: "more than once"},
+		{params: "port=70000", wantErr: "invalid value"},
+		{params: "ipv4hint=2001:db8::1", wantErr: "invalid value"},
+		{params: "ipv6hint=76.76.21.21", wantErr: "invalid value"},
+		{params: "ech=not-base64!", wantErr: "invalid value"},
+		{params: "alpn=", wantErr: "must have a value"},
+		{params: "no-default-alpn=1", wantErr: "must not have a value"},
+		{params: "key70000=abc", wantErr: "is not valid"},
+		{params: "priority=1", wantErr: "is not valid"},
+		{params: "mandatory=port alpn=h2", wantErr: "mandatory lists"},
+		{params: "mandatory=mandatory", wantErr: "mandatory lists"},
+	} {
+		err := ValidateSvcParams(tt.params)
+		if tt.wantErr == "" && err != nil {
+			t.Errorf("%q: unexpected error: %s", tt.params, err)
+		}
+		if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+			t.Errorf("%q: expected error containing %q, got %v", tt.params, tt.wantErr, err)
+		}
+	}
+}
@@ -0,0 +1,492 @@
+// Package dns provides offline helpers for working with DNS records, such as parsing RFC 1035 zone files.
+package dns
+
+import (
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+)
+
+// SRV holds the fields of an SRV record.
+type SRV struct {
+	Priority int64
+	Weight   int64
+	Port     int64
+	Target   string
+}
+
+// HTTPS holds the fields of an HTTPS record.
+type HTTPS struct {
+	Priority int64
+	// Target is a fully qualified hostname without a trailing dot, or `.` for the name of the record itself.
+	Target string
+	// Params are the space separated SvcParams of the record, such as `alpn=h2,h3`.
+	Params string
+}
+
+// Record is a single DNS record, in the form used by Vercel.
+type Record struct {
+	// Name is the name of the record relative to the zone's domain. It is empty for the apex of the domain.
+	Name string
+	Type string
+	// TTL is the TTL of the record in seconds, or 0 if the zone file did not specify one.
+	TTL int64
+	// Value is the value of the record. Hostnames are fully qualified, without a trailing dot.
+	// It is empty for SRV and HTTPS records, where SRV or HTTPS is set instead.
+	Value      string
+	MXPriority int64
+	SRV        *SRV
+	HTTPS      *HTTPS
+}
+
+// Skipped describes a record in a zone file that cannot be managed within Vercel.
+type Skipped struct {
+	Line   int
+	Name   string
+	Type   string
+	Reason string
+}
+
+// Zone is the result of parsing a zone file.
+type Zone struct {
+	Records []Record
+	Skipped []Skipped
+}
+
+// classes are the DNS classes that may appear in a zone file. Only IN is supported.
+var classes = map[string]bool{
+	"IN": true,
+	"CS": true,
+	"CH": true,
+	"HS": true,
+}
+
+// ParseZoneFile parses the contents of an RFC 1035 (BIND) zone file for the given domain. Relative names
+// are resolved against the domain, unless an $ORIGIN directive sets a different origin.
+//
+// SOA records and NS records at the apex of the domain are skipped, as Vercel manages them. Records of a
+// type that Vercel does not support are also skipped. $INCLUDE and $GENERATE directives are not supported.
+func ParseZoneFile(content, domain string) (Zone, error) {
+	domain = strings.ToLower(strings.TrimSuffix(domain, "."))
+	lines, err := tokenize(content)
+	if err != nil {
+		return Zone{}, err
+	}
+
+	p := parser{
+		domain: domain,
+		origin: domain,
+	}
+	for _, l := range lines {
+		if err := p.parseLine(l); err != nil {
+			return Zone{}, fmt.Errorf("line %d: %w", l.number, err)
+		}
+	}
+	return p.zone, nil
+}
+
+type parser struct {
+	domain     string
+	origin     string
+	owner      string
+	defaultTTL int64
+	lastTTL    int64
+	zone       Zone
+}
+
+func (p *parser) parseLine(l line) error {
+	tokens := l.tokens
+	if !l.continuesOwner && strings.HasPrefix(tokens[0].text, "$") && !tokens[0].quoted {
+		return p.parseDirective(tokens)
+	}
+
+	if !l.continuesOwner {
+		p.owner = p.absolute(tokens[0].text)
+		tokens = tokens[1:]
+	}
+	if p.owner == "" {
+		return fmt.Errorf("record has no owner name")
+	}
+
+	ttl := int64(-1)
+	for len(tokens) > 0 {
+		if tokens[0].quoted {
+			break
+		}
+		text := strings.ToUpper(tokens[0].text)
+		if classes[text] {
+			if text != "IN" {
+				return fmt.Errorf("class %s is not supported, only IN", text)
+			}
+			tokens = tokens[1:]
+			continue
+		}
+		if t, ok := parseTTL(tokens[0].text); ok && ttl == -1 {
+			ttl = t
+			tokens = tokens[1:]
+			continue
+		}
+		break
+	}
+	if len(tokens) == 0 {
+		return fmt.Errorf("record for %s has no type", p.owner)
+	}
+	switch {
+	case ttl != -1:
+		p.lastTTL = ttl
+	case p.defaultTTL != 0:
+		ttl = p.defaultTTL
+	default:
+		ttl = p.lastTTL
+	}
+
+	name, err := p.relative(p.owner)
+	if err != nil {
+		return err
+	}
+	recordType := strings.ToUpper(tokens[0].text)
+	record, skipReason, err := p.parseRecord(name, recordType, tokens[1:])
+	if err != nil {
+		return fmt.Errorf("invalid %s record for %s: %w", recordType, p.owner, err)
+	}
+	if skipReason != "" {
+		p.zone.Skipped = append(p.zone.Skipped, Skipped{
+			Line:   l.number,
+			Name:   name,
+			Type:   recordType,
+			Reason: skipReason,
+		})
+		return nil
+	}
+	record.TTL = ttl
+	p.zone.Records = append(p.zone.Records, record)
+	return nil
+}
+
+func (p *parser) parseDirective(tokens []token) error {
+	directive := strings.ToUpper(tokens[0].text)
+	switch directive {
+	case "$ORIGIN":
+		if len(tokens) != 2 {
+			return fmt.Errorf("$ORIGIN expects a single domain name")
+		}
+		p.origin = p.absolute(tokens[1].text)
+		return nil
+	case "$TTL":
+		if len(tokens) != 2 {
+			return fmt.Errorf("$TTL expects a single TTL")
+		}
+		ttl, ok := parseTTL(tokens[1].text)
+		if !ok {
+			return fmt.Errorf("invalid TTL %q", tokens[1].text)
+		}
+		p.defaultTTL = ttl
+		return nil
+	default:
+		return fmt.Errorf("the %s directive is not supported", directive)
+	}
+}
+
+// parseRecord parses the RDATA of a record. If the record cannot be managed within Vercel, a reason
+// is returned instead.
+func (p *parser) parseRecord(name, recordType string, rdata []token) (record Record, skipReason string, err error) {
+	record = Record{
+		Name: name,
+		Type: recordType,
+	}
+	switch recordType {
+	case "SOA":
+		return record, "SOA records are managed by Vercel", nil
+	case "A", "AAAA":
+		if err := expectFields(rdata, 1); err != nil {
+			return record, "", err
+		}
+		version := "IPv6"
+		if recordType == "A" {
+			version = "IPv4"
+		}
+		addr, err := netip.ParseAddr(rdata[0].text)
+		if err != nil || (version == "IPv4") != addr.Is4() {
+			return record, "", fmt.Errorf("%q is not a valid %s address", rdata[0].text, version)
+		}
+		record.Value = addr.String()
+	case "NS":
+		if name == "" {
+			return record, "NS records at the apex of the domain are managed by Vercel", nil
+		}
+		fallthrough
+	case "CNAME", "ALIAS":
+		if err := expectFields(rdata, 1); err != nil {
+			return record, "", err
+		}
+		record.Value = p.absolute(rdata[0].text)
+	case "MX":
+		if err := expectFields(rdata, 2); err != nil {
+			return record, "", err
+		}
+		priority, err := parseUint16(rdata[0].text, "preference")
+		if err != nil {
+			return record, "", err
+		}
+		record.MXPriority = priority
+		record.Value = p.absolute(rdata[1].text)
+	case "SRV":
+		if err := expectFields(rdata, 4); err != nil {
+			return record, "", err
+		}
+		srv := &SRV{
+			Target: p.absolute(rdata[3].text),
+		}
+		for i, field := range []*int64{&srv.Priority, &srv.Weight, &srv.Port} {
+			*field, err = parseUint16(rdata[i].text, []string{"priority", "weight", "port"}[i])
+			if err != nil {
+				return record, "", err
+			}
+		}
+		record.SRV = srv
+	case "HTTPS":
+		if len(rdata) < 2 {
+			return record, "", fmt.Errorf("expected at least 2 fields, got %d", len(rdata))
+		}
+		priority, err := parseUint16(rdata[0].text, "priority")
+		if err != nil {
+			return record, "", err
+		}
+		https := &HTTPS{
+			Priority: priority,
+			Target:   rdata[1].text,
+		}
+		if https.Target != "." {
+			https.Target = p.absolute(https.Target)
+		}
+		var params []string
+		for _, t := range rdata[2:] {
+			params = append(params, t.text)
+		}
+		https.Params = strings.Join(params, " ")
+		record.HTTPS = https
+	case "TXT":
+		if len(rdata) == 0 {
+			return record, "", fmt.Errorf("expected at least one string")
+		}
+		// Long values, such as DKIM keys, are split into several strings that form a single value.
+		var value strings.Builder
+		for _, t := range rdata {
+			value.WriteString(t.text)
+		}
+		record.Value = value.String()
+	case "CAA":
+		if err := expectFields(rdata, 3); err != nil {
+			return record, "", err
+		}
+		if _, err := strconv.ParseUint(rdata[0].text, 10, 8); err != nil {
+			return record, "", fmt.Errorf("flags must be a number between 0 and 255, got %q", rdata[0].text)
+		}
+		record.Value = fmt.Sprintf(`%s %s "%s"`, rdata[0].text, strings.ToLower(rdata[1].text), rdata[2].text)
+	default:
+		return record, fmt.Sprintf("%s records are not supported by Vercel", recordType), nil
+	}
+	return record, "", nil
+}
+
+func expectFields(rdata []token, n int) error {
+	if len(rdata) != n {
+		return fmt.Errorf("expected %d fields, got %d", n, len(rdata))
+	}
+	return nil
+}
+
+func parseUint16(s, field string) (int64, error) {
+	v, err := strconv.ParseUint(s, 10, 16)
+	if err != nil {
+		return 0, fmt.Errorf("%s must be a number between 0 and 65535, got %q", field, s)
+	}
+	return int64(v), nil
+}
+
+// absolute resolves a name against the current origin, returning a fully qualified name without a
+// trailing dot.
+func (p *parser) absolute(name string) string {
+	switch {
+	case name == "@":
+		return p.origin
+	case name == ".":
+		return ""
+	case strings.HasSuffix(name, "."):
+		return strings.ToLower(strings.TrimSuffix(name, "."))
+	case p.origin == "":
+		return strings.ToLower(name)
+	default:
+		return strings.ToLower(name) + "." + p.origin
+	}
+}
+
+// relative returns a fully qualified name relative to the zone's domain.
+func (p *parser) relative(name string) (string, error) {
+	if name == p.domain {
+		return "", nil
+	}
+	if !strings.HasSuffix(name, "."+p.domain) {
+		return "", fmt.Errorf("%s is not within the domain %s", name, p.domain)
+	}
+	return strings.TrimSuffix(name, "."+p.domain), nil
+}
+
+// ttlUnits are the BIND TTL units, in seconds.
+var ttlUnits = map[byte]int64{
+	's': 1,
+	'm': 60,
+	'h': 60 * 60,
+	'd': 24 * 60 * 60,
+	'w': 7 * 24 * 60 * 60,
+}
+
+// parseTTL parses a TTL, either as a number of seconds or in the BIND format, such as `1h30m`.
+func parseTTL(s string) (int64, bool) {
+	if s == "" || s[0] < '0' || s[0] > '9' {
+		return 0, false
+	}
+	if v, err := strconv.ParseUint(s, 10, 31); err == nil {
+		return int64(v), true
+	}
+	var total, current int64
+	digits := false
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c >= '0' && c <= '9' {
+			current = current*10 + int64(c-'0')
+			digits = true
+			continue
+		}
+		unit, ok := ttlUnits[c|0x20]
+		if !ok || !digits {
+			return 0, false
+		}
+		total += current * unit
+		current, digits = 0, false
+	}
+	if digits {
+		return 0, false
+	}
+	return total, total < 1<<31
+}
+
+type token struct {
+	text   string
+	quoted bool
+}
+
+// line is a single logical line of a zone file, after comments are removed and parenthesised
+// continuations are joined.
+type line struct {
+	number int
+	// continuesOwner is set when the line begins with whitespace, meaning the owner name is omitted and
+	// the previous owner is used.
+	continuesOwner bool
+	tokens         []token
+}
+
+// tokenize splits a zone file into logical lines of tokens, handling comments, quoted strings, escapes
+// and parentheses.
+func tokenize(content string) ([]line, error) {
+	var lines []line
+	var current line
+	var text strings.Builder
+	inToken, quoted, inQuotes := false, false, false
+	parens := 0
+	number := 1
+	atLineStart := true
+
+	endToken := func() {
+		if inToken {
+			current.tokens = append(current.tokens, token{text: text.String(), quoted: quoted})
+		}
+		text.Reset()
+		inToken, quoted = false, false
+	}
+	endLine := func() {
+		endToken()
+		if len(current.tokens) > 0 {
+			lines = append(lines, current)
+		}
+		current = line{}
+	}
+
+	for i := 0; i < len(content); i++ {
+		c := content[i]
+		if atLineStart && parens == 0 {
+			current = line{number: number, continuesOwner: c == ' ' || c == '\t'}
+			atLineStart = false
+		}
+		switch {
+		case inQuotes && c == '"':
+			inQuotes = false
+		case c == '\\':
+			if i+1 >= len(content) {
+				return nil, fmt.Errorf("line %d: unterminated escape", number)
+			}
+			i++
+			if n, ok := decimalEscape(content[i:]); ok {
+				text.WriteByte(n)
+				i += 2
+			} else {
+				text.WriteByte(content[i])
+			}
+			inToken = true
+		case inQuotes:
+			if c == '\n' {
+				number++
+			}
+			text.WriteByte(c)
+		case c == '"':
+			inQuotes, inToken, quoted = true, true, true
+		case c == ';':
+			for i+1 < len(content) && content[i+1] != '\n' {
+				i++
+			}
+		case c == '(':
+			endToken()
+			parens++
+		case c == ')':
+			endToken()
+			if parens == 0 {
+				return nil, fmt.Errorf("line %d: unexpected )", number)
+			}
+			parens--
+		case c == '\n':
+			number++
+			if parens == 0 {
+				endLine()
+				atLineStart = true
+			} else {
+				endToken()
+			}
+		case c == ' ' || c == '\t' || c == '\r':
+			endToken()
+		default:
+			text.WriteByte(c)
+			inToken = true
+		}
+	}
+	if inQuotes {
+		return nil, fmt.Errorf("line %d: unterminated quoted string", number)
+	}
+	if parens != 0 {
+		return nil, fmt.Errorf("line %d: unclosed (", number)
+	}
+	endLine()
+	return lines, nil
+}
+
+// decimalEscape parses the `DDD` of a `\DDD` escape sequence.
+func decimalEscape(s string) (byte, bool) {
+	if len(s) < 3 {
+		return 0, false
+	}
+	n, err := strconv.ParseUint(s[:3], 10, 8)
+	if err != nil {
+		return 0, false
+	}
+	return byte(n), true
+}
@@ -0,0 +1,106 @@
+package dns
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const exampleZone = `
+$ORIGIN example.com.
+$TTL 1h
+@       IN  SOA ns1.example.com. hostmaster.example.com. (
+                2024010101 ; serial
+                7200       ; refresh
+                3600       ; retry
+                1209600    ; expire
+                3600 )     ; minimum
+@       IN  NS    ns1.vercel-dns.com.
+@       IN  A     76.76.21.21
+        IN  AAAA  2001:db8::1
+        IN  MX    10 mail
+        IN  MX    20 mail.backup.net.
+www     300 IN CNAME @
+api     IN  300 CNAME cname.vercel-dns.com.
+sub     IN  NS    ns1.other.net.
+_sip._tcp IN SRV  10 60 5060 sip
+@       IN  TXT   "v=spf1 include:_spf.example.com ~all"
+dkim._domainkey IN TXT ( "v=DKIM1; k=rsa; "
+                         "p=MIGfMA0\"GCSq" )
+@       IN  CAA   0 issue "letsencrypt.org"
+@       IN  HTTPS 1 . alpn=h2,h3
+svc     IN  HTTPS 0 svc.cdn.net.
+ptr     IN  PTR   host.example.com.
+`
+
+func TestParseZoneFile(t *testing.T) {
+	zone, err := ParseZoneFile(exampleZone, "example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []Record{
+		{Name: "", Type: "A", TTL: 3600, Value: "76.76.21.21"},
+		{Name: "", Type: "AAAA", TTL: 3600, Value: "2001:db8::1"},
+		{Name: "", Type: "MX", TTL: 3600, Value: "mail.example.com", MXPriority: 10},
+		{Name: "", Type: "MX", TTL: 3600, Value: "mail.backup.net", MXPriority: 20},
+		{Name: "www", Type: "CNAME", TTL: 300, Value: "example.com"},
+		{Name: "api", Type: "CNAME", TTL: 300, Value: "cname.vercel-dns.com"},
+		{Name: "sub", Type: "NS", TTL: 3600, Value: "ns1.other.net"},
+		{Name: "_sip._tcp", Type: "SRV", TTL: 3600, SRV: &SRV{Priority: 10, Weight: 60, Port: 5060, Target: "sip.example.com"}},
+		{Name: "", Type: "TXT", TTL: 3600, Value: "v=spf1 include:_spf.example.com ~all"},
+		{Name: "dkim._domainkey", Type: "TXT", TTL: 3600, Value: `v=DKIM1; k=rsa; p=MIGfMA0"GCSq`},
+		{Name: "", Type: "CAA", TTL: 3600, Value: `0 issue "letsencrypt.org"`},
+		{Name: "", Type: "HTTPS", TTL: 3600, HTTPS: &HTTPS{Priority: 1, Target: ".", Params: "alpn=h2,h3"}},
+		{Name: "svc", Type: "HTTPS", TTL: 3600, HTTPS: &HTTPS{Priority: 0, Target: "svc.cdn.net"}},
+	}
+	if !reflect.DeepEqual(zone.Records, expected) {
+		t.Errorf("unexpected records:\n got: %+v\nwant: %+v", zone.Records, expected)
+	}
+
+	var skipped []string
+	for _, s := range zone.Skipped {
+		skipped = append(skipped, s.Type)
+	}
+	if strings.Join(skipped, ",") != "SOA,NS,PTR" {
+		t.Errorf("expected SOA, apex NS and PTR records to be skipped, got %v", zone.Skipped)
+	}
+}
+
+func TestParseZoneFileTTL(t *testing.T) {
+	zone, err := ParseZoneFile("a IN A 1.1.1.1\nb 1h30m A 1.1.1.2\nc A 1.1.1.3\n", "example.com.")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	// Without a $TTL directive, records without a TTL use the last TTL stated.
+	for i, ttl := range []int64{0, 5400, 5400} {
+		if zone.Records[i].TTL != ttl {
+			t.Errorf("expected record %s to have TTL %d, got %d", zone.Records[i].Name, ttl, zone.Records[i].TTL)
+		}
+	}
+}
+
+func TestParseZoneFileErrors(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		content string
+		err     string
+	}{
+		{name: "invalid ipv4", content: "@ A 2001:db8::1", err: "line 1: invalid A record for example.com"},
+		{name: "outside domain", content: "www.other.com. A 1.1.1.1", err: "not within the domain example.com"},
+		{name: "missing fields", content: "@ MX mail", err: "expected 2 fields, got 1"},
+		{name: "include", content: "$INCLUDE other.zone", err: "the $INCLUDE directive is not supported"},
+		{name: "unclosed parenthesis", content: "@ TXT ( \"a\"", err: "unclosed ("},
+		{name: "unterminated string", content: "@ TXT \"a", err: "unterminated quoted string"},
+		{name: "no owner", content: "  A 1.1.1.1", err: "record has no owner name"},
+		{name: "class", content: "@ CH A 1.1.1.1", err: "class CH is not supported"},
+		{name: "srv port", content: "_sip._tcp SRV 1 1 70000 sip", err: "port must be a number between 0 and 65535"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ParseZoneFile(tc.content, "example.com")
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Errorf("expected error containing %q, got %v", tc.err, err)
+			}
+		})
+	}
+}
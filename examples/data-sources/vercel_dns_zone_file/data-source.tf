@@ -0,0 +1,9 @@
+data "vercel_dns_zone_file" "example" {
+  domain    = "example.com"
+  zone_file = file("${path.module}/example.com.zone")
+}
+
+resource "vercel_dns_zone" "example" {
+  domain  = "example.com"
+  records = data.vercel_dns_zone_file.example.records
+}
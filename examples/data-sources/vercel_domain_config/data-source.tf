@@ -0,0 +1,11 @@
+data "vercel_domain_config" "example" {
+  domain = "www.example.com"
+
+  # Stop the apply until the domain points at Vercel.
+  lifecycle {
+    postcondition {
+      condition     = !self.misconfigured
+      error_message = "www.example.com should have a CNAME record of ${coalesce(self.recommended_cname, "cname.vercel-dns.com")}."
+    }
+  }
+}
@@ -0,0 +1,43 @@
+# Rules exported from the Firewall section of the project dashboard.
+data "vercel_firewall_rules_json" "example" {
+  json = file("${path.module}/firewall-rules.json")
+}
+
+resource "vercel_project" "example" {
+  name = "firewall-rules-json-example"
+}
+
+# The rules can either be used within a firewall config...
+resource "vercel_firewall_config" "example" {
+  project_id = vercel_project.example.id
+
+  rules {
+    dynamic "rule" {
+      for_each = data.vercel_firewall_rules_json.example.rules
+      content {
+        name            = rule.value.name
+        description     = rule.value.description
+        active          = rule.value.active
+        condition_group = rule.value.condition_group
+        action          = rule.value.action
+      }
+    }
+  }
+}
+
+# ...or manage each rule individually, keeping the order of the JSON.
+resource "vercel_project" "individual_example" {
+  name = "firewall-rules-json-individual-example"
+}
+
+resource "vercel_firewall_rule" "example" {
+  for_each = { for i, rule in data.vercel_firewall_rules_json.example.rules : rule.name => merge(rule, { priority = i }) }
+
+  project_id      = vercel_project.individual_example.id
+  priority        = each.value.priority
+  name            = each.value.name
+  description     = each.value.description
+  active          = each.value.active
+  condition_group = each.value.condition_group
+  action          = each.value.action
+}
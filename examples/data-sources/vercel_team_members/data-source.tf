@@ -0,0 +1,8 @@
+data "vercel_team_members" "example" {
+  team_id = "team_xxxxxxxxxxxxxxxxxxxxxxxx"
+}
+
+# The email addresses of every owner of the team.
+output "owners" {
+  value = [for m in data.vercel_team_members.example.members : m.email if m.role == "OWNER"]
+}
@@ -0,0 +1,25 @@
+resource "vercel_edge_config" "example" {
+  name = "example"
+}
+
+# A token is created each time Terraform runs, and deleted once
+# Terraform has finished with it. The token is never stored in state.
+ephemeral "vercel_edge_config_token" "example" {
+  edge_config_id = vercel_edge_config.example.id
+  label          = "ci"
+}
+
+# Ephemeral values can only be referenced from other ephemeral contexts,
+# such as provider configuration or write-only attributes.
+resource "vercel_project" "example" {
+  name = "example-project"
+}
+
+resource "vercel_project_environment_variable" "example" {
+  project_id       = vercel_project.example.id
+  key              = "EDGE_CONFIG"
+  value_wo         = ephemeral.vercel_edge_config_token.example.connection_string
+  value_wo_version = 1
+  target           = ["production"]
+  sensitive        = true
+}
@@ -0,0 +1,22 @@
+resource "vercel_project" "example" {
+  name                             = "example-project"
+  protection_bypass_for_automation = true
+}
+
+# The secret is read from Vercel during each Terraform run, and is never
+# stored in plan or state.
+ephemeral "vercel_project_protection_bypass" "example" {
+  project_id = vercel_project.example.id
+}
+
+# The secret can be passed to a secret manager, for example as a
+# write-only attribute of another provider's resource.
+resource "aws_secretsmanager_secret" "example" {
+  name = "vercel-protection-bypass"
+}
+
+resource "aws_secretsmanager_secret_version" "example" {
+  secret_id                = aws_secretsmanager_secret.example.id
+  secret_string_wo         = ephemeral.vercel_project_protection_bypass.example.secret
+  secret_string_wo_version = 1
+}
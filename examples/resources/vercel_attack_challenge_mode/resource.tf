@@ -6,3 +6,15 @@ resource "vercel_attack_challenge_mode" "example" {
   project_id = vercel_project.example.id
   enabled    = true
 }
+
+# Enable Attack Challenge Mode for a fixed window during an incident.
+# Once the window has passed, the next refresh disables it again.
+resource "vercel_project" "incident" {
+  name = "incident-project"
+}
+
+resource "vercel_attack_challenge_mode" "incident" {
+  project_id = vercel_project.incident.id
+  enabled    = true
+  duration   = "4h"
+}
@@ -0,0 +1,14 @@
+resource "vercel_custom_certificate" "example" {
+  certificate           = file("${path.module}/certs/example.com.pem")
+  private_key           = file("${path.module}/certs/example.com.key")
+  certificate_authority = file("${path.module}/certs/ca-chain.pem")
+}
+
+# The expiry is known at plan time, so it can be checked before the
+# certificate is close to expiring.
+check "certificate_expiry" {
+  assert {
+    condition     = timecmp(vercel_custom_certificate.example.expires_at, timeadd(plantimestamp(), "720h")) > 0
+    error_message = "The certificate for ${join(", ", vercel_custom_certificate.example.domains)} expires within 30 days."
+  }
+}
@@ -67,3 +67,15 @@ resource "vercel_dns_record" "txt" {
   ttl    = 60
   value  = "some text value"
 }
+
+resource "vercel_dns_record" "https" {
+  domain = "example.com"
+  name   = "subdomain"
+  type   = "HTTPS"
+  ttl    = 60
+  https = {
+    priority = 1
+    target   = "."
+    params   = "alpn=h2,h3"
+  }
+}
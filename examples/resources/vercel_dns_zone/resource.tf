@@ -0,0 +1,45 @@
+resource "vercel_dns_zone" "example" {
+  domain           = "example.com"
+  remove_unmanaged = true
+
+  records = [
+    {
+      name  = ""
+      type  = "A"
+      value = "76.76.21.21"
+    },
+    {
+      name  = "www"
+      type  = "CNAME"
+      ttl   = 300
+      value = "cname.vercel-dns.com"
+    },
+    {
+      name        = ""
+      type        = "MX"
+      mx_priority = 10
+      value       = "mail.example.com"
+    },
+    {
+      name = "_sip._tcp"
+      type = "SRV"
+      srv = {
+        port     = 5060
+        weight   = 60
+        priority = 10
+        target   = "sip.example.com"
+      }
+    },
+  ]
+}
+
+# Records can also be migrated from an existing zone file.
+data "vercel_dns_zone_file" "migrated" {
+  domain    = "example.org"
+  zone_file = file("${path.module}/example.org.zone")
+}
+
+resource "vercel_dns_zone" "migrated" {
+  domain  = "example.org"
+  records = data.vercel_dns_zone_file.migrated.records
+}
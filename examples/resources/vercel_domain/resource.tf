@@ -0,0 +1,19 @@
+# Add a domain that is registered elsewhere. The domain must
+# then be pointed at Vercel for it to be verified.
+resource "vercel_domain" "example" {
+  name = "example.com"
+}
+
+# Transfer the registration of a domain to Vercel, using the
+# authorization code from the current registrar.
+resource "vercel_domain" "transferred" {
+  name           = "example.org"
+  auth_code      = var.example_org_auth_code
+  expected_price = 20
+  auto_renew     = true
+}
+
+variable "example_org_auth_code" {
+  type      = string
+  sensitive = true
+}
@@ -0,0 +1,11 @@
+# If importing into a personal account, or with a team configured on
+# the provider, use the project ID, domain and source IP.
+# - The project ID can be taken from the project settings page.
+# - Use * as the domain for a bypass of every domain of the project.
+# - The source IP may be an IP address or a CIDR range.
+terraform import vercel_firewall_bypass.example prj_xxxxxxxxxxxxxxxxxxxxxxxxxxxx/example.com/198.51.100.7
+terraform import vercel_firewall_bypass.example "prj_xxxxxxxxxxxxxxxxxxxxxxxxxxxx/*/203.0.113.0/24"
+
+# Alternatively, you can import via the team_id, project ID, domain and source IP.
+# - team_id can be found in the team `settings` tab in the Vercel UI.
+terraform import vercel_firewall_bypass.example team_xxxxxxxxxxxxxxxxxxxxxxxx/prj_xxxxxxxxxxxxxxxxxxxxxxxxxxxx/example.com/198.51.100.7
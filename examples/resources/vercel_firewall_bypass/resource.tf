@@ -0,0 +1,17 @@
+resource "vercel_project" "example" {
+  name = "firewall-bypass-example"
+}
+
+# Allow an uptime monitor to skip system mitigations on every domain of the project.
+resource "vercel_firewall_bypass" "monitoring" {
+  project_id = vercel_project.example.id
+  source_ip  = "203.0.113.0/24"
+  note       = "Uptime monitoring"
+}
+
+# Allow a single office address to skip system mitigations on one domain.
+resource "vercel_firewall_bypass" "office" {
+  project_id = vercel_project.example.id
+  domain     = "example.com"
+  source_ip  = "198.51.100.7"
+}
@@ -93,6 +93,22 @@ resource "vercel_firewall_config" "example" {
         actionDuration = "5m"
       }
     }
+
+    rule {
+      name = "Challenge outside North America"
+      # inc and ninc match against a list of values
+      condition_group = [{
+        conditions = [{
+          type = "geo_continent"
+          op = "inc"
+          neg = true
+          values = ["NA"]
+        }]
+      }]
+      action = {
+        action = "challenge"
+      }
+    }
   }
 }
 
@@ -0,0 +1,9 @@
+# If importing into a personal account, or with a team configured on
+# the provider, use the project ID and rule ID.
+# - The project ID can be taken from the project settings page.
+# - IP rule IDs can be found in the Firewall section of the project dashboard.
+terraform import vercel_firewall_ip_rule.example prj_xxxxxxxxxxxxxxxxxxxxxxxxxxxx/rule_xxxxxxxxxxxx
+
+# Alternatively, you can import via the team_id, project ID and rule ID.
+# - team_id can be found in the team `settings` tab in the Vercel UI.
+terraform import vercel_firewall_ip_rule.example team_xxxxxxxxxxxxxxxxxxxxxxxx/prj_xxxxxxxxxxxxxxxxxxxxxxxxxxxx/rule_xxxxxxxxxxxx
@@ -0,0 +1,20 @@
+resource "vercel_project" "example" {
+  name = "firewall-ip-rule-example"
+}
+
+resource "vercel_firewall_ip_rule" "office" {
+  project_id = vercel_project.example.id
+  priority   = 0
+  hostname   = "*"
+  ip         = "10.0.0.0/8"
+  notes      = "Office network"
+  action     = "bypass"
+}
+
+resource "vercel_firewall_ip_rule" "blocked" {
+  project_id = vercel_project.example.id
+  priority   = 1
+  hostname   = "example.com"
+  ip         = "1.2.3.4"
+  action     = "deny"
+}
@@ -0,0 +1,47 @@
+resource "vercel_project" "example" {
+  name = "firewall-rule-example"
+}
+
+# Rules with a lower priority are evaluated first. Other rules in the
+# project's firewall, such as those created in the dashboard or by another
+# Terraform configuration, are left untouched.
+resource "vercel_firewall_rule" "bypass_internal" {
+  project_id  = vercel_project.example.id
+  priority    = 0
+  name        = "Bypass internal requests"
+  description = "Bypass requests using an internal bearer token"
+  condition_group = [{
+    conditions = [{
+      type  = "header"
+      key   = "Authorization"
+      op    = "eq"
+      value = "Bearer internaltoken"
+    }]
+  }]
+  action = {
+    action = "bypass"
+  }
+}
+
+resource "vercel_firewall_rule" "rate_limit_api" {
+  project_id = vercel_project.example.id
+  priority   = 1
+  name       = "Rate limit API"
+  condition_group = [{
+    conditions = [{
+      type  = "path"
+      op    = "pre"
+      value = "/api"
+    }]
+  }]
+  action = {
+    action = "rate_limit"
+    rate_limit = {
+      algo   = "fixed_window"
+      window = 60
+      limit  = 100
+      keys   = ["ip"]
+      action = "deny"
+    }
+  }
+}
@@ -19,3 +19,24 @@ resource "vercel_log_drain" "example" {
 resource "vercel_project" "example" {
   name = "example"
 }
+
+// Deliver server errors from API routes to an OpenTelemetry collector, sampling
+// a tenth of function logs.
+resource "vercel_log_drain" "otlp" {
+  delivery_format = "protobuf"
+  environments    = ["production"]
+  sources         = ["lambda", "edge"]
+  filter          = "status_code >= 500 and path starts_with \"/api\""
+  sampling_rules = [
+    { source = "lambda", rate = 0.1 },
+  ]
+  endpoint = "https://otel-collector.example.com:4318/v1/logs"
+}
+
+// Deliver production build logs to a syslog server.
+resource "vercel_log_drain" "syslog" {
+  delivery_format = "syslog"
+  environments    = ["production"]
+  sources         = ["build"]
+  endpoint        = "syslog+tls://logs.example.com:6514"
+}
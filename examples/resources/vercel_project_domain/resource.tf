@@ -18,3 +18,30 @@ resource "vercel_project_domain" "example_redirect" {
   redirect             = vercel_project_domain.example.domain
   redirect_status_code = 307
 }
+
+# A domain that waits until it is verified and serving a valid
+# certificate, so that anything depending on it can use it straight away.
+resource "vercel_project_domain" "example_wait" {
+  project_id = vercel_project.example.id
+  domain     = "www.example.com"
+
+  wait_for_certificate = true
+  wait_timeout         = "20m"
+}
+
+# If the domain is in use by another Vercel account or team, it must be
+# verified with the records in `verification`. The domain cannot wait
+# for verification here, as the records are only created afterwards.
+resource "vercel_project_domain" "example_external" {
+  project_id = vercel_project.example.id
+  domain     = "app.example.com"
+}
+
+resource "vercel_dns_record" "example_verification" {
+  for_each = { for v in vercel_project_domain.example_external.verification : v.domain => v }
+
+  domain = "example.com"
+  name   = trimsuffix(each.value.domain, ".example.com")
+  type   = each.value.type
+  value  = each.value.value
+}
@@ -34,4 +34,15 @@ resource "vercel_project_environment_variable" "example_sensitive" {
 	value      = "bar-production"
 	target     = ["production"]
 	sensitive  = true
-}
\ No newline at end of file
+}
+# An environment variable whose value is never stored in
+# Terraform state. This requires Terraform 1.11 or later.
+# Increment value_wo_version whenever the value changes.
+resource "vercel_project_environment_variable" "example_write_only" {
+  project_id       = vercel_project.example.id
+  key              = "foo_write_only"
+  value_wo         = var.secret_value
+  value_wo_version = 1
+  target           = ["production"]
+  sensitive        = true
+}
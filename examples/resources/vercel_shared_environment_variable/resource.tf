@@ -17,3 +17,16 @@ resource "vercel_shared_environment_variable" "example" {
     vercel_project.example.id
   ]
 }
+
+# A shared environment variable whose value is never stored in
+# Terraform state. This requires Terraform 1.11 or later.
+# Increment value_wo_version whenever the value changes.
+resource "vercel_shared_environment_variable" "example_write_only" {
+  key              = "EXAMPLE_WRITE_ONLY"
+  value_wo         = var.secret_value
+  value_wo_version = 1
+  target           = ["production"]
+  project_ids = [
+    vercel_project.example.id
+  ]
+}
@@ -0,0 +1,24 @@
+resource "vercel_project" "example" {
+  name = "example"
+
+  git_repository = {
+    type = "github"
+    repo = "vercel/some-repo"
+  }
+}
+
+# A shared environment variable that does not manage
+# the projects it is linked to.
+resource "vercel_shared_environment_variable" "example" {
+  key    = "EXAMPLE"
+  value  = "some_value"
+  target = ["production"]
+}
+
+# Link the "example" project to the shared environment variable.
+# This can live in a separate Terraform configuration to the
+# shared environment variable itself.
+resource "vercel_shared_environment_variable_project_link" "example" {
+  shared_environment_variable_id = vercel_shared_environment_variable.example.id
+  project_id                     = vercel_project.example.id
+}
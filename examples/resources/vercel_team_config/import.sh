@@ -0,0 +1,4 @@
+# Import the configuration of a team via its ID or its slug.
+# - The team ID and slug can be found in the team `settings` tab in the Vercel UI.
+terraform import vercel_team_config.example team_xxxxxxxxxxxxxxxxxxxxxxxx
+terraform import vercel_team_config.example example
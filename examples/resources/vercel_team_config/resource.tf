@@ -0,0 +1,19 @@
+resource "vercel_team_config" "example" {
+  id                                    = "team_xxxxxxxxxxxxxxxxxxxxxxxx"
+  name                                  = "Example"
+  slug                                  = "example"
+  sensitive_environment_variable_policy = "on"
+  preview_deployment_suffix             = "preview.example.com"
+  hide_ip_addresses                     = true
+  enable_preview_feedback               = "off"
+
+  remote_caching = {
+    enabled = true
+  }
+
+  default_deployment_protection = {
+    vercel_authentication = {
+      deployment_type = "standard_protection"
+    }
+  }
+}
@@ -0,0 +1,10 @@
+# Import a member via the team_id and the user ID of the member.
+# - team_id can be found in the team `settings` tab in the Vercel UI.
+# - The user ID can be found in the account settings of the user, or from the vercel_team_members data source.
+terraform import vercel_team_member.example team_xxxxxxxxxxxxxxxxxxxxxxxx/xxxxxxxxxxxxxxxxxxxxxxxx
+
+# A pending invitation, which has no user ID yet, can be imported via its email address.
+terraform import vercel_team_member.example team_xxxxxxxxxxxxxxxxxxxxxxxx/jane@example.com
+
+# If a team is configured on the provider, the team_id can be omitted.
+terraform import vercel_team_member.example xxxxxxxxxxxxxxxxxxxxxxxx
@@ -0,0 +1,13 @@
+# Invite a user to the team by their email address.
+resource "vercel_team_member" "by_email" {
+  team_id = "team_xxxxxxxxxxxxxxxxxxxxxxxx"
+  email   = "jane@example.com"
+  role    = "DEVELOPER"
+}
+
+# Invite an existing Vercel user to the team by their user ID.
+resource "vercel_team_member" "by_user_id" {
+  team_id = "team_xxxxxxxxxxxxxxxxxxxxxxxx"
+  user_id = "xxxxxxxxxxxxxxxxxxxxxxxx"
+  role    = "VIEWER"
+}
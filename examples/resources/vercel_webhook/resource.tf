@@ -16,3 +16,24 @@ resource "vercel_webhook" "without_project_ids" {
   events   = ["deployment.created", "deployment.succeeded"]
   endpoint = "https://example.com/endpoint"
 }
+
+# The secret of a webhook can't be changed, so changing the trigger destroys the
+# webhook and creates a new one with a new ID and secret. Anything using them is
+# updated in the same apply.
+resource "vercel_webhook" "replaced" {
+  events          = ["deployment.ready", "deployment.error"]
+  endpoint        = "https://example.com/endpoint"
+  replace_trigger = "2024-06"
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+
+resource "vercel_project_environment_variable" "webhook_secret" {
+  project_id = vercel_project.example.id
+  key        = "WEBHOOK_SECRET"
+  value      = vercel_webhook.replaced.secret
+  target     = ["production"]
+  sensitive  = true
+}
@@ -0,0 +1,109 @@
+package firewall
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+// actions are the actions a custom rule can take when it matches a request.
+var actions = []string{"bypass", "log", "challenge", "deny", "rate_limit", "redirect"}
+
+// ParseRules decodes custom firewall rules from JSON, in the form used by the Vercel API and exported from the
+// dashboard. The JSON can be a single rule, a list of rules, or a firewall config with a `rules` list, optionally
+// wrapped in an `active` object as returned by the API. Rules that do not specify whether they are active are
+// treated as active.
+func ParseRules(data string) ([]client.FirewallRule, error) {
+	trimmed := bytes.TrimSpace([]byte(data))
+	if len(trimmed) == 0 {
+		return nil, fmt.Errorf("the JSON is empty")
+	}
+
+	var raw []json.RawMessage
+	switch trimmed[0] {
+	case '[':
+		if err := json.Unmarshal(trimmed, &raw); err != nil {
+			return nil, fmt.Errorf("invalid JSON: %w", err)
+		}
+	case '{':
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(trimmed, &fields); err != nil {
+			return nil, fmt.Errorf("invalid JSON: %w", err)
+		}
+		if active := bytes.TrimSpace(fields["active"]); bytes.HasPrefix(active, []byte("{")) {
+			return ParseRules(string(active))
+		}
+		if rules, ok := fields["rules"]; ok {
+			if err := json.Unmarshal(rules, &raw); err != nil {
+				return nil, fmt.Errorf("invalid JSON: rules: %w", err)
+			}
+			break
+		}
+		raw = []json.RawMessage{trimmed}
+	default:
+		return nil, fmt.Errorf("expected a rule, a list of rules, or a firewall config, got %s", describeJSON(trimmed))
+	}
+
+	rules := make([]client.FirewallRule, len(raw))
+	for i, r := range raw {
+		if err := json.Unmarshal(r, &rules[i]); err != nil {
+			return nil, fmt.Errorf("invalid JSON: rules[%d]: %w", i, err)
+		}
+		var active struct {
+			Active *bool `json:"active"`
+		}
+		if err := json.Unmarshal(r, &active); err != nil {
+			return nil, fmt.Errorf("invalid JSON: rules[%d]: %w", i, err)
+		}
+		if active.Active == nil {
+			rules[i].Active = true
+		}
+	}
+	return rules, nil
+}
+
+func describeJSON(b []byte) string {
+	if len(b) > 20 {
+		return string(b[:20]) + "..."
+	}
+	return string(b)
+}
+
+// ValidateRule validates a custom rule, along with each of its conditions and its action. The fields of the
+// errors are relative to the rule, e.g. `condition_group[0].conditions[1].value`.
+func ValidateRule(rule client.FirewallRule) []FieldError {
+	var errs []FieldError
+	if strings.TrimSpace(rule.Name) == "" {
+		errs = append(errs, FieldError{Field: "name", Message: "a name is required"})
+	}
+	if len(rule.ConditionGroup) == 0 {
+		errs = append(errs, FieldError{Field: "condition_group", Message: "at least one condition group is required"})
+	}
+	for j, group := range rule.ConditionGroup {
+		if len(group.Conditions) == 0 {
+			errs = append(errs, FieldError{Field: fmt.Sprintf("condition_group[%d].conditions", j), Message: "at least one condition is required"})
+		}
+		for k, condition := range group.Conditions {
+			for _, err := range ValidateCondition(condition) {
+				err.Field = fmt.Sprintf("condition_group[%d].conditions[%d].%s", j, k, err.Field)
+				errs = append(errs, err)
+			}
+		}
+	}
+	if !slices.Contains(actions, rule.Action.Mitigate.Action) {
+		errs = append(errs, FieldError{
+			Field:   "action.action",
+			Message: fmt.Sprintf("%q is not a valid action, expected one of: %s", rule.Action.Mitigate.Action, strings.Join(actions, ", ")),
+		})
+		return errs
+	}
+	for _, err := range ValidateAction(rule.Action.Mitigate) {
+		err.Field = "action." + err.Field
+		errs = append(errs, err)
+	}
+	return errs
+}
@@ -0,0 +1,103 @@
+package firewall
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+const exportedRule = `{
+  "id": "rule_deny_admin",
+  "name": "Deny admin",
+  "conditionGroup": [
+    {"conditions": [
+      {"type": "path", "op": "pre", "value": "/admin"},
+      {"type": "geo_country", "op": "inc", "neg": true, "value": ["US", "CA"]}
+    ]}
+  ],
+  "action": {"mitigate": {"action": "deny"}}
+}`
+
+func TestParseRules(t *testing.T) {
+	expected := client.FirewallRule{
+		ID:     "rule_deny_admin",
+		Name:   "Deny admin",
+		Active: true,
+		ConditionGroup: []client.ConditionGroup{{Conditions: []client.Condition{
+			{Type: "path", Op: "pre", Value: "/admin"},
+			{Type: "geo_country", Op: "inc", Neg: true, Values: []string{"US", "CA"}},
+		}}},
+		Action: client.Action{Mitigate: client.Mitigate{Action: "deny"}},
+	}
+	for name, data := range map[string]string{
+		"single rule":   exportedRule,
+		"list of rules": "[" + exportedRule + "]",
+		"config":        `{"firewallEnabled": true, "rules": [` + exportedRule + `], "ips": []}`,
+		"active config": `{"active": {"rules": [` + exportedRule + `]}}`,
+	} {
+		rules, err := ParseRules(data)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", name, err)
+			continue
+		}
+		if len(rules) != 1 || !reflect.DeepEqual(rules[0], expected) {
+			t.Errorf("%s: expected %+v, got %+v", name, expected, rules)
+		}
+	}
+
+	rules, err := ParseRules(`[{"name": "disabled", "active": false}]`)
+	if err != nil || rules[0].Active {
+		t.Errorf("expected a disabled rule to stay disabled, got %+v, %v", rules, err)
+	}
+
+	for data, wantErr := range map[string]string{
+		"":                        "the JSON is empty",
+		`"rule"`:                  "expected a rule, a list of rules, or a firewall config",
+		`[{"name": 1}]`:           "rules[0]",
+		`{"rules": {}}`:           "rules:",
+		`[{"name": "x"`:           "invalid JSON",
+		`{"rules": [], "x": 1`:    "invalid JSON",
+		`[{"conditionGroup": 1}]`: "rules[0]",
+	} {
+		if _, err := ParseRules(data); err == nil || !strings.Contains(err.Error(), wantErr) {
+			t.Errorf("%q: expected error containing %q, got %v", data, wantErr, err)
+		}
+	}
+}
+
+func TestValidateRule(t *testing.T) {
+	rules, err := ParseRules(exportedRule)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if errs := ValidateRule(rules[0]); len(errs) > 0 {
+		t.Errorf("unexpected errors: %v", errs)
+	}
+
+	errs := ValidateRule(client.FirewallRule{
+		ConditionGroup: []client.ConditionGroup{
+			{Conditions: []client.Condition{{Type: "path", Op: "eq", Value: "/"}}},
+			{Conditions: []client.Condition{{Type: "header", Op: "eq", Value: "x"}}},
+		},
+		Action: client.Action{Mitigate: client.Mitigate{Action: "rate_limit"}},
+	})
+	var fields []string
+	for _, err := range errs {
+		fields = append(fields, err.Field)
+	}
+	expected := []string{"name", "condition_group[1].conditions[0].key", "action.rate_limit"}
+	if !reflect.DeepEqual(fields, expected) {
+		t.Errorf("expected errors on %v, got %v", expected, errs)
+	}
+
+	errs = ValidateRule(client.FirewallRule{
+		Name:           "unknown action",
+		ConditionGroup: []client.ConditionGroup{{}},
+		Action:         client.Action{Mitigate: client.Mitigate{Action: "block"}},
+	})
+	if len(errs) != 2 || errs[0].Field != "condition_group[0].conditions" || errs[1].Field != "action.action" {
+		t.Errorf("expected errors on the empty group and action, got %v", errs)
+	}
+}
@@ -0,0 +1,219 @@
+// Package firewall validates the rules of a Vercel firewall offline, so that conditions and actions the API
+// would reject can be reported before a plan is applied.
+package firewall
+
+import (
+	"fmt"
+	"net/netip"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+// FieldError is a validation error for a single field of a condition or action. Field is the name of the
+// field relative to the value being validated, e.g. `value` for a condition or `rate_limit.window` for an
+// action, so that callers can report the error against the field at fault.
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+func (e FieldError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Field, e.Message)
+}
+
+var (
+	// stringOps are the operators for matching free-form strings.
+	stringOps = []string{"eq", "neq", "re", "pre", "suf", "sub", "inc", "ninc"}
+	// keyedOps add checks for whether a key is present to the string operators.
+	keyedOps = append(slices.Clone(stringOps), "ex", "nex")
+	// enumOps are the operators for values from a fixed set, such as countries.
+	enumOps = []string{"eq", "neq", "inc", "ninc"}
+	// numericOps add comparisons to the equality operators.
+	numericOps = []string{"eq", "neq", "inc", "ninc", "gt", "gte", "lt", "lte"}
+)
+
+// conditionOps lists the operators each condition type supports.
+var conditionOps = map[string][]string{
+	"host":               stringOps,
+	"path":               stringOps,
+	"target_path":        stringOps,
+	"user_agent":         stringOps,
+	"geo_city":           stringOps,
+	"header":             keyedOps,
+	"query":              keyedOps,
+	"cookie":             keyedOps,
+	"method":             enumOps,
+	"ip_address":         enumOps,
+	"region":             enumOps,
+	"protocol":           enumOps,
+	"scheme":             enumOps,
+	"environment":        enumOps,
+	"geo_continent":      enumOps,
+	"geo_country":        enumOps,
+	"geo_country_region": enumOps,
+	"ja4_digest":         enumOps,
+	"ja3_digest":         enumOps,
+	"geo_as_number":      numericOps,
+}
+
+// keyedTypes are the condition types that match against a named part of the request, and so require a key.
+var keyedTypes = map[string]bool{
+	"header": true,
+	"query":  true,
+	"cookie": true,
+}
+
+// Operators returns the operators supported by a condition type, or nil if the type is not known.
+func Operators(conditionType string) []string {
+	return conditionOps[conditionType]
+}
+
+// ValidateCondition validates that the operator of a condition is supported by its type, that a key is set
+// only when the type requires one, and that the value is valid for the type and operator. Regular
+// expressions must compile, IP addresses must be addresses or CIDR ranges, and AS numbers must be numbers.
+func ValidateCondition(c client.Condition) []FieldError {
+	var errs []FieldError
+	ops, ok := conditionOps[c.Type]
+	if !ok {
+		return append(errs, FieldError{Field: "type", Message: fmt.Sprintf("%q is not a valid condition type", c.Type)})
+	}
+	if !slices.Contains(ops, c.Op) {
+		return append(errs, FieldError{
+			Field:   "op",
+			Message: fmt.Sprintf("operator %q cannot be used with type %q, expected one of: %s", c.Op, c.Type, strings.Join(ops, ", ")),
+		})
+	}
+
+	if keyedTypes[c.Type] && c.Key == "" {
+		errs = append(errs, FieldError{Field: "key", Message: fmt.Sprintf("a key is required for type %q, to name the %s to match", c.Type, c.Type)})
+	}
+	if !keyedTypes[c.Type] && c.Key != "" {
+		errs = append(errs, FieldError{Field: "key", Message: fmt.Sprintf("a key cannot be used with type %q", c.Type)})
+	}
+
+	switch c.Op {
+	case "ex", "nex":
+		if c.Value != "" || c.Values != nil {
+			errs = append(errs, FieldError{Field: "value", Message: fmt.Sprintf("operator %q only checks whether the %s is present, and cannot have a value", c.Op, c.Type)})
+		}
+		return errs
+	case "inc", "ninc":
+		if c.Value != "" {
+			errs = append(errs, FieldError{Field: "value", Message: fmt.Sprintf("operator %q matches against a list, so `values` must be set instead of `value`", c.Op)})
+			return errs
+		}
+		if len(c.Values) == 0 {
+			errs = append(errs, FieldError{Field: "values", Message: fmt.Sprintf("operator %q requires at least one value", c.Op)})
+		}
+		for i, v := range c.Values {
+			if err := validateValue(c.Type, c.Op, v); err != nil {
+				errs = append(errs, FieldError{Field: fmt.Sprintf("values[%d]", i), Message: err.Error()})
+			}
+		}
+		return errs
+	}
+
+	if c.Values != nil {
+		errs = append(errs, FieldError{Field: "values", Message: fmt.Sprintf("operator %q matches a single value, so `value` must be set instead of `values`", c.Op)})
+		return errs
+	}
+	if c.Value == "" {
+		errs = append(errs, FieldError{Field: "value", Message: fmt.Sprintf("operator %q requires a value", c.Op)})
+		return errs
+	}
+	if err := validateValue(c.Type, c.Op, c.Value); err != nil {
+		errs = append(errs, FieldError{Field: "value", Message: err.Error()})
+	}
+	return errs
+}
+
+func validateValue(conditionType, op, value string) error {
+	if op == "re" {
+		if _, err := regexp.Compile(value); err != nil {
+			return fmt.Errorf("%q is not a valid regular expression: %s", value, err)
+		}
+		return nil
+	}
+	switch conditionType {
+	case "ip_address":
+		return ValidateIP(value)
+	case "geo_as_number":
+		if _, err := strconv.ParseUint(value, 10, 32); err != nil {
+			return fmt.Errorf("%q is not a valid AS number", value)
+		}
+	case "method":
+		if value != strings.ToUpper(value) || strings.ContainsAny(value, " \t") {
+			return fmt.Errorf("%q is not a valid HTTP method, methods must be uppercase, such as GET", value)
+		}
+	}
+	return nil
+}
+
+// ValidateIP validates an IPv4 or IPv6 address, or a CIDR range.
+func ValidateIP(value string) error {
+	if strings.Contains(value, "/") {
+		prefix, err := netip.ParsePrefix(value)
+		if err != nil {
+			return fmt.Errorf("%q is not a valid CIDR range", value)
+		}
+		if prefix.Masked() != prefix {
+			return fmt.Errorf("%q is not a valid CIDR range, as it has host bits set, did you mean %q?", value, prefix.Masked())
+		}
+		return nil
+	}
+	if _, err := netip.ParseAddr(value); err != nil {
+		return fmt.Errorf("%q is not a valid IP address or CIDR range", value)
+	}
+	return nil
+}
+
+const (
+	// MinRateLimitWindow and MaxRateLimitWindow are the bounds, in seconds, of a rate limit window.
+	MinRateLimitWindow = 10
+	MaxRateLimitWindow = 3600
+)
+
+// rateLimitAlgorithms are the algorithms a rate limit can use.
+var rateLimitAlgorithms = []string{"fixed_window", "token_bucket"}
+
+// ValidateAction validates that an action has the settings it needs, and only those settings: rate limits
+// need `rate_limit`, and redirects need `redirect`. Rate limits must use a known algorithm, a window of
+// between 10 seconds and an hour, and a positive limit.
+func ValidateAction(m client.Mitigate) []FieldError {
+	var errs []FieldError
+	switch {
+	case m.Action == "rate_limit" && m.RateLimit == nil:
+		errs = append(errs, FieldError{Field: "rate_limit", Message: "`rate_limit` must be set when the action is rate_limit"})
+	case m.Action != "rate_limit" && m.RateLimit != nil:
+		errs = append(errs, FieldError{Field: "rate_limit", Message: fmt.Sprintf("`rate_limit` can only be set when the action is rate_limit, not %s", m.Action)})
+	}
+	switch {
+	case m.Action == "redirect" && m.Redirect == nil:
+		errs = append(errs, FieldError{Field: "redirect", Message: "`redirect` must be set when the action is redirect"})
+	case m.Action != "redirect" && m.Redirect != nil:
+		errs = append(errs, FieldError{Field: "redirect", Message: fmt.Sprintf("`redirect` can only be set when the action is redirect, not %s", m.Action)})
+	}
+
+	if r := m.RateLimit; r != nil {
+		if !slices.Contains(rateLimitAlgorithms, r.Algo) {
+			errs = append(errs, FieldError{Field: "rate_limit.algo", Message: fmt.Sprintf("%q is not a valid algorithm, expected one of: %s", r.Algo, strings.Join(rateLimitAlgorithms, ", "))})
+		}
+		if r.Window < MinRateLimitWindow || r.Window > MaxRateLimitWindow {
+			errs = append(errs, FieldError{Field: "rate_limit.window", Message: fmt.Sprintf("the window must be between %d and %d seconds, got %d", MinRateLimitWindow, MaxRateLimitWindow, r.Window)})
+		}
+		if r.Limit < 1 {
+			errs = append(errs, FieldError{Field: "rate_limit.limit", Message: fmt.Sprintf("the limit must be at least 1, got %d", r.Limit)})
+		}
+		if len(r.Keys) == 0 {
+			errs = append(errs, FieldError{Field: "rate_limit.keys", Message: "at least one key is required to identify clients, such as ip"})
+		}
+	}
+	if r := m.Redirect; r != nil && !strings.HasPrefix(r.Location, "/") && !strings.HasPrefix(r.Location, "https://") && !strings.HasPrefix(r.Location, "http://") {
+		errs = append(errs, FieldError{Field: "redirect.location", Message: fmt.Sprintf("%q must be a path starting with / or an absolute URL", r.Location)})
+	}
+	return errs
+}
@@ -0,0 +1,91 @@
+package firewall
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+func TestValidateCondition(t *testing.T) {
+	for _, tt := range []struct {
+		condition client.Condition
+		field     string
+		wantErr   string
+	}{
+		{condition: client.Condition{Type: "path", Op: "pre", Value: "/api"}},
+		{condition: client.Condition{Type: "path", Op: "re", Value: `^/api/v[0-9]+/`}},
+		{condition: client.Condition{Type: "path", Op: "re", Value: `^/api/(`}, field: "value", wantErr: "not a valid regular expression"},
+		{condition: client.Condition{Type: "path", Op: "gt", Value: "/api"}, field: "op", wantErr: `operator "gt" cannot be used with type "path"`},
+		{condition: client.Condition{Type: "path", Op: "eq"}, field: "value", wantErr: "requires a value"},
+		{condition: client.Condition{Type: "path", Op: "eq", Key: "x", Value: "/"}, field: "key", wantErr: "cannot be used with type"},
+		{condition: client.Condition{Type: "header", Op: "eq", Value: "Bearer x"}, field: "key", wantErr: "a key is required"},
+		{condition: client.Condition{Type: "header", Op: "eq", Key: "Authorization", Value: "Bearer x"}},
+		{condition: client.Condition{Type: "header", Op: "ex", Key: "x-debug"}},
+		{condition: client.Condition{Type: "header", Op: "ex", Key: "x-debug", Value: "1"}, field: "value", wantErr: "cannot have a value"},
+		{condition: client.Condition{Type: "ip_address", Op: "eq", Value: "10.0.0.0/8"}},
+		{condition: client.Condition{Type: "ip_address", Op: "eq", Value: "2001:db8::1"}},
+		{condition: client.Condition{Type: "ip_address", Op: "eq", Value: "10.0.0.1/8"}, field: "value", wantErr: `did you mean "10.0.0.0/8"`},
+		{condition: client.Condition{Type: "ip_address", Op: "eq", Value: "10.0.0"}, field: "value", wantErr: "not a valid IP address or CIDR range"},
+		{condition: client.Condition{Type: "ip_address", Op: "pre", Value: "10."}, field: "op", wantErr: "expected one of: eq, neq, inc, ninc"},
+		{condition: client.Condition{Type: "ip_address", Op: "inc", Values: []string{"10.0.0.0/8", "bad"}}, field: "values[1]", wantErr: "not a valid IP address"},
+		{condition: client.Condition{Type: "geo_country", Op: "inc", Values: []string{"US", "CA"}}},
+		{condition: client.Condition{Type: "geo_country", Op: "inc", Value: "US"}, field: "value", wantErr: "`values` must be set instead of `value`"},
+		{condition: client.Condition{Type: "geo_country", Op: "inc", Values: []string{}}, field: "values", wantErr: "at least one value"},
+		{condition: client.Condition{Type: "geo_country", Op: "eq", Values: []string{"US"}}, field: "values", wantErr: "`value` must be set instead of `values`"},
+		{condition: client.Condition{Type: "geo_as_number", Op: "gte", Value: "13335"}},
+		{condition: client.Condition{Type: "geo_as_number", Op: "eq", Value: "AS13335"}, field: "value", wantErr: "not a valid AS number"},
+		{condition: client.Condition{Type: "method", Op: "eq", Value: "post"}, field: "value", wantErr: "must be uppercase"},
+		{condition: client.Condition{Type: "referer", Op: "eq", Value: "x"}, field: "type", wantErr: "not a valid condition type"},
+	} {
+		errs := ValidateCondition(tt.condition)
+		if tt.wantErr == "" {
+			if len(errs) > 0 {
+				t.Errorf("%+v: unexpected errors: %v", tt.condition, errs)
+			}
+			continue
+		}
+		if len(errs) != 1 {
+			t.Errorf("%+v: expected a single error containing %q, got %v", tt.condition, tt.wantErr, errs)
+			continue
+		}
+		if errs[0].Field != tt.field || !strings.Contains(errs[0].Message, tt.wantErr) {
+			t.Errorf("%+v: expected error on %s containing %q, got %s", tt.condition, tt.field, tt.wantErr, errs[0])
+		}
+	}
+}
+
+func TestValidateAction(t *testing.T) {
+	rateLimit := func(algo string, window, limit int64, keys ...string) *client.RateLimit {
+		return &client.RateLimit{Algo: algo, Window: window, Limit: limit, Keys: keys, Action: "deny"}
+	}
+	for _, tt := range []struct {
+		action  client.Mitigate
+		fields  []string
+		wantErr string
+	}{
+		{action: client.Mitigate{Action: "deny"}},
+		{action: client.Mitigate{Action: "rate_limit", RateLimit: rateLimit("fixed_window", 60, 100, "ip")}},
+		{action: client.Mitigate{Action: "rate_limit"}, fields: []string{"rate_limit"}, wantErr: "must be set"},
+		{action: client.Mitigate{Action: "deny", RateLimit: rateLimit("fixed_window", 60, 100, "ip")}, fields: []string{"rate_limit"}, wantErr: "not deny"},
+		{action: client.Mitigate{Action: "rate_limit", RateLimit: rateLimit("fixed_window", 5, 100, "ip")}, fields: []string{"rate_limit.window"}, wantErr: "between 10 and 3600 seconds, got 5"},
+		{action: client.Mitigate{Action: "rate_limit", RateLimit: rateLimit("leaky_bucket", 7200, 0)}, fields: []string{"rate_limit.algo", "rate_limit.window", "rate_limit.limit", "rate_limit.keys"}},
+		{action: client.Mitigate{Action: "redirect", Redirect: &client.Redirect{Location: "/bye"}}},
+		{action: client.Mitigate{Action: "redirect", Redirect: &client.Redirect{Location: "example.com"}}, fields: []string{"redirect.location"}, wantErr: "absolute URL"},
+		{action: client.Mitigate{Action: "redirect"}, fields: []string{"redirect"}, wantErr: "must be set"},
+	} {
+		errs := ValidateAction(tt.action)
+		if len(errs) != len(tt.fields) {
+			t.Errorf("%+v: expected errors on %v, got %v", tt.action, tt.fields, errs)
+			continue
+		}
+		for i, err := range errs {
+			if err.Field != tt.fields[i] {
+				t.Errorf("%+v: expected error on %s, got %s", tt.action, tt.fields[i], err)
+			}
+		}
+		if tt.wantErr != "" && !strings.Contains(errs[0].Message, tt.wantErr) {
+			t.Errorf("%+v: expected error containing %q, got %s", tt.action, tt.wantErr, errs[0])
+		}
+	}
+}
@@ -0,0 +1,84 @@
+// Package logdrain validates the configuration of a Vercel log drain offline, so that endpoints and sampling
+// rules the API would reject can be reported before a plan is applied.
+package logdrain
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+var (
+	// Formats are the formats log data can be delivered in.
+	Formats = []string{"json", "ndjson", "syslog", "protobuf"}
+	// Sources are the sources a log drain can send logs for.
+	Sources = []string{"static", "edge", "external", "build", "lambda"}
+	// Environments are the environments a log drain can send logs for.
+	Environments = []string{"production", "preview"}
+)
+
+// HTTPFormat reports whether logs in a format are delivered as HTTP requests, and so whether custom headers
+// and endpoint verification apply. Syslog is delivered over a syslog connection instead.
+func HTTPFormat(format string) bool {
+	return format != "syslog"
+}
+
+// ValidateEndpoint validates that an endpoint can receive logs in a delivery format. Syslog endpoints must use
+// the syslog or syslog+tls scheme and include a port, and every other format is delivered to an HTTP endpoint.
+func ValidateEndpoint(format, endpoint string) error {
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Host == "" {
+		return fmt.Errorf("%q is not a valid URL", endpoint)
+	}
+	if !HTTPFormat(format) {
+		if u.Scheme != "syslog+tls" && u.Scheme != "syslog" {
+			return fmt.Errorf("the %s format is delivered over syslog, so the endpoint must start with syslog+tls:// or syslog://, got %q", format, endpoint)
+		}
+		if u.Port() == "" {
+			return fmt.Errorf("the syslog endpoint %q must include a port, such as %s://%s:6514", endpoint, u.Scheme, u.Hostname())
+		}
+		return nil
+	}
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return fmt.Errorf("the %s format is delivered as HTTP requests, so the endpoint must start with https:// or http://, got %q", format, endpoint)
+	}
+	return nil
+}
+
+// RuleError is a validation error for a single field of a sampling rule, identified by the index of the rule.
+type RuleError struct {
+	Index   int
+	Field   string
+	Message string
+}
+
+func (e RuleError) Error() string {
+	return fmt.Sprintf("sampling_rules[%d].%s: %s", e.Index, e.Field, e.Message)
+}
+
+// ValidateSamplingRules validates that each sampling rule applies to one of the sources of the log drain, that
+// no source has more than one rule, and that each rate is greater than 0 and at most 1.
+func ValidateSamplingRules(sources []string, rules []client.LogDrainSamplingRule) []RuleError {
+	var errs []RuleError
+	seen := map[string]int{}
+	for i, rule := range rules {
+		switch {
+		case !slices.Contains(Sources, rule.Source):
+			errs = append(errs, RuleError{Index: i, Field: "source", Message: fmt.Sprintf("%q is not a valid source, expected one of: %s", rule.Source, strings.Join(Sources, ", "))})
+		case sources != nil && !slices.Contains(sources, rule.Source):
+			errs = append(errs, RuleError{Index: i, Field: "source", Message: fmt.Sprintf("the log drain does not send logs for %q, so it cannot be sampled", rule.Source)})
+		default:
+			if j, ok := seen[rule.Source]; ok {
+				errs = append(errs, RuleError{Index: i, Field: "source", Message: fmt.Sprintf("%q is already sampled by sampling_rules[%d]", rule.Source, j)})
+			}
+			seen[rule.Source] = i
+		}
+		if rule.Rate <= 0 || rule.Rate > 1 {
+			errs = append(errs, RuleError{Index: i, Field: "rate", Message: fmt.Sprintf("the rate must be greater than 0 and at most 1, got %g", rule.Rate)})
+		}
+	}
+	return errs
+}
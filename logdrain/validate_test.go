@@ -0,0 +1,60 @@
+package logdrain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+func TestValidateEndpoint(t *testing.T) {
+	for _, tt := range []struct {
+		format   string
+		endpoint string
+		wantErr  string
+	}{
+		{format: "json", endpoint: "https://logs.example.com/vercel"},
+		{format: "protobuf", endpoint: "https://otel.example.com:4318/v1/logs"},
+		{format: "ndjson", endpoint: "syslog+tls://logs.example.com:6514", wantErr: "must start with https://"},
+		{format: "syslog", endpoint: "syslog+tls://logs.example.com:6514"},
+		{format: "syslog", endpoint: "https://logs.example.com", wantErr: "must start with syslog+tls://"},
+		{format: "syslog", endpoint: "syslog+tls://logs.example.com", wantErr: `such as syslog+tls://logs.example.com:6514`},
+		{format: "json", endpoint: "logs.example.com", wantErr: "not a valid URL"},
+	} {
+		err := ValidateEndpoint(tt.format, tt.endpoint)
+		if tt.wantErr == "" {
+			if err != nil {
+				t.Errorf("%s %s: unexpected error: %s", tt.format, tt.endpoint, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("%s %s: expected error containing %q, got %v", tt.format, tt.endpoint, tt.wantErr, err)
+		}
+	}
+}
+
+func TestValidateSamplingRules(t *testing.T) {
+	sources := []string{"lambda", "edge"}
+	for _, tt := range []struct {
+		rules  []client.LogDrainSamplingRule
+		fields []string
+	}{
+		{rules: []client.LogDrainSamplingRule{{Source: "lambda", Rate: 0.1}, {Source: "edge", Rate: 1}}},
+		{rules: []client.LogDrainSamplingRule{{Source: "lambda", Rate: 0}}, fields: []string{"sampling_rules[0].rate"}},
+		{rules: []client.LogDrainSamplingRule{{Source: "static", Rate: 0.5}}, fields: []string{"sampling_rules[0].source"}},
+		{rules: []client.LogDrainSamplingRule{{Source: "functions", Rate: 0.5}}, fields: []string{"sampling_rules[0].source"}},
+		{rules: []client.LogDrainSamplingRule{{Source: "edge", Rate: 0.5}, {Source: "edge", Rate: 1.5}}, fields: []string{"sampling_rules[1].source", "sampling_rules[1].rate"}},
+	} {
+		errs := ValidateSamplingRules(sources, tt.rules)
+		if len(errs) != len(tt.fields) {
+			t.Errorf("%+v: expected errors on %v, got %v", tt.rules, tt.fields, errs)
+			continue
+		}
+		for i, err := range errs {
+			if !strings.HasPrefix(err.Error(), tt.fields[i]+":") {
+				t.Errorf("%+v: expected error on %s, got %s", tt.rules, tt.fields[i], err)
+			}
+		}
+	}
+}
@@ -20,7 +20,7 @@ func newAliasDataSource() datasource.DataSource {
 }
 
 type aliasDataSource struct {
-	client *client.Client
+	client client.AliasesAPI
 }
 
 func (d *aliasDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -33,11 +33,11 @@ func (d *aliasDataSource) Configure(ctx context.Context, req datasource.Configur
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(client.AliasesAPI)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected client.AliasesAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/vercel/terraform-provider-vercel/client"
 )
@@ -21,7 +22,7 @@ func newAttackChallengeModeDataSource() datasource.DataSource {
 }
 
 type attackChallengeModeDataSource struct {
-	client *client.Client
+	client client.ProjectsAPI
 }
 
 func (d *attackChallengeModeDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -34,11 +35,11 @@ func (d *attackChallengeModeDataSource) Configure(ctx context.Context, req datas
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(client.ProjectsAPI)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected client.ProjectsAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -70,12 +71,48 @@ Attack Challenge Mode prevent malicious traffic by showing a verification challe
 				Computed:    true,
 				Description: "Whether Attack Challenge Mode is enabled or not.",
 			},
+			"updated_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The time, in RFC 3339 format, that Attack Challenge Mode was last enabled or disabled. Not set if it has never been changed.",
+			},
+			"expires_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The time, in RFC 3339 format, that Attack Challenge Mode will be automatically disabled at. Not set if it is disabled, or is enabled without a time limit.",
+			},
 		},
 	}
 }
 
+// AttackChallengeModeDataSource represents the information terraform knows about the Attack Challenge Mode of a project.
+type AttackChallengeModeDataSource struct {
+	ID        types.String `tfsdk:"id"`
+	ProjectID types.String `tfsdk:"project_id"`
+	TeamID    types.String `tfsdk:"team_id"`
+	Enabled   types.Bool   `tfsdk:"enabled"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+	ExpiresAt types.String `tfsdk:"expires_at"`
+}
+
+func responseToAttackChallengeModeDataSource(out client.AttackChallengeMode) AttackChallengeModeDataSource {
+	result := AttackChallengeModeDataSource{
+		ID:        types.StringValue(out.ProjectID),
+		ProjectID: types.StringValue(out.ProjectID),
+		TeamID:    toTeamID(out.TeamID),
+		Enabled:   types.BoolValue(out.Enabled),
+		UpdatedAt: types.StringNull(),
+		ExpiresAt: types.StringNull(),
+	}
+	if out.UpdatedAt != 0 {
+		result.UpdatedAt = types.StringValue(formatMillis(out.UpdatedAt))
+	}
+	if out.Enabled && out.ActiveUntil != nil {
+		result.ExpiresAt = types.StringValue(formatMillis(*out.ActiveUntil))
+	}
+	return result
+}
+
 func (d *attackChallengeModeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var config AttackChallengeMode
+	var config AttackChallengeModeDataSource
 	diags := req.Config.Get(ctx, &config)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -99,7 +136,7 @@ func (d *attackChallengeModeDataSource) Read(ctx context.Context, req datasource
 		return
 	}
 
-	result := responseToAttackChallengeMode(out)
+	result := responseToAttackChallengeModeDataSource(out)
 	tflog.Info(ctx, "read attack challenge mode", map[string]interface{}{
 		"team_id":    result.TeamID.ValueString(),
 		"project_id": result.ProjectID.ValueString(),
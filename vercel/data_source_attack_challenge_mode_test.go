@@ -4,12 +4,11 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
 func TestAcc_AttackChallengeModeDataSource(t *testing.T) {
-	name := acctest.RandString(16)
+	name := randString(t, 16)
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -19,6 +18,9 @@ func TestAcc_AttackChallengeModeDataSource(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("data.vercel_attack_challenge_mode.never_enabled", "enabled", "false"),
 					resource.TestCheckResourceAttr("data.vercel_attack_challenge_mode.enabled", "enabled", "true"),
+					resource.TestCheckResourceAttrSet("data.vercel_attack_challenge_mode.enabled", "updated_at"),
+					resource.TestCheckResourceAttrPair("data.vercel_attack_challenge_mode.enabled", "expires_at", "vercel_attack_challenge_mode.enabled", "expires_at"),
+					resource.TestCheckNoResourceAttr("data.vercel_attack_challenge_mode.never_enabled", "expires_at"),
 					resource.TestCheckResourceAttr("data.vercel_attack_challenge_mode.disabled", "enabled", "false"),
 				),
 			},
@@ -46,6 +48,7 @@ resource "vercel_project" "enabled" {
 resource "vercel_attack_challenge_mode" "enabled" {
     project_id = vercel_project.enabled.id
     enabled = true
+    duration = "2h"
     %[2]s
 }
 
@@ -23,7 +23,7 @@ func newDeploymentDataSource() datasource.DataSource {
 }
 
 type deploymentDataSource struct {
-	client *client.Client
+	client client.DeploymentsAPI
 }
 
 func (d *deploymentDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -36,11 +36,11 @@ func (d *deploymentDataSource) Configure(ctx context.Context, req datasource.Con
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(client.DeploymentsAPI)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected client.DeploymentsAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
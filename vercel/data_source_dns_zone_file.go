@@ -0,0 +1,213 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vercel/terraform-provider-vercel/dns"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &dnsZoneFileDataSource{}
+)
+
+func newDNSZoneFileDataSource() datasource.DataSource {
+	return &dnsZoneFileDataSource{}
+}
+
+type dnsZoneFileDataSource struct{}
+
+func (d *dnsZoneFileDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_zone_file"
+}
+
+// Schema returns the schema information for a DNS zone file data source
+func (d *dnsZoneFileDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Parses an RFC 1035 (BIND) zone file into DNS records.
+
+This data source does not create or read any records in Vercel. It only turns the zone file into ` + "`records`" + `, which can
+be used directly with a ` + "`vercel_dns_zone`" + ` resource to migrate a domain to Vercel.
+
+SOA records and NS records for the root domain are skipped, as these are managed by Vercel. Records of a type that Vercel
+does not support are skipped with a warning. The ` + "`$ORIGIN`" + ` and ` + "`$TTL`" + ` directives are supported, but ` + "`$INCLUDE`" + ` is not.`,
+		Attributes: map[string]schema.Attribute{
+			"domain": schema.StringAttribute{
+				Description: "The domain name, or zone, that the zone file describes. Relative names within the zone file are resolved against this domain, unless an `$ORIGIN` directive is used.",
+				Required:    true,
+			},
+			"zone_file": schema.StringAttribute{
+				Description: "The contents of the zone file, for example from the `file` function.",
+				Required:    true,
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"records": schema.ListNestedAttribute{
+				Description: "The DNS records within the zone file. Names are relative to the domain, and hostnames are fully qualified without a trailing dot.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The subdomain name of the record. This is an empty string if the record is for the root domain.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The type of DNS record.",
+							Computed:    true,
+						},
+						"value": schema.StringAttribute{
+							Description: "The value of the DNS record. This is not set for SRV or HTTPS records.",
+							Computed:    true,
+						},
+						"ttl": schema.Int64Attribute{
+							Description: "The TTL value in seconds, if the zone file specifies one.",
+							Computed:    true,
+						},
+						"mx_priority": schema.Int64Attribute{
+							Description: "The priority of an MX record.",
+							Computed:    true,
+						},
+						"srv": schema.SingleNestedAttribute{
+							Description: "The settings for an SRV record.",
+							Computed:    true,
+							Attributes: map[string]schema.Attribute{
+								"weight": schema.Int64Attribute{
+									Description: "A relative weight for records with the same priority.",
+									Computed:    true,
+								},
+								"port": schema.Int64Attribute{
+									Description: "The TCP or UDP port on which the service is to be found.",
+									Computed:    true,
+								},
+								"priority": schema.Int64Attribute{
+									Description: "The priority of the target host.",
+									Computed:    true,
+								},
+								"target": schema.StringAttribute{
+									Description: "The canonical hostname of the machine providing the service.",
+									Computed:    true,
+								},
+							},
+						},
+						"https": schema.SingleNestedAttribute{
+							Description: "The settings for an HTTPS record.",
+							Computed:    true,
+							Attributes: map[string]schema.Attribute{
+								"priority": schema.Int64Attribute{
+									Description: "The priority of the record. A priority of 0 makes the record an alias for `target`.",
+									Computed:    true,
+								},
+								"target": schema.StringAttribute{
+									Description: "The hostname of the service, or `.` to use the name of the record itself.",
+									Computed:    true,
+								},
+								"params": schema.StringAttribute{
+									Description: "The SvcParams of the record, as space separated `key=value` pairs.",
+									Computed:    true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// DNSZoneFile represents the information terraform knows about a DNS zone file data source
+type DNSZoneFile struct {
+	Domain   types.String    `tfsdk:"domain"`
+	ZoneFile types.String    `tfsdk:"zone_file"`
+	ID       types.String    `tfsdk:"id"`
+	Records  []DNSZoneRecord `tfsdk:"records"`
+}
+
+func convertZoneFileRecord(r dns.Record) DNSZoneRecord {
+	record := DNSZoneRecord{
+		Name:       types.StringValue(r.Name),
+		Type:       types.StringValue(r.Type),
+		Value:      types.StringValue(r.Value),
+		TTL:        types.Int64Null(),
+		MXPriority: types.Int64Null(),
+	}
+	if r.TTL != 0 {
+		record.TTL = types.Int64Value(r.TTL)
+	}
+	if r.Type == "MX" {
+		record.MXPriority = types.Int64Value(r.MXPriority)
+	}
+	if r.SRV != nil {
+		record.Value = types.StringNull()
+		record.SRV = &SRV{
+			Port:     types.Int64Value(r.SRV.Port),
+			Priority: types.Int64Value(r.SRV.Priority),
+			Target:   types.StringValue(r.SRV.Target),
+			Weight:   types.Int64Value(r.SRV.Weight),
+		}
+	}
+	if r.HTTPS != nil {
+		record.Value = types.StringNull()
+		record.HTTPS = &HTTPSRecord{
+			Priority: types.Int64Value(r.HTTPS.Priority),
+			Target:   types.StringValue(r.HTTPS.Target),
+			Params:   types.StringNull(),
+		}
+		if r.HTTPS.Params != "" {
+			record.HTTPS.Params = types.StringValue(r.HTTPS.Params)
+		}
+	}
+	return record
+}
+
+// Read will parse a zone file and provide terraform with the records within it.
+// It is called by the provider whenever data source values should be read to update state.
+func (d *dnsZoneFileDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config DNSZoneFile
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zone, err := dns.ParseZoneFile(config.ZoneFile.ValueString(), config.Domain.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing zone file",
+			fmt.Sprintf("Could not parse zone file for domain %s: %s", config.Domain.ValueString(), err),
+		)
+		return
+	}
+
+	var unsupported []string
+	for _, s := range zone.Skipped {
+		if s.Type == "SOA" || s.Type == "NS" {
+			continue
+		}
+		unsupported = append(unsupported, fmt.Sprintf("line %d: %s", s.Line, s.Reason))
+	}
+	if len(unsupported) > 0 {
+		resp.Diagnostics.AddWarning(
+			"Unsupported DNS records skipped",
+			fmt.Sprintf("The following records in the zone file for %s cannot be managed by Vercel and have been skipped:\n%s", config.Domain.ValueString(), strings.Join(unsupported, "\n")),
+		)
+	}
+
+	config.ID = types.StringValue(strings.TrimSuffix(config.Domain.ValueString(), "."))
+	config.Records = []DNSZoneRecord{}
+	for _, r := range zone.Records {
+		config.Records = append(config.Records, convertZoneFileRecord(r))
+	}
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
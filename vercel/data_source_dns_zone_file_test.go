@@ -0,0 +1,70 @@
+package vercel_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_DNSZoneFileDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSZoneFileDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vercel_dns_zone_file.test", "id", "example.com"),
+					resource.TestCheckResourceAttr("data.vercel_dns_zone_file.test", "records.#", "5"),
+					resource.TestCheckResourceAttr("data.vercel_dns_zone_file.test", "records.0.name", ""),
+					resource.TestCheckResourceAttr("data.vercel_dns_zone_file.test", "records.0.type", "A"),
+					resource.TestCheckResourceAttr("data.vercel_dns_zone_file.test", "records.0.value", "76.76.21.21"),
+					resource.TestCheckResourceAttr("data.vercel_dns_zone_file.test", "records.0.ttl", "3600"),
+					resource.TestCheckResourceAttr("data.vercel_dns_zone_file.test", "records.1.name", "www"),
+					resource.TestCheckResourceAttr("data.vercel_dns_zone_file.test", "records.1.type", "CNAME"),
+					resource.TestCheckResourceAttr("data.vercel_dns_zone_file.test", "records.1.value", "cname.vercel-dns.com"),
+					resource.TestCheckResourceAttr("data.vercel_dns_zone_file.test", "records.1.ttl", "300"),
+					resource.TestCheckResourceAttr("data.vercel_dns_zone_file.test", "records.2.type", "MX"),
+					resource.TestCheckResourceAttr("data.vercel_dns_zone_file.test", "records.2.mx_priority", "10"),
+					resource.TestCheckResourceAttr("data.vercel_dns_zone_file.test", "records.2.value", "mail.example.com"),
+					resource.TestCheckResourceAttr("data.vercel_dns_zone_file.test", "records.3.type", "SRV"),
+					resource.TestCheckNoResourceAttr("data.vercel_dns_zone_file.test", "records.3.value"),
+					resource.TestCheckResourceAttr("data.vercel_dns_zone_file.test", "records.3.srv.priority", "10"),
+					resource.TestCheckResourceAttr("data.vercel_dns_zone_file.test", "records.3.srv.weight", "60"),
+					resource.TestCheckResourceAttr("data.vercel_dns_zone_file.test", "records.3.srv.port", "5060"),
+					resource.TestCheckResourceAttr("data.vercel_dns_zone_file.test", "records.3.srv.target", "sip.example.com"),
+					resource.TestCheckResourceAttr("data.vercel_dns_zone_file.test", "records.4.type", "TXT"),
+					resource.TestCheckResourceAttr("data.vercel_dns_zone_file.test", "records.4.value", "v=DKIM1; k=rsa; p=MIGf"),
+				),
+			},
+			{
+				Config: `
+data "vercel_dns_zone_file" "test" {
+    domain    = "example.com"
+    zone_file = "www.other.com. IN A 76.76.21.21"
+}
+`,
+				ExpectError: regexp.MustCompile("is not within the domain example.com"),
+			},
+		},
+	})
+}
+
+const testAccDNSZoneFileDataSourceConfig = `
+data "vercel_dns_zone_file" "test" {
+    domain    = "example.com"
+    zone_file = <<-EOT
+        $ORIGIN example.com.
+        $TTL 1h
+        @ IN SOA ns1.vercel-dns.com. hostmaster.example.com. 1 7200 3600 1209600 3600
+        @ IN NS ns1.vercel-dns.com.
+        @ IN A 76.76.21.21
+        www 300 IN CNAME cname.vercel-dns.com.
+        @ IN MX 10 mail
+        _sip._tcp IN SRV 10 60 5060 sip
+        dkim._domainkey IN TXT ( "v=DKIM1; k=rsa; "
+                                 "p=MIGf" )
+    EOT
+}
+`
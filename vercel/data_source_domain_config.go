@@ -0,0 +1,225 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &domainConfigDataSource{}
+	_ datasource.DataSourceWithConfigure = &domainConfigDataSource{}
+)
+
+func newDomainConfigDataSource() datasource.DataSource {
+	return &domainConfigDataSource{}
+}
+
+type domainConfigDataSource struct {
+	client client.DomainsAPI
+}
+
+func (d *domainConfigDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_config"
+}
+
+func (d *domainConfigDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.DomainsAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.DomainsAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Schema returns the schema information for a domain config data source
+func (d *domainConfigDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides information about the DNS configuration of a domain.
+
+This reports whether a domain is configured correctly to be served by Vercel, along with the A and CNAME record values
+it should use. Combined with a ` + "`postcondition`" + `, it can be used to stop an apply until the DNS of a domain is ready.
+
+The configuration is read from Vercel each time the data source is read, and is not cached.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"domain": schema.StringAttribute{
+				Description: "The domain name to check the configuration of. This can be a subdomain, such as `www.example.com`.",
+				Required:    true,
+			},
+			"team_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the team the domain exists under. Required when configuring a team data source if a default team has not been set in the provider.",
+			},
+			"project_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID or name of a project that the domain is used by. When set, the recommended values are specific to the project.",
+			},
+			"misconfigured": schema.BoolAttribute{
+				Description: "Whether the domain is misconfigured, meaning Vercel cannot serve it or issue a certificate for it.",
+				Computed:    true,
+			},
+			"configured_by": schema.StringAttribute{
+				Description: "How the domain points at Vercel: `A`, `CNAME`, `http` or `dns-01`. This is null if the domain does not point at Vercel.",
+				Computed:    true,
+			},
+			"accepted_challenges": schema.ListAttribute{
+				Description: "The challenges that can be used to issue a certificate for the domain: `dns-01` or `http-01`.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"service_type": schema.StringAttribute{
+				Description: "How the domain is managed. `zeit.world` for domains registered with Vercel, `external` for domains registered elsewhere, or `na` if unknown.",
+				Computed:    true,
+			},
+			"nameservers": schema.ListAttribute{
+				Description: "The nameservers the domain currently uses.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"a_values": schema.ListAttribute{
+				Description: "The A record values the domain currently resolves to.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"cnames": schema.ListAttribute{
+				Description: "The CNAME record values the domain currently resolves to.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"recommended_a_values": schema.ListAttribute{
+				Description: "The A record values the domain should use, if configured with A records.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"recommended_cname": schema.StringAttribute{
+				Description: "The CNAME record value the domain should use, if configured with a CNAME record.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// DomainConfig represents the terraform state for a domain config data source.
+type DomainConfig struct {
+	ID                 types.String `tfsdk:"id"`
+	Domain             types.String `tfsdk:"domain"`
+	TeamID             types.String `tfsdk:"team_id"`
+	ProjectID          types.String `tfsdk:"project_id"`
+	Misconfigured      types.Bool   `tfsdk:"misconfigured"`
+	ConfiguredBy       types.String `tfsdk:"configured_by"`
+	AcceptedChallenges types.List   `tfsdk:"accepted_challenges"`
+	ServiceType        types.String `tfsdk:"service_type"`
+	Nameservers        types.List   `tfsdk:"nameservers"`
+	AValues            types.List   `tfsdk:"a_values"`
+	CNAMEs             types.List   `tfsdk:"cnames"`
+	RecommendedAValues types.List   `tfsdk:"recommended_a_values"`
+	RecommendedCNAME   types.String `tfsdk:"recommended_cname"`
+}
+
+func convertResponseToDomainConfig(ctx context.Context, response client.DomainConfig, projectID types.String) (DomainConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	result := DomainConfig{
+		ID:               types.StringValue(response.Domain),
+		Domain:           types.StringValue(response.Domain),
+		TeamID:           toTeamID(response.TeamID),
+		ProjectID:        projectID,
+		Misconfigured:    types.BoolValue(response.Misconfigured),
+		ConfiguredBy:     types.StringPointerValue(response.ConfiguredBy),
+		ServiceType:      types.StringValue(response.ServiceType),
+		RecommendedCNAME: types.StringNull(),
+	}
+
+	// The recommendations are ranked, with the preferred values having the lowest rank.
+	recommendedA := []string{}
+	sort.SliceStable(response.RecommendedIPv4, func(i, j int) bool {
+		return response.RecommendedIPv4[i].Rank < response.RecommendedIPv4[j].Rank
+	})
+	if len(response.RecommendedIPv4) > 0 {
+		recommendedA = response.RecommendedIPv4[0].Value
+	}
+	sort.SliceStable(response.RecommendedCNAME, func(i, j int) bool {
+		return response.RecommendedCNAME[i].Rank < response.RecommendedCNAME[j].Rank
+	})
+	if len(response.RecommendedCNAME) > 0 {
+		result.RecommendedCNAME = types.StringValue(response.RecommendedCNAME[0].Value)
+	}
+
+	for _, l := range []struct {
+		target *types.List
+		values []string
+	}{
+		{target: &result.AcceptedChallenges, values: response.AcceptedChallenges},
+		{target: &result.Nameservers, values: response.Nameservers},
+		{target: &result.AValues, values: response.AValues},
+		{target: &result.CNAMEs, values: response.CNAMEs},
+		{target: &result.RecommendedAValues, values: recommendedA},
+	} {
+		list, d := types.ListValueFrom(ctx, types.StringType, nonNil(l.values))
+		diags.Append(d...)
+		*l.target = list
+	}
+	return result, diags
+}
+
+// Read will read the configuration of a domain by requesting it from the Vercel API, and will update terraform
+// with this information.
+func (d *domainConfigDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config DomainConfig
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := d.client.GetDomainConfig(ctx, config.Domain.ValueString(), config.ProjectID.ValueString(), config.TeamID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading domain config",
+			fmt.Sprintf("Could not read the configuration of domain %s %s, unexpected error: %s",
+				config.TeamID.ValueString(),
+				config.Domain.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	result, diags := convertResponseToDomainConfig(ctx, out, config.ProjectID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "read domain config", map[string]interface{}{
+		"team_id":       result.TeamID.ValueString(),
+		"domain":        result.Domain.ValueString(),
+		"misconfigured": result.Misconfigured.ValueBool(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
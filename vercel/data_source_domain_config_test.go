@@ -0,0 +1,31 @@
+package vercel_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_DomainConfigDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "vercel_domain_config" "test" {
+  domain = "%s"
+  %s
+}
+`, testDomain(), teamIDConfig()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vercel_domain_config.test", "domain", testDomain()),
+					resource.TestCheckResourceAttrSet("data.vercel_domain_config.test", "misconfigured"),
+					resource.TestCheckResourceAttrSet("data.vercel_domain_config.test", "recommended_a_values.0"),
+					resource.TestCheckResourceAttrSet("data.vercel_domain_config.test", "recommended_cname"),
+				),
+			},
+		},
+	})
+}
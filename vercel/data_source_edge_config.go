@@ -21,7 +21,7 @@ func newEdgeConfigDataSource() datasource.DataSource {
 }
 
 type edgeConfigDataSource struct {
-	client *client.Client
+	client client.EdgeConfigAPI
 }
 
 func (d *edgeConfigDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -34,11 +34,11 @@ func (d *edgeConfigDataSource) Configure(ctx context.Context, req datasource.Con
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(client.EdgeConfigAPI)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected client.EdgeConfigAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -23,7 +23,7 @@ func newEdgeConfigSchemaDataSource() datasource.DataSource {
 }
 
 type edgeConfigSchemaDataSource struct {
-	client *client.Client
+	client client.EdgeConfigAPI
 }
 
 func (d *edgeConfigSchemaDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -36,11 +36,11 @@ func (d *edgeConfigSchemaDataSource) Configure(ctx context.Context, req datasour
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(client.EdgeConfigAPI)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected client.EdgeConfigAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -20,7 +20,7 @@ func newEndpointVerificationDataSource() datasource.DataSource {
 }
 
 type endpointVerificationDataSource struct {
-	client *client.Client
+	client client.LogDrainsAPI
 }
 
 func (d *endpointVerificationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -33,11 +33,11 @@ func (d *endpointVerificationDataSource) Configure(ctx context.Context, req data
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(client.LogDrainsAPI)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected client.LogDrainsAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -0,0 +1,284 @@
+package vercel
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vercel/terraform-provider-vercel/client"
+	"github.com/vercel/terraform-provider-vercel/firewall"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &firewallRulesJSONDataSource{}
+)
+
+func newFirewallRulesJSONDataSource() datasource.DataSource {
+	return &firewallRulesJSONDataSource{}
+}
+
+type firewallRulesJSONDataSource struct{}
+
+func (d *firewallRulesJSONDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_firewall_rules_json"
+}
+
+// Schema returns the schema information for a firewall rules JSON data source
+func (d *firewallRulesJSONDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Decodes custom firewall rules from the JSON form used by the Vercel API and exported from the dashboard.
+
+Only the given JSON is read; the firewall configuration of existing projects is not looked up. The resulting ` + "`rules`" + `
+can be used with a ` + "`dynamic`" + ` block in a ` + "`vercel_firewall_config`" + ` resource, or with ` + "`for_each`" + ` on a
+` + "`vercel_firewall_rule`" + ` resource, so that rules prototyped in the dashboard do not need to be rewritten by hand.
+
+Unset fields are decoded as null, so that applying the rules produces the same plan as writing them out in full.`,
+		Attributes: map[string]schema.Attribute{
+			"json": schema.StringAttribute{
+				Description: "The JSON to decode. This can be a single rule, a list of rules, or a firewall config with a `rules` list.",
+				Required:    true,
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"rules": schema.ListNestedAttribute{
+				Description: "The rules within the JSON, in the order they are evaluated.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the rule, if the JSON includes one.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name to identify the rule.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "A description of the rule.",
+							Computed:    true,
+						},
+						"active": schema.BoolAttribute{
+							Description: "Whether the rule is active. Rules that do not specify this are active.",
+							Computed:    true,
+						},
+						"condition_group": schema.ListNestedAttribute{
+							Description: "Sets of conditions that may match a request.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"conditions": schema.ListNestedAttribute{
+										Description: "Conditions that must all match within a group.",
+										Computed:    true,
+										NestedObject: schema.NestedAttributeObject{
+											Attributes: map[string]schema.Attribute{
+												"type": schema.StringAttribute{
+													Description: "Request key type to match against.",
+													Computed:    true,
+												},
+												"op": schema.StringAttribute{
+													Description: "How to compare type to value.",
+													Computed:    true,
+												},
+												"neg": schema.BoolAttribute{
+													Description: "Whether the condition is negated.",
+													Computed:    true,
+												},
+												"key": schema.StringAttribute{
+													Description: "Key within type to match against.",
+													Computed:    true,
+												},
+												"value": schema.StringAttribute{
+													Description: "Value to match against.",
+													Computed:    true,
+												},
+												"values": schema.ListAttribute{
+													Description: "Values to match against, used by the `inc` and `ninc` operators.",
+													Computed:    true,
+													ElementType: types.StringType,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"action": schema.SingleNestedAttribute{
+							Description: "Actions to take when the condition groups match a request.",
+							Computed:    true,
+							Attributes: map[string]schema.Attribute{
+								"action": schema.StringAttribute{
+									Description: "Base action.",
+									Computed:    true,
+								},
+								"rate_limit": schema.SingleNestedAttribute{
+									Description: "Behavior of a rate limiting action.",
+									Computed:    true,
+									Attributes: map[string]schema.Attribute{
+										"algo": schema.StringAttribute{
+											Description: "Rate limiting algorithm.",
+											Computed:    true,
+										},
+										"window": schema.Int64Attribute{
+											Description: "Time window in seconds.",
+											Computed:    true,
+										},
+										"limit": schema.Int64Attribute{
+											Description: "Number of requests allowed in the window.",
+											Computed:    true,
+										},
+										"keys": schema.ListAttribute{
+											Description: "Keys used to bucket an individual client.",
+											Computed:    true,
+											ElementType: types.StringType,
+										},
+										"action": schema.StringAttribute{
+											Description: "Action to take when the rate limit is exceeded.",
+											Computed:    true,
+										},
+									},
+								},
+								"redirect": schema.SingleNestedAttribute{
+									Description: "How to redirect a request.",
+									Computed:    true,
+									Attributes: map[string]schema.Attribute{
+										"location": schema.StringAttribute{
+											Computed: true,
+										},
+										"permanent": schema.BoolAttribute{
+											Computed: true,
+										},
+									},
+								},
+								"action_duration": schema.StringAttribute{
+									Description: "Forward persistence of a rule action.",
+									Computed:    true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// FirewallRulesJSON represents the information terraform knows about a firewall rules JSON data source
+type FirewallRulesJSON struct {
+	JSON  types.String   `tfsdk:"json"`
+	ID    types.String   `tfsdk:"id"`
+	Rules []FirewallRule `tfsdk:"rules"`
+}
+
+func stringOrNull(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
+
+// convertJSONFirewallRule converts a decoded rule into its terraform representation. Fields that are not set are
+// null, matching a rule that leaves them out of its configuration.
+func convertJSONFirewallRule(rule client.FirewallRule) (FirewallRule, error) {
+	action, err := fromMitigate(rule.Action.Mitigate, Mitigate{ActionDuration: types.StringNull()})
+	if err != nil {
+		return FirewallRule{}, err
+	}
+	r := FirewallRule{
+		ID:             stringOrNull(rule.ID),
+		Name:           types.StringValue(rule.Name),
+		Description:    stringOrNull(rule.Description),
+		Active:         types.BoolValue(rule.Active),
+		ConditionGroup: []ConditionGroup{},
+		Action:         action,
+	}
+	for _, group := range rule.ConditionGroup {
+		conditions := []Condition{}
+		for _, condition := range group.Conditions {
+			c := Condition{
+				Type:   types.StringValue(condition.Type),
+				Op:     types.StringValue(condition.Op),
+				Neg:    types.BoolValue(condition.Neg),
+				Key:    stringOrNull(condition.Key),
+				Value:  stringOrNull(condition.Value),
+				Values: types.ListNull(types.StringType),
+			}
+			if condition.Values != nil {
+				values := make([]attr.Value, len(condition.Values))
+				for i, v := range condition.Values {
+					values[i] = types.StringValue(v)
+				}
+				c.Values = types.ListValueMust(types.StringType, values)
+			}
+			conditions = append(conditions, c)
+		}
+		r.ConditionGroup = append(r.ConditionGroup, ConditionGroup{Conditions: conditions})
+	}
+	return r, nil
+}
+
+// Read will decode and validate the firewall rules within the JSON, and provide terraform with the rules.
+// It is called by the provider whenever data source values should be read to update state.
+func (d *firewallRulesJSONDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config FirewallRulesJSON
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules, err := firewall.ParseRules(config.JSON.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("json"),
+			"Error decoding firewall rules",
+			fmt.Sprintf("Could not decode firewall rules from JSON: %s", err),
+		)
+		return
+	}
+	for i, rule := range rules {
+		for _, err := range firewall.ValidateRule(rule) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("json"),
+				"Invalid firewall rule",
+				fmt.Sprintf("rules[%d].%s: the rule %q is invalid: %s", i, err.Field, rule.Name, err.Message),
+			)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.Rules = []FirewallRule{}
+	for _, rule := range rules {
+		r, err := convertJSONFirewallRule(rule)
+		if err != nil {
+			resp.Diagnostics.AddError("Error decoding firewall rules", err.Error())
+			return
+		}
+		config.Rules = append(config.Rules, r)
+	}
+	// The ID is based on the decoded rules, so that formatting changes to the JSON do not change it.
+	b, err := json.Marshal(rules)
+	if err != nil {
+		resp.Diagnostics.AddError("Error decoding firewall rules", err.Error())
+		return
+	}
+	sum := sha256.Sum256(b)
+	config.ID = types.StringValue(hex.EncodeToString(sum[:]))
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
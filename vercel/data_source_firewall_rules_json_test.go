@@ -0,0 +1,98 @@
+package vercel_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_FirewallRulesJSONDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallRulesJSONDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.vercel_firewall_rules_json.test", "id"),
+					resource.TestCheckResourceAttr("data.vercel_firewall_rules_json.test", "rules.#", "2"),
+					resource.TestCheckResourceAttr("data.vercel_firewall_rules_json.test", "rules.0.id", "rule_deny_admin"),
+					resource.TestCheckResourceAttr("data.vercel_firewall_rules_json.test", "rules.0.name", "Deny admin"),
+					resource.TestCheckResourceAttr("data.vercel_firewall_rules_json.test", "rules.0.active", "true"),
+					resource.TestCheckNoResourceAttr("data.vercel_firewall_rules_json.test", "rules.0.description"),
+					resource.TestCheckResourceAttr("data.vercel_firewall_rules_json.test", "rules.0.condition_group.0.conditions.0.type", "path"),
+					resource.TestCheckResourceAttr("data.vercel_firewall_rules_json.test", "rules.0.condition_group.0.conditions.0.value", "/admin"),
+					resource.TestCheckNoResourceAttr("data.vercel_firewall_rules_json.test", "rules.0.condition_group.0.conditions.0.key"),
+					resource.TestCheckResourceAttr("data.vercel_firewall_rules_json.test", "rules.0.condition_group.0.conditions.1.neg", "true"),
+					resource.TestCheckResourceAttr("data.vercel_firewall_rules_json.test", "rules.0.condition_group.0.conditions.1.values.#", "2"),
+					resource.TestCheckResourceAttr("data.vercel_firewall_rules_json.test", "rules.0.action.action", "deny"),
+					resource.TestCheckNoResourceAttr("data.vercel_firewall_rules_json.test", "rules.0.action.action_duration"),
+					resource.TestCheckResourceAttr("data.vercel_firewall_rules_json.test", "rules.1.active", "false"),
+					resource.TestCheckResourceAttr("data.vercel_firewall_rules_json.test", "rules.1.action.rate_limit.window", "60"),
+					resource.TestCheckResourceAttr("data.vercel_firewall_rules_json.test", "rules.1.action.rate_limit.keys.0", "ip"),
+				),
+			},
+			{
+				Config: `
+data "vercel_firewall_rules_json" "test" {
+  json = jsonencode([{
+    name           = "missing key"
+    conditionGroup = [{ conditions = [{ type = "header", op = "eq", value = "x" }] }]
+    action         = { mitigate = { action = "deny" } }
+  }])
+}
+`,
+				ExpectError: regexp.MustCompile(`rules\[0\]\.condition_group\[0\]\.conditions\[0\]\.key`),
+			},
+			{
+				Config: `
+data "vercel_firewall_rules_json" "test" {
+  json = "[{"
+}
+`,
+				ExpectError: regexp.MustCompile(`Could not decode firewall rules`),
+			},
+		},
+	})
+}
+
+const testAccFirewallRulesJSONDataSourceConfig = `
+data "vercel_firewall_rules_json" "test" {
+  json = <<-EOT
+    {
+      "firewallEnabled": true,
+      "rules": [
+        {
+          "id": "rule_deny_admin",
+          "name": "Deny admin",
+          "active": true,
+          "conditionGroup": [
+            {
+              "conditions": [
+                { "type": "path", "op": "pre", "value": "/admin" },
+                { "type": "geo_country", "op": "inc", "neg": true, "value": ["US", "CA"] }
+              ]
+            }
+          ],
+          "action": { "mitigate": { "action": "deny" } }
+        },
+        {
+          "name": "Rate limit API",
+          "description": "Limit requests to the API",
+          "active": false,
+          "conditionGroup": [
+            { "conditions": [{ "type": "path", "op": "pre", "value": "/api" }] }
+          ],
+          "action": {
+            "mitigate": {
+              "action": "rate_limit",
+              "rateLimit": { "algo": "fixed_window", "window": 60, "limit": 100, "keys": ["ip"], "action": "deny" }
+            }
+          }
+        }
+      ]
+    }
+  EOT
+}
+`
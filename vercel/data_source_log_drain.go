@@ -23,7 +23,7 @@ func newLogDrainDataSource() datasource.DataSource {
 }
 
 type logDrainDataSource struct {
-	client *client.Client
+	client client.LogDrainsAPI
 }
 
 func (d *logDrainDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -36,11 +36,11 @@ func (d *logDrainDataSource) Configure(ctx context.Context, req datasource.Confi
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(client.LogDrainsAPI)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected client.LogDrainsAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -69,7 +69,7 @@ Teams on Pro and Enterprise plans can subscribe to log drains that are generic a
 				Description: "The ID of the team the Log Drain should exist under. Required when configuring a team resource if a default team has not been set in the provider.",
 			},
 			"delivery_format": schema.StringAttribute{
-				Description: "The format log data should be delivered in. Can be `json` or `ndjson`.",
+				Description: "The format log data should be delivered in. Can be `json`, `ndjson`, `protobuf` or `syslog`.",
 				Computed:    true,
 			},
 			"environments": schema.SetAttribute{
@@ -91,6 +91,26 @@ Teams on Pro and Enterprise plans can subscribe to log drains that are generic a
 				Description: "A ratio of logs matching the sampling rate will be sent to your log drain. Should be a value between 0 and 1. If unspecified, all logs are sent.",
 				Computed:    true,
 			},
+			"sampling_rules": schema.ListNestedAttribute{
+				Description: "Sampling rates for individual sources, which override `sampling_rate` for logs from that source.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"source": schema.StringAttribute{
+							Description: "The source the rule applies to.",
+							Computed:    true,
+						},
+						"rate": schema.Float64Attribute{
+							Description: "The ratio of logs from the source that are sent to the log drain.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"filter": schema.StringAttribute{
+				Description: "An expression selecting the logs that are delivered. If not set, every log is delivered.",
+				Computed:    true,
+			},
 			"sources": schema.SetAttribute{
 				Description: "A set of sources that the log drain should send logs for. Valid values are `static`, `edge`, `external`, `build` and `function`.",
 				Computed:    true,
@@ -112,6 +132,8 @@ type LogDrainWithoutSecret struct {
 	Headers        types.Map     `tfsdk:"headers"`
 	ProjectIDs     types.Set     `tfsdk:"project_ids"`
 	SamplingRate   types.Float64 `tfsdk:"sampling_rate"`
+	SamplingRules  types.List    `tfsdk:"sampling_rules"`
+	Filter         types.String  `tfsdk:"filter"`
 	Sources        types.Set     `tfsdk:"sources"`
 	Endpoint       types.String  `tfsdk:"endpoint"`
 }
@@ -137,6 +159,11 @@ func responseToLogDrainWithoutSecret(ctx context.Context, out client.LogDrain) (
 		return l, diags
 	}
 
+	samplingRules, diags := samplingRulesToList(ctx, out.SamplingRules)
+	if diags.HasError() {
+		return l, diags
+	}
+
 	return LogDrainWithoutSecret{
 		ID:             types.StringValue(out.ID),
 		TeamID:         toTeamID(out.TeamID),
@@ -147,6 +174,8 @@ func responseToLogDrainWithoutSecret(ctx context.Context, out client.LogDrain) (
 		Headers:        headers,
 		Sources:        sources,
 		ProjectIDs:     projectIDs,
+		SamplingRules:  samplingRules,
+		Filter:         stringOrNull(out.Filter),
 	}, nil
 }
 
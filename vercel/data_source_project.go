@@ -25,7 +25,7 @@ func newProjectDataSource() datasource.DataSource {
 }
 
 type projectDataSource struct {
-	client *client.Client
+	client client.ProjectsAPI
 }
 
 func (d *projectDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -38,11 +38,11 @@ func (d *projectDataSource) Configure(ctx context.Context, req datasource.Config
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(client.ProjectsAPI)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected client.ProjectsAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -21,7 +21,7 @@ func newProjectDeploymentRetentionDataSource() datasource.DataSource {
 }
 
 type projectDeploymentRetentionDataSource struct {
-	client *client.Client
+	client client.ProjectsAPI
 }
 
 func (r *projectDeploymentRetentionDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -34,11 +34,11 @@ func (r *projectDeploymentRetentionDataSource) Configure(ctx context.Context, re
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(client.ProjectsAPI)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected DataSource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected client.ProjectsAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
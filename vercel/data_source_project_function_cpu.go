@@ -22,7 +22,7 @@ func newProjectFunctionCPUDataSource() datasource.DataSource {
 }
 
 type projectFunctionCPUDataSource struct {
-	client *client.Client
+	client client.ProjectsAPI
 }
 
 func (d *projectFunctionCPUDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -35,11 +35,11 @@ func (d *projectFunctionCPUDataSource) Configure(ctx context.Context, req dataso
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(client.ProjectsAPI)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected client.ProjectsAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -23,7 +23,7 @@ func newSharedEnvironmentVariableDataSource() datasource.DataSource {
 }
 
 type sharedEnvironmentVariableDataSource struct {
-	client *client.Client
+	client client.SharedEnvironmentVariablesAPI
 }
 
 func (d *sharedEnvironmentVariableDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -31,7 +31,7 @@ func (d *sharedEnvironmentVariableDataSource) Metadata(ctx context.Context, req
 }
 
 func (d *sharedEnvironmentVariableDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
-	var config SharedEnvironmentVariable
+	var config SharedEnvironmentVariableDataSource
 	diags := req.Config.Get(ctx, &config)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -69,11 +69,11 @@ func (d *sharedEnvironmentVariableDataSource) Configure(ctx context.Context, req
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(client.SharedEnvironmentVariablesAPI)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected client.SharedEnvironmentVariablesAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -135,6 +135,31 @@ For more detailed information, please see the [Vercel documentation](https://ver
 	}
 }
 
+// SharedEnvironmentVariableDataSource reflects the state terraform stores internally for a shared environment
+// variable data source.
+type SharedEnvironmentVariableDataSource struct {
+	Target     types.Set    `tfsdk:"target"`
+	Key        types.String `tfsdk:"key"`
+	Value      types.String `tfsdk:"value"`
+	TeamID     types.String `tfsdk:"team_id"`
+	ProjectIDs types.Set    `tfsdk:"project_ids"`
+	ID         types.String `tfsdk:"id"`
+	Sensitive  types.Bool   `tfsdk:"sensitive"`
+}
+
+func convertResponseToSharedEnvironmentVariableDataSource(response client.SharedEnvironmentVariableResponse) SharedEnvironmentVariableDataSource {
+	e := convertResponseToSharedEnvironmentVariable(response, types.StringNull(), types.Int64Null())
+	return SharedEnvironmentVariableDataSource{
+		Target:     e.Target,
+		Key:        e.Key,
+		Value:      e.Value,
+		TeamID:     e.TeamID,
+		ProjectIDs: e.ProjectIDs,
+		ID:         e.ID,
+		Sensitive:  e.Sensitive,
+	}
+}
+
 func isSameTarget(a []string, b []types.String) bool {
 	if len(a) != len(b) {
 		return false
@@ -151,7 +176,7 @@ func isSameTarget(a []string, b []types.String) bool {
 // with this information.
 // It is called by the provider whenever data source values should be read to update state.
 func (d *sharedEnvironmentVariableDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var config SharedEnvironmentVariable
+	var config SharedEnvironmentVariableDataSource
 	diags := req.Config.Get(ctx, &config)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -224,14 +249,7 @@ func (d *sharedEnvironmentVariableDataSource) Read(ctx context.Context, req data
 		return
 	}
 
-	result := convertResponseToSharedEnvironmentVariable(out, types.StringNull())
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error converting shared environment variable response to model",
-			"Could not read shared environment variable, unexpected error: "+err.Error(),
-		)
-		return
-	}
+	result := convertResponseToSharedEnvironmentVariableDataSource(out)
 	tflog.Info(ctx, "read shared environment variable", map[string]interface{}{
 		"team_id":    result.TeamID.ValueString(),
 		"project_id": result.ID.ValueString(),
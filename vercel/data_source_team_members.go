@@ -0,0 +1,168 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &teamMembersDataSource{}
+	_ datasource.DataSourceWithConfigure = &teamMembersDataSource{}
+)
+
+func newTeamMembersDataSource() datasource.DataSource {
+	return &teamMembersDataSource{}
+}
+
+type teamMembersDataSource struct {
+	client client.TeamsAPI
+}
+
+func (d *teamMembersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_members"
+}
+
+func (d *teamMembersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.TeamsAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.TeamsAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Schema returns the schema information for a team members data source
+func (d *teamMembersDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides information about the members of a Vercel team.
+
+The members include users that have been invited but have not yet accepted. Invitations to an email address that does
+not yet belong to a Vercel account are included without a user ID.`,
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the team to list the members of. Required when configuring a team data source if a default team has not been set in the provider.",
+			},
+			"members": schema.ListNestedAttribute{
+				Description: "The members of the team.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"user_id": schema.StringAttribute{
+							Description: "The ID of the user. This is not set for an invitation to an email address that does not yet belong to a Vercel account.",
+							Computed:    true,
+						},
+						"email": schema.StringAttribute{
+							Description: "The email address of the user.",
+							Computed:    true,
+						},
+						"username": schema.StringAttribute{
+							Description: "The username of the user.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the user, if they have set one.",
+							Computed:    true,
+						},
+						"role": schema.StringAttribute{
+							Description: "The role the user has within the team.",
+							Computed:    true,
+						},
+						"confirmed": schema.BoolAttribute{
+							Description: "Whether the user has accepted the invitation to join the team.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// TeamMembers represents the information terraform knows about a team members data source
+type TeamMembers struct {
+	TeamID  types.String        `tfsdk:"team_id"`
+	Members []TeamMemberSummary `tfsdk:"members"`
+}
+
+// TeamMemberSummary represents a single member within a team members data source
+type TeamMemberSummary struct {
+	UserID    types.String `tfsdk:"user_id"`
+	Email     types.String `tfsdk:"email"`
+	Username  types.String `tfsdk:"username"`
+	Name      types.String `tfsdk:"name"`
+	Role      types.String `tfsdk:"role"`
+	Confirmed types.Bool   `tfsdk:"confirmed"`
+}
+
+// Read will read the members of a team by requesting them from the Vercel API, and will update terraform
+// with this information.
+// It is called by the provider whenever data source values should be read to update state.
+func (d *teamMembersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config TeamMembers
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	team, err := d.client.Team(ctx, config.TeamID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading team members",
+			fmt.Sprintf("Could not get team %s, unexpected error: %s", config.TeamID.ValueString(), err),
+		)
+		return
+	}
+	out, err := d.client.ListTeamMembers(ctx, team.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading team members",
+			fmt.Sprintf("Could not list the members of team %s, unexpected error: %s", team.ID, err),
+		)
+		return
+	}
+
+	result := TeamMembers{
+		TeamID:  toTeamID(team.ID),
+		Members: []TeamMemberSummary{},
+	}
+	for _, m := range out {
+		result.Members = append(result.Members, TeamMemberSummary{
+			UserID:    stringOrNull(m.UserID),
+			Email:     stringOrNull(m.Email),
+			Username:  stringOrNull(m.Username),
+			Name:      stringOrNull(m.Name),
+			Role:      types.StringValue(m.Role),
+			Confirmed: types.BoolValue(m.Confirmed),
+		})
+	}
+	tflog.Info(ctx, "read team members", map[string]interface{}{
+		"team_id": result.TeamID.ValueString(),
+		"members": len(result.Members),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
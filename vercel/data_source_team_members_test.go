@@ -0,0 +1,49 @@
+package vercel_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_TeamMembersDataSource(t *testing.T) {
+	name := randString(t, 16)
+	email := fmt.Sprintf("terraform-%s@example.com", name)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamMembersDataSourceConfig(email, teamIDConfig()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.vercel_team_members.test", "team_id"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.vercel_team_members.test", "members.*", map[string]string{
+						"email":     email,
+						"role":      "MEMBER",
+						"confirmed": "false",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.vercel_team_members.test", "members.*", map[string]string{
+						"role":      "OWNER",
+						"confirmed": "true",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccTeamMembersDataSourceConfig(email, teamID string) string {
+	return fmt.Sprintf(`
+resource "vercel_team_member" "test" {
+    email = "%[1]s"
+    role  = "MEMBER"
+    %[2]s
+}
+
+data "vercel_team_members" "test" {
+    %[2]s
+    depends_on = [vercel_team_member.test]
+}
+`, email, teamID)
+}
@@ -0,0 +1,199 @@
+package vercel
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &edgeConfigTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &edgeConfigTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose     = &edgeConfigTokenEphemeralResource{}
+)
+
+func newEdgeConfigTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &edgeConfigTokenEphemeralResource{}
+}
+
+type edgeConfigTokenEphemeralResource struct {
+	client client.EdgeConfigAPI
+}
+
+func (r *edgeConfigTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_edge_config_token"
+}
+
+func (r *edgeConfigTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.EdgeConfigAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected client.EdgeConfigAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Schema returns the schema information for an edgeConfigToken ephemeral resource.
+func (r *edgeConfigTokenEphemeralResource) Schema(_ context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides an Edge Config Token ephemeral resource.
+
+An Edge Config token is used to authenticate against an Edge Config's endpoint.
+
+The token is created when Terraform opens the ephemeral resource, and is deleted again once Terraform has finished with it.
+Neither the token nor the connection string are ever persisted to plan or state, making this suitable for passing
+the values into a secret manager or a write-only attribute.
+`,
+		Attributes: map[string]schema.Attribute{
+			"label": schema.StringAttribute{
+				Description: "The label of the Edge Config Token.",
+				Required:    true,
+			},
+			"edge_config_id": schema.StringAttribute{
+				Description: "The ID of the Edge Config store.",
+				Required:    true,
+			},
+			"team_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the team the Edge Config should exist under. Required when configuring a team resource if a default team has not been set in the provider.",
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"token": schema.StringAttribute{
+				Description: "A read access token used for authenticating against the Edge Config's endpoint for high volume, low-latency requests.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"connection_string": schema.StringAttribute{
+				Description: "A connection string is a URL that connects a project to an Edge Config. The variable can be called anything, but our Edge Config client SDK will search for process.env.EDGE_CONFIG by default.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+// edgeConfigTokenPrivateKey is the key used to store the created token in the ephemeral resource's
+// private data, so that it can be deleted when the ephemeral resource is closed.
+const edgeConfigTokenPrivateKey = "edge_config_token"
+
+type edgeConfigTokenPrivateData struct {
+	TeamID       string `json:"team_id"`
+	EdgeConfigID string `json:"edge_config_id"`
+	Token        string `json:"token"`
+}
+
+// Open will create a new Edge Config Token via the Vercel API. The token is returned to terraform without
+// being stored in state.
+func (r *edgeConfigTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var config EdgeConfigToken
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := r.client.CreateEdgeConfigToken(ctx, client.CreateEdgeConfigTokenRequest{
+		Label:        config.Label.ValueString(),
+		TeamID:       config.TeamID.ValueString(),
+		EdgeConfigID: config.EdgeConfigID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Edge Config Token",
+			"Could not create Edge Config Token, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	result := responseToEdgeConfigToken(out)
+	tflog.Info(ctx, "opened edge config token", map[string]interface{}{
+		"team_id":        result.TeamID.ValueString(),
+		"edge_config_id": result.EdgeConfigID.ValueString(),
+		"id":             result.ID.ValueString(),
+	})
+
+	private, err := json.Marshal(edgeConfigTokenPrivateData{
+		TeamID:       out.TeamID,
+		EdgeConfigID: out.EdgeConfigID,
+		Token:        out.Token,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Edge Config Token",
+			"Could not store Edge Config Token for cleanup, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	diags = resp.Private.SetKey(ctx, edgeConfigTokenPrivateKey, private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Result.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Close deletes the Edge Config Token created by Open, so that a new token does not accumulate on every run.
+func (r *edgeConfigTokenEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	b, diags := req.Private.GetKey(ctx, edgeConfigTokenPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || b == nil {
+		return
+	}
+
+	var private edgeConfigTokenPrivateData
+	if err := json.Unmarshal(b, &private); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting Edge Config Token",
+			"Could not read Edge Config Token for cleanup, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	err := r.client.DeleteEdgeConfigToken(ctx, client.EdgeConfigTokenRequest{
+		TeamID:       private.TeamID,
+		EdgeConfigID: private.EdgeConfigID,
+		Token:        private.Token,
+	})
+	if client.NotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting Edge Config Token",
+			fmt.Sprintf(
+				"Could not delete Edge Config Token for Edge Config %s, unexpected error: %s",
+				private.EdgeConfigID,
+				err,
+			),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "closed edge config token", map[string]interface{}{
+		"team_id":        private.TeamID,
+		"edge_config_id": private.EdgeConfigID,
+	})
+}
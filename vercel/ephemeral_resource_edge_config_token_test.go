@@ -0,0 +1,64 @@
+package vercel_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/vercel/terraform-provider-vercel/vercel"
+)
+
+// testAccProtoV6ProviderFactoriesWithEcho includes the echo provider, which allows the values of
+// ephemeral resources to be surfaced into state so they can be checked by tests.
+var testAccProtoV6ProviderFactoriesWithEcho = map[string]func() (tfprotov6.ProviderServer, error){
+	"vercel": providerserver.NewProtocol6WithError(vercel.NewWithTransport(testTransport())),
+	"echo":   echoprovider.NewProviderServer(),
+}
+
+func TestAcc_EdgeConfigTokenEphemeralResource(t *testing.T) {
+	name := randString(t, 16)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithEcho,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEphemeralResourceEdgeConfigToken(name, teamIDConfig()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("echo.test", "data.label", "ephemeral token"),
+					resource.TestCheckResourceAttrSet("echo.test", "data.id"),
+					resource.TestCheckResourceAttrSet("echo.test", "data.token"),
+					resource.TestCheckResourceAttrSet("echo.test", "data.connection_string"),
+					resource.TestCheckResourceAttrPair("echo.test", "data.edge_config_id", "vercel_edge_config.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEphemeralResourceEdgeConfigToken(name, team string) string {
+	return fmt.Sprintf(`
+resource "vercel_edge_config" "test" {
+    name = "%[1]s"
+    %[2]s
+}
+
+ephemeral "vercel_edge_config_token" "test" {
+    label          = "ephemeral token"
+    edge_config_id = vercel_edge_config.test.id
+    %[2]s
+}
+
+provider "echo" {
+    data = ephemeral.vercel_edge_config_token.test
+}
+
+resource "echo" "test" {}
+`, name, team)
+}
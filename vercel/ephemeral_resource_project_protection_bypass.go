@@ -0,0 +1,144 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &projectProtectionBypassEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &projectProtectionBypassEphemeralResource{}
+)
+
+func newProjectProtectionBypassEphemeralResource() ephemeral.EphemeralResource {
+	return &projectProtectionBypassEphemeralResource{}
+}
+
+type projectProtectionBypassEphemeralResource struct {
+	client client.ProjectsAPI
+}
+
+func (r *projectProtectionBypassEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_protection_bypass"
+}
+
+func (r *projectProtectionBypassEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.ProjectsAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected client.ProjectsAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Schema returns the schema information for a project protection bypass ephemeral resource.
+func (r *projectProtectionBypassEphemeralResource) Schema(_ context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides a Project Protection Bypass ephemeral resource.
+
+This reads the secret used to bypass Deployment Protection for automation on a Vercel Project, without persisting it to plan or state.
+Protection Bypass for Automation must already be enabled on the project, for example via the ` + "`protection_bypass_for_automation`" + ` field on the ` + "`vercel_project`" + ` resource.
+
+For more detailed information, please see the [Vercel documentation](https://vercel.com/docs/security/deployment-protection/methods-to-bypass-deployment-protection/protection-bypass-automation).
+`,
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Description: "The ID of the Project.",
+				Required:    true,
+			},
+			"team_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the team the Project exists under. Required when configuring a team resource if a default team has not been set in the provider.",
+			},
+			"secret": schema.StringAttribute{
+				Description: "The secret used to bypass Deployment Protection for automation. This should be sent in the `x-vercel-protection-bypass` header.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+type ProjectProtectionBypass struct {
+	ProjectID types.String `tfsdk:"project_id"`
+	TeamID    types.String `tfsdk:"team_id"`
+	Secret    types.String `tfsdk:"secret"`
+}
+
+// automationBypassSecret returns the secret for the automation bypass of a project, if one exists.
+func automationBypassSecret(protectionBypass map[string]client.ProtectionBypass) (string, bool) {
+	for k, v := range protectionBypass {
+		if v.Scope == "automation-bypass" {
+			return k, true
+		}
+	}
+	return "", false
+}
+
+// Open reads the protection bypass secret for a project from the Vercel API.
+func (r *projectProtectionBypassEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var config ProjectProtectionBypass
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := r.client.GetProject(ctx, config.ProjectID.ValueString(), config.TeamID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Project Protection Bypass",
+			fmt.Sprintf("Could not get Project %s %s, unexpected error: %s",
+				config.TeamID.ValueString(),
+				config.ProjectID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	secret, ok := automationBypassSecret(out.ProtectionBypass)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Error reading Project Protection Bypass",
+			fmt.Sprintf(
+				"Protection Bypass for Automation is not enabled for Project %s. Enable it with the `protection_bypass_for_automation` field on the `vercel_project` resource.",
+				config.ProjectID.ValueString(),
+			),
+		)
+		return
+	}
+
+	result := ProjectProtectionBypass{
+		ProjectID: config.ProjectID,
+		TeamID:    toTeamID(out.TeamID),
+		Secret:    types.StringValue(secret),
+	}
+	tflog.Info(ctx, "read project protection bypass", map[string]interface{}{
+		"team_id":    result.TeamID.ValueString(),
+		"project_id": result.ProjectID.ValueString(),
+	})
+
+	diags = resp.Result.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,88 @@
+package vercel_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_ProjectProtectionBypassEphemeralResource(t *testing.T) {
+	name := randString(t, 16)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithEcho,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEphemeralResourceProjectProtectionBypass(name, teamIDConfig()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("echo.test", "data.project_id", "vercel_project.test", "id"),
+					resource.TestCheckResourceAttrPair("echo.test", "data.secret", "vercel_project.test", "protection_bypass_for_automation_secret"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_ProjectProtectionBypassEphemeralResourceNotEnabled(t *testing.T) {
+	name := randString(t, 16)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithEcho,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccEphemeralResourceProjectProtectionBypassNotEnabled(name, teamIDConfig()),
+				ExpectError: regexp.MustCompile("Protection Bypass for Automation is not enabled"),
+			},
+		},
+	})
+}
+
+func testAccEphemeralResourceProjectProtectionBypass(name, team string) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "test" {
+    name                             = "test-acc-protection-bypass-%[1]s"
+    protection_bypass_for_automation = true
+    %[2]s
+}
+
+ephemeral "vercel_project_protection_bypass" "test" {
+    project_id = vercel_project.test.id
+    %[2]s
+}
+
+provider "echo" {
+    data = ephemeral.vercel_project_protection_bypass.test
+}
+
+resource "echo" "test" {}
+`, name, team)
+}
+
+func testAccEphemeralResourceProjectProtectionBypassNotEnabled(name, team string) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "test" {
+    name = "test-acc-protection-bypass-%[1]s"
+    %[2]s
+}
+
+ephemeral "vercel_project_protection_bypass" "test" {
+    project_id = vercel_project.test.id
+    %[2]s
+}
+
+provider "echo" {
+    data = ephemeral.vercel_project_protection_bypass.test
+}
+
+resource "echo" "test" {}
+`, name, team)
+}
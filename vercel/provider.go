@@ -3,10 +3,12 @@ package vercel
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"regexp"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -14,13 +16,25 @@ import (
 	"github.com/vercel/terraform-provider-vercel/client"
 )
 
-type vercelProvider struct{}
+type vercelProvider struct {
+	transport http.RoundTripper
+}
+
+var _ provider.ProviderWithEphemeralResources = &vercelProvider{}
 
 // New instantiates a new instance of a vercel terraform provider.
 func New() provider.Provider {
 	return &vercelProvider{}
 }
 
+// NewWithTransport instantiates a new instance of a vercel terraform provider that makes all API requests
+// through the given transport. This allows the acceptance tests to record and replay API interactions.
+func NewWithTransport(transport http.RoundTripper) provider.Provider {
+	return &vercelProvider{
+		transport: transport,
+	}
+}
+
 func (p *vercelProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
 	resp.TypeName = "vercel"
 }
@@ -40,10 +54,18 @@ Use the navigation to the left to read about the available resources.
 				Description: "The Vercel API Token to use. This can also be specified with the `VERCEL_API_TOKEN` shell environment variable. Tokens can be created from your [Vercel settings](https://vercel.com/account/tokens).",
 				Sensitive:   true,
 			},
+			"serialize_project_writes": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Make changes to any single project one at a time, avoiding conflicts when many resources belonging to the same project are changed in parallel. Reads, and changes to different projects, still run in parallel. Projects are told apart by the project ID or name exactly as it is configured, so changes to a project referenced by its name in some resources and by its ID in others are not serialized with each other. Defaults to `false`.",
+			},
 			"team": schema.StringAttribute{
 				Optional:    true,
 				Description: "The default Vercel Team to use when creating resources or reading data sources. This can be provided as either a team slug, or team ID. The slug and ID are both available from the Team Settings page in the Vercel dashboard.",
 			},
+			"cache_reads": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Cache reads of projects, project environment variables and teams for the duration of a single Terraform run. Identical concurrent reads are combined into a single request, and cached reads are discarded whenever the provider makes a change to the same project or team. This reduces the number of API requests made by large configurations, and the likelihood of hitting rate limits. Defaults to `false`.",
+			},
 		},
 	}
 }
@@ -52,19 +74,28 @@ func (p *vercelProvider) Resources(_ context.Context) []func() resource.Resource
 	return []func() resource.Resource{
 		newAliasResource,
 		newAttackChallengeModeResource,
+		newCustomCertificateResource,
 		newDNSRecordResource,
+		newDNSZoneResource,
 		newDeploymentResource,
+		newDomainResource,
 		newEdgeConfigResource,
 		newEdgeConfigSchemaResource,
 		newEdgeConfigTokenResource,
 		newFirewallConfigResource,
+		newFirewallIPRuleResource,
+		newFirewallBypassResource,
+		newFirewallRuleResource,
 		newLogDrainResource,
 		newProjectDeploymentRetentionResource,
 		newProjectDomainResource,
 		newProjectEnvironmentVariableResource,
 		newProjectFunctionCPUResource,
 		newProjectResource,
+		newSharedEnvironmentVariableProjectLinkResource,
 		newSharedEnvironmentVariableResource,
+		newTeamConfigResource,
+		newTeamMemberResource,
 		newWebhookResource,
 	}
 }
@@ -73,12 +104,15 @@ func (p *vercelProvider) DataSources(_ context.Context) []func() datasource.Data
 	return []func() datasource.DataSource{
 		newAliasDataSource,
 		newAttackChallengeModeDataSource,
+		newDNSZoneFileDataSource,
 		newDeploymentDataSource,
+		newDomainConfigDataSource,
 		newEdgeConfigDataSource,
 		newEdgeConfigSchemaDataSource,
 		newEdgeConfigTokenDataSource,
 		newEndpointVerificationDataSource,
 		newFileDataSource,
+		newFirewallRulesJSONDataSource,
 		newLogDrainDataSource,
 		newPrebuiltProjectDataSource,
 		newProjectDataSource,
@@ -86,12 +120,22 @@ func (p *vercelProvider) DataSources(_ context.Context) []func() datasource.Data
 		newProjectDirectoryDataSource,
 		newProjectFunctionCPUDataSource,
 		newSharedEnvironmentVariableDataSource,
+		newTeamMembersDataSource,
+	}
+}
+
+func (p *vercelProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		newEdgeConfigTokenEphemeralResource,
+		newProjectProtectionBypassEphemeralResource,
 	}
 }
 
 type providerData struct {
-	APIToken types.String `tfsdk:"api_token"`
-	Team     types.String `tfsdk:"team"`
+	APIToken               types.String `tfsdk:"api_token"`
+	Team                   types.String `tfsdk:"team"`
+	CacheReads             types.Bool   `tfsdk:"cache_reads"`
+	SerializeProjectWrites types.Bool   `tfsdk:"serialize_project_writes"`
 }
 
 // apiTokenRe is a regex for an API access token. We use this to validate that the
@@ -141,6 +185,15 @@ func (p *vercelProvider) Configure(ctx context.Context, req provider.ConfigureRe
 	}
 
 	vercelClient := client.New(apiToken)
+	if p.transport != nil {
+		vercelClient = vercelClient.WithTransport(p.transport)
+	}
+	if config.CacheReads.ValueBool() {
+		vercelClient = vercelClient.WithReadCache()
+	}
+	if config.SerializeProjectWrites.ValueBool() {
+		vercelClient = vercelClient.WithSerializedProjectWrites()
+	}
 	if config.Team.ValueString() != "" {
 		res, err := vercelClient.GetTeam(ctx, config.Team.ValueString())
 		if client.NotFound(err) {
@@ -162,4 +215,5 @@ func (p *vercelProvider) Configure(ctx context.Context, req provider.ConfigureRe
 
 	resp.DataSourceData = vercelClient
 	resp.ResourceData = vercelClient
+	resp.EphemeralResourceData = vercelClient
 }
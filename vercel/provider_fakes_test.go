@@ -0,0 +1,163 @@
+package vercel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/vercel/terraform-provider-vercel/client"
+	"github.com/vercel/terraform-provider-vercel/client/clienttest"
+)
+
+// fakeClient combines the in-memory implementations of each per-domain interface, in the same way that
+// client.Client implements all of them, so that it can be used as the provider's client.
+type fakeClient struct {
+	*clienttest.Projects
+	*clienttest.Teams
+	*clienttest.Deployments
+	*clienttest.DNS
+	*clienttest.Certificates
+	*clienttest.Domains
+	*clienttest.Firewall
+	*clienttest.EdgeConfigs
+	*clienttest.Aliases
+	*clienttest.SharedEnvironmentVariables
+	*clienttest.LogDrains
+	*clienttest.Webhooks
+}
+
+func newFakeClient() fakeClient {
+	return fakeClient{
+		Projects:                   clienttest.NewProjects(),
+		Teams:                      clienttest.NewTeams(client.Team{ID: "team_a"}),
+		Deployments:                clienttest.NewDeployments(),
+		DNS:                        clienttest.NewDNS(),
+		Certificates:               clienttest.NewCertificates(),
+		Domains:                    clienttest.NewDomains(),
+		Firewall:                   clienttest.NewFirewall(),
+		EdgeConfigs:                clienttest.NewEdgeConfigs(),
+		Aliases:                    clienttest.NewAliases(),
+		SharedEnvironmentVariables: clienttest.NewSharedEnvironmentVariables(),
+		LogDrains:                  clienttest.NewLogDrains(),
+		Webhooks:                   clienttest.NewWebhooks(),
+	}
+}
+
+// TestProviderConfiguresWithFakes checks that every resource, data source and ephemeral resource only
+// depends on the per-domain interfaces, rather than on client.Client itself.
+func TestProviderConfiguresWithFakes(t *testing.T) {
+	ctx := context.Background()
+	p := New().(provider.ProviderWithEphemeralResources)
+	fake := newFakeClient()
+
+	for _, newResource := range p.Resources(ctx) {
+		r := newResource()
+		var metadata resource.MetadataResponse
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "vercel"}, &metadata)
+		if c, ok := r.(resource.ResourceWithConfigure); ok {
+			var resp resource.ConfigureResponse
+			c.Configure(ctx, resource.ConfigureRequest{ProviderData: fake}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Errorf("unable to configure resource %s: %v", metadata.TypeName, resp.Diagnostics)
+			}
+		}
+	}
+	for _, newDataSource := range p.DataSources(ctx) {
+		d := newDataSource()
+		var metadata datasource.MetadataResponse
+		d.Metadata(ctx, datasource.MetadataRequest{ProviderTypeName: "vercel"}, &metadata)
+		if c, ok := d.(datasource.DataSourceWithConfigure); ok {
+			var resp datasource.ConfigureResponse
+			c.Configure(ctx, datasource.ConfigureRequest{ProviderData: fake}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Errorf("unable to configure data source %s: %v", metadata.TypeName, resp.Diagnostics)
+			}
+		}
+	}
+	for _, newEphemeralResource := range p.EphemeralResources(ctx) {
+		e := newEphemeralResource()
+		var metadata ephemeral.MetadataResponse
+		e.Metadata(ctx, ephemeral.MetadataRequest{ProviderTypeName: "vercel"}, &metadata)
+		if c, ok := e.(ephemeral.EphemeralResourceWithConfigure); ok {
+			var resp ephemeral.ConfigureResponse
+			c.Configure(ctx, ephemeral.ConfigureRequest{ProviderData: fake}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Errorf("unable to configure ephemeral resource %s: %v", metadata.TypeName, resp.Diagnostics)
+			}
+		}
+	}
+}
+
+func TestWebhookResourceWithFakes(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeClient()
+	r := newWebhookResource().(*webhookResource)
+	var configureResp resource.ConfigureResponse
+	r.Configure(ctx, resource.ConfigureRequest{ProviderData: fake}, &configureResp)
+	if configureResp.Diagnostics.HasError() {
+		t.Fatalf("unable to configure webhook resource: %v", configureResp.Diagnostics)
+	}
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	s := schemaResp.Schema
+
+	events, _ := types.SetValueFrom(ctx, types.StringType, []string{"deployment.created"})
+	plan := tfsdk.Plan{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+	diags := plan.Set(ctx, Webhook{
+		ID:             types.StringUnknown(),
+		TeamID:         types.StringValue("team_a"),
+		Endpoint:       types.StringValue("https://example.com/webhook"),
+		Secret:         types.StringUnknown(),
+		ProjectIDs:     types.SetNull(types.StringType),
+		Events:         events,
+		ReplaceTrigger: types.StringNull(),
+	})
+	if diags.HasError() {
+		t.Fatalf("unable to set plan: %v", diags)
+	}
+
+	createResp := resource.CreateResponse{State: tfsdk.State{Schema: s, Raw: plan.Raw}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, &createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("unable to create webhook: %v", createResp.Diagnostics)
+	}
+	var created Webhook
+	createResp.State.Get(ctx, &created)
+	if created.ID.ValueString() == "" || created.Secret.ValueString() == "" {
+		t.Fatalf("expected the webhook to be created with an ID and a secret, got %+v", created)
+	}
+
+	// The secret is only returned on create, so it must be kept from state when the webhook is read.
+	readResp := resource.ReadResponse{State: createResp.State}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, &readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unable to read webhook: %v", readResp.Diagnostics)
+	}
+	var read Webhook
+	readResp.State.Get(ctx, &read)
+	if !read.Secret.Equal(created.Secret) || !read.Events.Equal(created.Events) {
+		t.Errorf("expected the webhook to be read back unchanged, got %+v, want %+v", read, created)
+	}
+
+	deleteResp := resource.DeleteResponse{State: readResp.State}
+	r.Delete(ctx, resource.DeleteRequest{State: readResp.State}, &deleteResp)
+	if deleteResp.Diagnostics.HasError() {
+		t.Fatalf("unable to delete webhook: %v", deleteResp.Diagnostics)
+	}
+	if _, err := fake.GetWebhook(ctx, created.ID.ValueString(), "team_a"); !client.NotFound(err) {
+		t.Errorf("expected the webhook to be deleted, got %v", err)
+	}
+
+	// A webhook deleted outside of terraform is removed from state.
+	goneResp := resource.ReadResponse{State: readResp.State}
+	r.Read(ctx, resource.ReadRequest{State: readResp.State}, &goneResp)
+	if goneResp.Diagnostics.HasError() || !goneResp.State.Raw.IsNull() {
+		t.Errorf("expected a deleted webhook to be removed from state, got %v", goneResp.Diagnostics)
+	}
+}
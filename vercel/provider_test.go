@@ -1,18 +1,164 @@
 package vercel_test
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"net/http"
 	"os"
+	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/vercel/terraform-provider-vercel/client"
 	"github.com/vercel/terraform-provider-vercel/vercel"
 )
 
 var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
-	"vercel": providerserver.NewProtocol6WithError(vercel.New()),
+	"vercel": providerserver.NewProtocol6WithError(vercel.NewWithTransport(testTransport())),
+}
+
+// testRecorder records or replays the API interactions made by acceptance tests, depending on the
+// VERCEL_TERRAFORM_TESTING_VCR environment variable, which can be set to `record` or `replay`.
+// Each test uses its own cassette within testdata/cassettes.
+var testRecorder = newTestRecorder()
+
+func newTestRecorder() *client.Recorder {
+	mode := os.Getenv("VERCEL_TERRAFORM_TESTING_VCR")
+	if mode == "" {
+		return nil
+	}
+	r, err := client.NewRecorder(client.RecorderMode(mode), nil)
+	if err != nil {
+		panic(fmt.Sprintf("VERCEL_TERRAFORM_TESTING_VCR: %s", err))
+	}
+	return r
+}
+
+func testTransport() http.RoundTripper {
+	if testRecorder == nil {
+		return nil
+	}
+	return testRecorder
+}
+
+func replaying() bool {
+	return testRecorder != nil && testRecorder.Mode() == client.RecorderModeReplay
+}
+
+// skipIfReplaying skips a test that cannot be replayed, such as one whose requests depend on the current
+// time, as requests must match those in the cassette exactly.
+func skipIfReplaying(t *testing.T, reason string) {
+	if replaying() {
+		t.Skipf("cannot be replayed, as %s", reason)
+	}
+}
+
+// useCassette switches the recorder to the cassette for the given test, if it is not already in use.
+// The cassette is saved once the test has finished, unless the test failed.
+func useCassette(t *testing.T) {
+	if testRecorder == nil {
+		return
+	}
+	path := filepath.Join("testdata", "cassettes", strings.ReplaceAll(t.Name(), "/", "_")+".json")
+	if testRecorder.Cassette() == path {
+		return
+	}
+	err := testRecorder.Start(path)
+	if errors.Is(err, os.ErrNotExist) {
+		t.Skipf("no cassette has been recorded for %s", t.Name())
+	}
+	if err != nil {
+		t.Fatalf("unable to start cassette: %s", err)
+	}
+	sanitizeTestEnv(t)
+	t.Cleanup(func() {
+		if t.Failed() {
+			return
+		}
+		if err := testRecorder.Stop(); err != nil {
+			t.Errorf("unable to save cassette: %s", err)
+		}
+	})
+}
+
+// testEnvPlaceholders are used in place of the testing environment variables within cassettes, so that
+// cassettes do not contain details of the account they were recorded against. When replaying, the
+// placeholders are used as the values of the environment variables, so they do not need to be set.
+var testEnvPlaceholders = []struct {
+	name        string
+	placeholder string
+}{
+	{name: "VERCEL_TERRAFORM_TESTING_TEAM", placeholder: "team_000000000000000000000000"},
+	{name: "VERCEL_TERRAFORM_TESTING_DOMAIN", placeholder: "vercel-terraform-testing.dev"},
+	{name: "VERCEL_TERRAFORM_TESTING_GITHUB_REPO", placeholder: "vercel-terraform-testing/github"},
+	{name: "VERCEL_TERRAFORM_TESTING_GITLAB_REPO", placeholder: "vercel-terraform-testing/gitlab"},
+	{name: "VERCEL_TERRAFORM_TESTING_BITBUCKET_REPO", placeholder: "vercel-terraform-testing/bitbucket"},
+}
+
+// testEnv returns the value of a testing environment variable, or its placeholder when replaying.
+func testEnv(name string) string {
+	if replaying() {
+		for _, env := range testEnvPlaceholders {
+			if env.name == name {
+				return env.placeholder
+			}
+		}
+	}
+	return os.Getenv(name)
+}
+
+// sanitizeTestEnv replaces the values of the testing environment variables with their placeholders in the
+// cassette being recorded. A value can only be replaced by a single placeholder, so the values must differ.
+func sanitizeTestEnv(t *testing.T) {
+	if replaying() {
+		return
+	}
+	seen := map[string]string{}
+	for _, env := range testEnvPlaceholders {
+		value := os.Getenv(env.name)
+		if value == "" {
+			continue
+		}
+		if other, ok := seen[value]; ok {
+			t.Fatalf("%s and %s must be set to different values when recording, so that they can be replayed", other, env.name)
+		}
+		seen[value] = env.name
+		testRecorder.Sanitize(value, env.placeholder)
+	}
+}
+
+var (
+	randCountsMu sync.Mutex
+	randCounts   = map[string]int{}
+)
+
+// randString generates a random string to make test resource names unique. When recording or replaying
+// API interactions, the value must be the same between runs, so a placeholder derived from the test name
+// is used in its place within cassettes.
+func randString(t *testing.T, length int) string {
+	if testRecorder == nil {
+		return acctest.RandString(length)
+	}
+	useCassette(t)
+
+	randCountsMu.Lock()
+	randCounts[t.Name()]++
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s-%d", t.Name(), randCounts[t.Name()])))
+	randCountsMu.Unlock()
+	placeholder := hex.EncodeToString(sum[:])[:length]
+
+	if replaying() {
+		return placeholder
+	}
+	value := acctest.RandString(length)
+	testRecorder.Sanitize(value, placeholder)
+	return value
 }
 
 func mustHaveEnv(t *testing.T, name string) {
@@ -22,6 +168,13 @@ func mustHaveEnv(t *testing.T, name string) {
 }
 
 func testAccPreCheck(t *testing.T) {
+	useCassette(t)
+	if replaying() {
+		// No requests are made when replaying, so the testing environment variables are not needed, but the
+		// provider still requires a valid looking token.
+		t.Setenv("VERCEL_API_TOKEN", strings.Repeat("0", 24))
+		return
+	}
 	mustHaveEnv(t, "VERCEL_API_TOKEN")
 	mustHaveEnv(t, "VERCEL_TERRAFORM_TESTING_GITHUB_REPO")
 	mustHaveEnv(t, "VERCEL_TERRAFORM_TESTING_GITLAB_REPO")
@@ -34,6 +187,9 @@ var tc *client.Client
 func testClient() *client.Client {
 	if tc == nil {
 		tc = client.New(apiToken())
+		if testRecorder != nil {
+			tc = tc.WithTransport(testRecorder)
+		}
 	}
 
 	return tc
@@ -44,15 +200,15 @@ func apiToken() string {
 }
 
 func testGithubRepo() string {
-	return os.Getenv("VERCEL_TERRAFORM_TESTING_GITHUB_REPO")
+	return testEnv("VERCEL_TERRAFORM_TESTING_GITHUB_REPO")
 }
 
 func testBitbucketRepo() string {
-	return os.Getenv("VERCEL_TERRAFORM_TESTING_BITBUCKET_REPO")
+	return testEnv("VERCEL_TERRAFORM_TESTING_BITBUCKET_REPO")
 }
 
 func testTeam() string {
-	return os.Getenv("VERCEL_TERRAFORM_TESTING_TEAM")
+	return testEnv("VERCEL_TERRAFORM_TESTING_TEAM")
 }
 
 func teamIDConfig() string {
@@ -63,5 +219,5 @@ func teamIDConfig() string {
 }
 
 func testDomain() string {
-	return os.Getenv("VERCEL_TERRAFORM_TESTING_DOMAIN")
+	return testEnv("VERCEL_TERRAFORM_TESTING_DOMAIN")
 }
@@ -24,7 +24,7 @@ func newAliasResource() resource.Resource {
 }
 
 type aliasResource struct {
-	client *client.Client
+	client client.AliasesAPI
 }
 
 func (r *aliasResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -37,11 +37,11 @@ func (r *aliasResource) Configure(ctx context.Context, req resource.ConfigureReq
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(client.AliasesAPI)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected client.AliasesAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -3,11 +3,15 @@ package vercel
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/vercel/terraform-provider-vercel/client"
@@ -15,9 +19,11 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &attackChallengeModeResource{}
-	_ resource.ResourceWithConfigure   = &attackChallengeModeResource{}
-	_ resource.ResourceWithImportState = &attackChallengeModeResource{}
+	_ resource.Resource                   = &attackChallengeModeResource{}
+	_ resource.ResourceWithConfigure      = &attackChallengeModeResource{}
+	_ resource.ResourceWithImportState    = &attackChallengeModeResource{}
+	_ resource.ResourceWithValidateConfig = &attackChallengeModeResource{}
+	_ resource.ResourceWithModifyPlan     = &attackChallengeModeResource{}
 )
 
 func newAttackChallengeModeResource() resource.Resource {
@@ -25,7 +31,7 @@ func newAttackChallengeModeResource() resource.Resource {
 }
 
 type attackChallengeModeResource struct {
-	client *client.Client
+	client client.ProjectsAPI
 }
 
 func (r *attackChallengeModeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -38,11 +44,11 @@ func (r *attackChallengeModeResource) Configure(ctx context.Context, req resourc
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(client.ProjectsAPI)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected client.ProjectsAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -55,7 +61,13 @@ func (r *attackChallengeModeResource) Schema(_ context.Context, req resource.Sch
 		Description: `
 Provides an Attack Challenge Mode resource.
 
-Attack Challenge Mode prevent malicious traffic by showing a verification challenge for every visitor.`,
+Attack Challenge Mode prevent malicious traffic by showing a verification challenge for every visitor.
+
+Attack Challenge Mode can be enabled for a fixed window, such as during an incident, by setting either ` + "`expires_at`" + ` or
+` + "`duration`" + `. The window is sent to Vercel, which disables Attack Challenge Mode itself once it has passed. The next
+refresh then reports the change as drift, without making any changes itself. A window changed outside of terraform is
+detected in the same way. With ` + "`duration`" + `, applying the configuration again enables Attack Challenge Mode for a new window.
+With an ` + "`expires_at`" + ` that has passed, applying the configuration keeps Attack Challenge Mode disabled, and warns when planning.`,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description:   "The resource identifier.",
@@ -77,6 +89,18 @@ Attack Challenge Mode prevent malicious traffic by showing a verification challe
 				Required:    true,
 				Description: "Whether Attack Challenge Mode is enabled or not.",
 			},
+			"expires_at": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The time, in RFC 3339 format, that Attack Challenge Mode should be disabled at. If `duration` is set, this is the end of the current window.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("duration")),
+				},
+			},
+			"duration": schema.StringAttribute{
+				Optional:    true,
+				Description: "How long Attack Challenge Mode should be enabled for once applied, e.g. `30m` or `4h`.",
+			},
 		},
 	}
 }
@@ -86,15 +110,178 @@ type AttackChallengeMode struct {
 	ProjectID types.String `tfsdk:"project_id"`
 	TeamID    types.String `tfsdk:"team_id"`
 	Enabled   types.Bool   `tfsdk:"enabled"`
+	ExpiresAt types.String `tfsdk:"expires_at"`
+	Duration  types.String `tfsdk:"duration"`
 }
 
-func responseToAttackChallengeMode(out client.AttackChallengeMode) AttackChallengeMode {
+func responseToAttackChallengeMode(out client.AttackChallengeMode, ref AttackChallengeMode) AttackChallengeMode {
+	// The window is taken from the API, so that a window changed outside of terraform is detected. The value
+	// in ref is kept if it is the same time, as it may have been written with a different offset.
+	expiresAt := types.StringNull()
+	if out.Enabled && out.ActiveUntil != nil {
+		expiresAt = types.StringValue(formatMillis(*out.ActiveUntil))
+		if end, err := time.Parse(time.RFC3339, ref.ExpiresAt.ValueString()); err == nil && end.UnixMilli() == *out.ActiveUntil {
+			expiresAt = ref.ExpiresAt
+		}
+	}
 	return AttackChallengeMode{
 		ID:        types.StringValue(out.ProjectID),
 		ProjectID: types.StringValue(out.ProjectID),
 		TeamID:    toTeamID(out.TeamID),
 		Enabled:   types.BoolValue(out.Enabled),
+		ExpiresAt: expiresAt,
+		Duration:  ref.Duration,
+	}
+}
+
+func formatMillis(ms int64) string {
+	return time.UnixMilli(ms).UTC().Format(time.RFC3339)
+}
+
+// attackChallengeModeWindow works out when a planned Attack Challenge Mode window ends. A window based on a
+// duration starts when it is applied, unless it has already been recorded by an earlier apply.
+func attackChallengeModeWindow(plan AttackChallengeMode, now time.Time) (expiresAt types.String, activeUntil *int64, err error) {
+	if !plan.Enabled.ValueBool() {
+		return types.StringNull(), nil, nil
+	}
+	var end time.Time
+	switch {
+	case !plan.ExpiresAt.IsNull() && !plan.ExpiresAt.IsUnknown():
+		end, err = time.Parse(time.RFC3339, plan.ExpiresAt.ValueString())
+		if err != nil {
+			return expiresAt, nil, err
+		}
+	case !plan.Duration.IsNull():
+		d, err := time.ParseDuration(plan.Duration.ValueString())
+		if err != nil {
+			return expiresAt, nil, err
+		}
+		end = now.Add(d)
+	default:
+		return types.StringNull(), nil, nil
+	}
+	ms := end.UnixMilli()
+	return types.StringValue(end.UTC().Format(time.RFC3339)), &ms, nil
+}
+
+// attackChallengeModeRequest builds the request to apply a planned Attack Challenge Mode. A window that has
+// already passed keeps Attack Challenge Mode disabled, rather than enabling it only for Vercel to disable it.
+func attackChallengeModeRequest(plan AttackChallengeMode, now time.Time) (request client.AttackChallengeMode, expiresAt types.String, expired bool, err error) {
+	expiresAt, activeUntil, err := attackChallengeModeWindow(plan, now)
+	if err != nil {
+		return request, expiresAt, false, err
+	}
+	expired = activeUntil != nil && *activeUntil <= now.UnixMilli()
+	request = client.AttackChallengeMode{
+		TeamID:      plan.TeamID.ValueString(),
+		ProjectID:   plan.ProjectID.ValueString(),
+		Enabled:     plan.Enabled.ValueBool() && !expired,
+		ActiveUntil: activeUntil,
+	}
+	if expired {
+		request.ActiveUntil = nil
+	}
+	return request, expiresAt, expired, nil
+}
+
+// attackChallengeModeExpired reports whether the recorded window of Attack Challenge Mode has passed.
+func attackChallengeModeExpired(state AttackChallengeMode, now time.Time) bool {
+	if state.ExpiresAt.IsNull() || state.ExpiresAt.IsUnknown() {
+		return false
+	}
+	end, err := time.Parse(time.RFC3339, state.ExpiresAt.ValueString())
+	return err == nil && !now.Before(end)
+}
+
+// ValidateConfig validates the window of Attack Challenge Mode, if one is set.
+func (r *attackChallengeModeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config AttackChallengeMode
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.ExpiresAt.IsNull() && !config.ExpiresAt.IsUnknown() {
+		if _, err := time.Parse(time.RFC3339, config.ExpiresAt.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("expires_at"),
+				"Invalid Attack Challenge Mode window",
+				fmt.Sprintf("%q is not a valid RFC 3339 time, such as 2025-01-02T15:04:05Z", config.ExpiresAt.ValueString()),
+			)
+		}
+	}
+	if !config.Duration.IsNull() && !config.Duration.IsUnknown() {
+		d, err := time.ParseDuration(config.Duration.ValueString())
+		if err != nil || d <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("duration"),
+				"Invalid Attack Challenge Mode window",
+				fmt.Sprintf("%q is not a valid duration, durations must be positive, such as 30m or 4h", config.Duration.ValueString()),
+			)
+		}
+	}
+	windowed := !config.ExpiresAt.IsNull() || !config.Duration.IsNull()
+	if windowed && !config.Enabled.IsUnknown() && !config.Enabled.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("enabled"),
+			"Invalid Attack Challenge Mode window",
+			"`expires_at` and `duration` can only be set when Attack Challenge Mode is enabled",
+		)
+	}
+}
+
+// ModifyPlan records the end of the window of Attack Challenge Mode. A window based on a duration keeps the end
+// recorded in state, unless Attack Challenge Mode is being enabled again, in which case a new window starts.
+func (r *attackChallengeModeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
 	}
+	var plan, config AttackChallengeMode
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var state *AttackChallengeMode
+	if !req.State.Raw.IsNull() {
+		state = &AttackChallengeMode{}
+		diags = req.State.Get(ctx, state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	enabling := state == nil || !state.Enabled.Equal(plan.Enabled)
+	switch {
+	case !config.ExpiresAt.IsNull():
+		if config.ExpiresAt.IsUnknown() || !plan.Enabled.ValueBool() {
+			return
+		}
+		end, err := time.Parse(time.RFC3339, config.ExpiresAt.ValueString())
+		if err != nil {
+			return
+		}
+		if (enabling || !state.ExpiresAt.Equal(config.ExpiresAt)) && !time.Now().Before(end) {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("expires_at"),
+				"Attack Challenge Mode window has passed",
+				fmt.Sprintf("The window ended at %s, so applying will keep Attack Challenge Mode disabled rather than enabling it. Set a later `expires_at`, use `duration`, or set `enabled` to false to stop this change being planned.", config.ExpiresAt.ValueString()),
+			)
+		}
+		return
+	case config.Duration.IsNull():
+		plan.ExpiresAt = types.StringNull()
+	case !enabling && state.Duration.Equal(plan.Duration) && !state.ExpiresAt.IsNull():
+		plan.ExpiresAt = state.ExpiresAt
+	default:
+		plan.ExpiresAt = types.StringUnknown()
+	}
+	diags = resp.Plan.SetAttribute(ctx, path.Root("expires_at"), plan.ExpiresAt)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *attackChallengeModeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -113,11 +300,15 @@ func (r *attackChallengeModeResource) Create(ctx context.Context, req resource.C
 		)
 		return
 	}
-	out, err := r.client.UpdateAttackChallengeMode(ctx, client.AttackChallengeMode{
-		TeamID:    plan.TeamID.ValueString(),
-		ProjectID: plan.ProjectID.ValueString(),
-		Enabled:   plan.Enabled.ValueBool(),
-	})
+	request, expiresAt, expired, err := attackChallengeModeRequest(plan, time.Now())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Attack Challenge Mode",
+			"Could not create Attack Challenge Mode, invalid window: "+err.Error(),
+		)
+		return
+	}
+	out, err := r.client.UpdateAttackChallengeMode(ctx, request)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating Attack Challenge Mode",
@@ -126,7 +317,13 @@ func (r *attackChallengeModeResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
-	result := responseToAttackChallengeMode(out)
+	plan.ExpiresAt = expiresAt
+	result := responseToAttackChallengeMode(out, plan)
+	if expired {
+		// The planned window is recorded, so that it is reported as drift on the next refresh.
+		result.Enabled = plan.Enabled
+		result.ExpiresAt = plan.ExpiresAt
+	}
 	tflog.Info(ctx, "created attack challenge mode", map[string]interface{}{
 		"team_id":    plan.TeamID.ValueString(),
 		"project_id": result.ProjectID.ValueString(),
@@ -164,7 +361,18 @@ func (r *attackChallengeModeResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
-	result := responseToAttackChallengeMode(out)
+	// Vercel disables Attack Challenge Mode itself once its window has passed, so this is only reported.
+	if attackChallengeModeExpired(state, time.Now()) && state.Enabled.ValueBool() {
+		resp.Diagnostics.AddWarning(
+			"Attack Challenge Mode window has passed",
+			fmt.Sprintf("The window for Attack Challenge Mode on project %s ended at %s, so Vercel disables it.",
+				state.ProjectID.ValueString(),
+				state.ExpiresAt.ValueString(),
+			),
+		)
+	}
+
+	result := responseToAttackChallengeMode(out, state)
 	tflog.Info(ctx, "read attack challenge mode", map[string]interface{}{
 		"team_id":    result.TeamID.ValueString(),
 		"project_id": result.ProjectID.ValueString(),
@@ -177,7 +385,7 @@ func (r *attackChallengeModeResource) Read(ctx context.Context, req resource.Rea
 	}
 }
 
-// Update does nothing.
+// Update enables or disables Attack Challenge Mode, sending the planned window to Vercel.
 func (r *attackChallengeModeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var plan AttackChallengeMode
 	diags := req.Plan.Get(ctx, &plan)
@@ -186,11 +394,15 @@ func (r *attackChallengeModeResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
-	out, err := r.client.UpdateAttackChallengeMode(ctx, client.AttackChallengeMode{
-		TeamID:    plan.TeamID.ValueString(),
-		ProjectID: plan.ProjectID.ValueString(),
-		Enabled:   plan.Enabled.ValueBool(),
-	})
+	request, expiresAt, expired, err := attackChallengeModeRequest(plan, time.Now())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating Attack Challenge Mode",
+			"Could not update Attack Challenge Mode, invalid window: "+err.Error(),
+		)
+		return
+	}
+	out, err := r.client.UpdateAttackChallengeMode(ctx, request)
 	if client.NotFound(err) {
 		resp.State.RemoveResource(ctx)
 		return
@@ -207,7 +419,13 @@ func (r *attackChallengeModeResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
-	result := responseToAttackChallengeMode(out)
+	plan.ExpiresAt = expiresAt
+	result := responseToAttackChallengeMode(out, plan)
+	if expired {
+		// The planned window is recorded, so that it is reported as drift on the next refresh.
+		result.Enabled = plan.Enabled
+		result.ExpiresAt = plan.ExpiresAt
+	}
 	tflog.Trace(ctx, "update attack challenge mode", map[string]interface{}{
 		"team_id":    result.TeamID.ValueString(),
 		"project_id": result.ProjectID.ValueString(),
@@ -282,7 +500,10 @@ func (r *attackChallengeModeResource) ImportState(ctx context.Context, req resou
 		return
 	}
 
-	result := responseToAttackChallengeMode(out)
+	result := responseToAttackChallengeMode(out, AttackChallengeMode{
+		ExpiresAt: types.StringNull(),
+		Duration:  types.StringNull(),
+	})
 	tflog.Info(ctx, "import attack challenge mode", map[string]interface{}{
 		"team_id":    result.TeamID.ValueString(),
 		"project_id": result.ProjectID.ValueString(),
@@ -0,0 +1,79 @@
+package vercel
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAttackChallengeModeRequest(t *testing.T) {
+	now := time.Date(2025, 1, 2, 15, 0, 0, 0, time.UTC)
+	millis := func(d time.Duration) *int64 {
+		ms := now.Add(d).UnixMilli()
+		return &ms
+	}
+	plan := func(enabled bool, expiresAt, duration types.String) AttackChallengeMode {
+		return AttackChallengeMode{
+			ProjectID: types.StringValue("prj_a"),
+			TeamID:    types.StringValue("team_a"),
+			Enabled:   types.BoolValue(enabled),
+			ExpiresAt: expiresAt,
+			Duration:  duration,
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		plan        AttackChallengeMode
+		enabled     bool
+		activeUntil *int64
+		expiresAt   types.String
+		expired     bool
+	}{
+		"no window": {
+			plan:      plan(true, types.StringNull(), types.StringNull()),
+			enabled:   true,
+			expiresAt: types.StringNull(),
+		},
+		"disabled": {
+			plan:      plan(false, types.StringNull(), types.StringNull()),
+			expiresAt: types.StringNull(),
+		},
+		"future window": {
+			plan:        plan(true, types.StringValue("2025-01-02T16:00:00Z"), types.StringNull()),
+			enabled:     true,
+			activeUntil: millis(time.Hour),
+			expiresAt:   types.StringValue("2025-01-02T16:00:00Z"),
+		},
+		"duration": {
+			plan:        plan(true, types.StringUnknown(), types.StringValue("30m")),
+			enabled:     true,
+			activeUntil: millis(30 * time.Minute),
+			expiresAt:   types.StringValue("2025-01-02T15:30:00Z"),
+		},
+		"passed window": {
+			plan:      plan(true, types.StringValue("2025-01-02T14:00:00Z"), types.StringNull()),
+			expiresAt: types.StringValue("2025-01-02T14:00:00Z"),
+			expired:   true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			request, expiresAt, expired, err := attackChallengeModeRequest(tc.plan, now)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if request.Enabled != tc.enabled {
+				t.Errorf("expected enabled to be %t, got %t", tc.enabled, request.Enabled)
+			}
+			if (request.ActiveUntil == nil) != (tc.activeUntil == nil) || (request.ActiveUntil != nil && *request.ActiveUntil != *tc.activeUntil) {
+				t.Errorf("expected activeUntil %v, got %v", tc.activeUntil, request.ActiveUntil)
+			}
+			if !expiresAt.Equal(tc.expiresAt) {
+				t.Errorf("expected expires_at %s, got %s", tc.expiresAt, expiresAt)
+			}
+			if expired != tc.expired {
+				t.Errorf("expected expired to be %t, got %t", tc.expired, expired)
+			}
+		})
+	}
+}
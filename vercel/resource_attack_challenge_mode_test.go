@@ -1,16 +1,17 @@
 package vercel_test
 
 import (
+	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 func TestAcc_AttackChallengeModeResource(t *testing.T) {
-	name := acctest.RandString(16)
+	name := randString(t, 16)
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -50,10 +51,60 @@ func TestAcc_AttackChallengeModeResource(t *testing.T) {
 					resource.TestCheckResourceAttr("vercel_attack_challenge_mode.enabled", "enabled", "false"),
 				),
 			},
+			{
+				// A window that has passed keeps Attack Challenge Mode disabled, and is planned again on every refresh.
+				Config: testAccAttackChallengeModeConfigResourceWindow(name, teamIDConfig(), `expires_at = "2020-01-01T00:00:00Z"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vercel_attack_challenge_mode.enabled", "expires_at", "2020-01-01T00:00:00Z"),
+					testAccAttackChallengeModeDisabled("vercel_attack_challenge_mode.enabled"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config:      testAccAttackChallengeModeConfigResourceWindow(name, teamIDConfig(), `duration = "-1h"`),
+				ExpectError: regexp.MustCompile("is not a valid duration"),
+			},
+		},
+	})
+}
+
+func TestAcc_AttackChallengeModeResourceDuration(t *testing.T) {
+	skipIfReplaying(t, "the window sent to the API depends on the current time")
+	name := randString(t, 16)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAttackChallengeModeConfigResourceWindow(name, teamIDConfig(), `duration = "1h"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vercel_attack_challenge_mode.enabled", "enabled", "true"),
+					resource.TestCheckResourceAttr("vercel_attack_challenge_mode.enabled", "duration", "1h"),
+					resource.TestCheckResourceAttrSet("vercel_attack_challenge_mode.enabled", "expires_at"),
+				),
+			},
 		},
 	})
 }
 
+// testAccAttackChallengeModeDisabled checks that Attack Challenge Mode is disabled within Vercel, whatever the state says.
+func testAccAttackChallengeModeDisabled(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		out, err := testClient().GetAttackChallengeMode(context.TODO(), rs.Primary.ID, rs.Primary.Attributes["team_id"])
+		if err != nil {
+			return err
+		}
+		if out.Enabled {
+			return fmt.Errorf("expected Attack Challenge Mode to be disabled")
+		}
+		return nil
+	}
+}
+
 func testAccAttackChallengeModeConfigResource(name, teamID string) string {
 	return fmt.Sprintf(`
 resource "vercel_project" "enabled" {
@@ -94,3 +145,19 @@ resource "vercel_attack_challenge_mode" "enabled" {
 }
 `, name, teamID)
 }
+
+func testAccAttackChallengeModeConfigResourceWindow(name, teamID, window string) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "enabled" {
+    name = "test-acc-%[1]s-enabled"
+    %[2]s
+}
+
+resource "vercel_attack_challenge_mode" "enabled" {
+    project_id = vercel_project.enabled.id
+    enabled = true
+    %[3]s
+    %[2]s
+}
+`, name, teamID, window)
+}
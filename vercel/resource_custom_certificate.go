@@ -0,0 +1,375 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/certificate"
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+var (
+	_ resource.Resource                   = &customCertificateResource{}
+	_ resource.ResourceWithConfigure      = &customCertificateResource{}
+	_ resource.ResourceWithModifyPlan     = &customCertificateResource{}
+	_ resource.ResourceWithValidateConfig = &customCertificateResource{}
+)
+
+func newCustomCertificateResource() resource.Resource {
+	return &customCertificateResource{}
+}
+
+type customCertificateResource struct {
+	client client.CertificatesAPI
+}
+
+func (r *customCertificateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_certificate"
+}
+
+func (r *customCertificateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.CertificatesAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.CertificatesAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Schema returns the schema information for a custom certificate resource.
+func (r *customCertificateResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides a Custom Certificate resource, which uploads a TLS certificate issued by your own certificate authority.
+
+Vercel uses the certificate, instead of issuing its own, for any domains that it covers.
+
+The certificate is checked by the provider before it is uploaded, so a mismatched private key fails the plan rather
+than the apply. The private key must match the certificate, and the certificate must be signed by the certificate
+authority chain. The domains, expiry and fingerprint
+of the certificate are known at plan time, so ` + "`expires_at`" + ` can be used to drive alerts before the certificate expires.
+
+Changing any of the certificate, private key or certificate authority uploads a new certificate.
+
+~> The private key is stored in the Terraform state. Ensure the state is stored securely.
+
+For more detailed information, please see the [Vercel documentation](https://vercel.com/docs/domains/custom-SSL-certificate).
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:   "The ID of the certificate.",
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"team_id": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "The ID of the team the certificate should exist under. Required when configuring a team resource if a default team has not been set in the provider.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplaceIfConfigured(), stringplanmodifier.UseStateForUnknown()},
+			},
+			"certificate": schema.StringAttribute{
+				Description:   "The PEM encoded certificate.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"private_key": schema.StringAttribute{
+				Description:   "The PEM encoded private key of the certificate.",
+				Required:      true,
+				Sensitive:     true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"certificate_authority": schema.StringAttribute{
+				Description:   "The PEM encoded chain of certificates that issued the certificate. This may either end with the root certificate, or stop at an intermediate certificate.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"domains": schema.ListAttribute{
+				Description:   "The domains covered by the certificate, from its subject alternative names.",
+				Computed:      true,
+				ElementType:   types.StringType,
+				PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+			},
+			"issuer": schema.StringAttribute{
+				Description:   "The distinguished name of the issuer of the certificate.",
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"issued_at": schema.StringAttribute{
+				Description:   "When the certificate becomes valid, as an RFC 3339 timestamp.",
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"expires_at": schema.StringAttribute{
+				Description:   "When the certificate expires, as an RFC 3339 timestamp.",
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"fingerprint_sha256": schema.StringAttribute{
+				Description:   "The SHA-256 fingerprint of the certificate, as lowercase hex.",
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+// CustomCertificate represents the terraform state for a custom certificate resource.
+type CustomCertificate struct {
+	ID                   types.String `tfsdk:"id"`
+	TeamID               types.String `tfsdk:"team_id"`
+	Certificate          types.String `tfsdk:"certificate"`
+	PrivateKey           types.String `tfsdk:"private_key"`
+	CertificateAuthority types.String `tfsdk:"certificate_authority"`
+	Domains              types.List   `tfsdk:"domains"`
+	Issuer               types.String `tfsdk:"issuer"`
+	IssuedAt             types.String `tfsdk:"issued_at"`
+	ExpiresAt            types.String `tfsdk:"expires_at"`
+	FingerprintSHA256    types.String `tfsdk:"fingerprint_sha256"`
+}
+
+// setParsed sets the attributes that are parsed from the certificate itself.
+func (c *CustomCertificate) setParsed(ctx context.Context, parsed certificate.Certificate) diag.Diagnostics {
+	domains, diags := types.ListValueFrom(ctx, types.StringType, nonNil(parsed.Domains))
+	c.Domains = domains
+	c.Issuer = types.StringValue(parsed.Issuer)
+	c.IssuedAt = types.StringValue(parsed.NotBefore.Format(time.RFC3339))
+	c.ExpiresAt = types.StringValue(parsed.NotAfter.Format(time.RFC3339))
+	c.FingerprintSHA256 = types.StringValue(parsed.Fingerprint)
+	return diags
+}
+
+// ValidateConfig parses the certificate, and checks that the private key and certificate authority chain
+// match it, before any requests are made to Vercel.
+func (r *customCertificateResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config CustomCertificate
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if config.Certificate.IsUnknown() || config.Certificate.IsNull() {
+		return
+	}
+
+	if _, err := certificate.Parse(config.Certificate.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("certificate"),
+			"Custom Certificate Invalid",
+			fmt.Sprintf("The certificate could not be parsed: %s", err),
+		)
+		return
+	}
+	if !config.PrivateKey.IsUnknown() && !config.PrivateKey.IsNull() {
+		if err := certificate.CheckKey(config.Certificate.ValueString(), config.PrivateKey.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("private_key"),
+				"Custom Certificate Invalid",
+				fmt.Sprintf("The private key is invalid: %s", err),
+			)
+		}
+	}
+	if !config.CertificateAuthority.IsUnknown() && !config.CertificateAuthority.IsNull() {
+		if err := certificate.CheckChain(config.Certificate.ValueString(), config.CertificateAuthority.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("certificate_authority"),
+				"Custom Certificate Invalid",
+				fmt.Sprintf("The certificate authority chain is invalid: %s", err),
+			)
+		}
+	}
+}
+
+// ModifyPlan sets the attributes parsed from the certificate, so that they are known at plan time, and warns
+// about certificates that have expired or are about to expire.
+func (r *customCertificateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan CustomCertificate
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.Certificate.IsUnknown() {
+		return
+	}
+
+	parsed, err := certificate.Parse(plan.Certificate.ValueString())
+	if err != nil {
+		// This is reported by ValidateConfig.
+		return
+	}
+	resp.Diagnostics.Append(plan.setParsed(ctx, parsed)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch remaining := time.Until(parsed.NotAfter); {
+	case remaining <= 0:
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("certificate"),
+			"Custom Certificate expired",
+			fmt.Sprintf("The certificate for %v expired at %s.", parsed.Domains, plan.ExpiresAt.ValueString()),
+		)
+	case remaining < 30*24*time.Hour:
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("certificate"),
+			"Custom Certificate expiring soon",
+			fmt.Sprintf("The certificate for %v expires at %s.", parsed.Domains, plan.ExpiresAt.ValueString()),
+		)
+	}
+
+	diags = resp.Plan.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Create will upload a custom certificate to Vercel.
+// This is called automatically by the provider when a new resource should be created.
+func (r *customCertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan CustomCertificate
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parsed, err := certificate.Parse(plan.Certificate.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing Custom Certificate",
+			fmt.Sprintf("Could not parse certificate, unexpected error: %s", err),
+		)
+		return
+	}
+	out, err := r.client.UploadCustomCertificate(ctx, client.UploadCustomCertificateRequest{
+		TeamID:      plan.TeamID.ValueString(),
+		Certificate: plan.Certificate.ValueString(),
+		PrivateKey:  plan.PrivateKey.ValueString(),
+		CA:          plan.CertificateAuthority.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error uploading Custom Certificate",
+			fmt.Sprintf("Could not upload certificate for %v, unexpected error: %s", parsed.Domains, err),
+		)
+		return
+	}
+
+	result := plan
+	result.ID = types.StringValue(out.ID)
+	result.TeamID = toTeamID(out.TeamID)
+	resp.Diagnostics.Append(result.setParsed(ctx, parsed)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "uploaded custom certificate", map[string]interface{}{
+		"team_id":        result.TeamID.ValueString(),
+		"certificate_id": result.ID.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read checks that the certificate still exists within Vercel. The remaining attributes are parsed from the
+// certificate, so do not need to be read from the API.
+func (r *customCertificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state CustomCertificate
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := r.client.GetCustomCertificate(ctx, state.ID.ValueString(), state.TeamID.ValueString())
+	if client.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Custom Certificate",
+			fmt.Sprintf("Could not get Custom Certificate %s %s, unexpected error: %s",
+				state.TeamID.ValueString(),
+				state.ID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	state.TeamID = toTeamID(out.TeamID)
+	tflog.Info(ctx, "read custom certificate", map[string]interface{}{
+		"team_id":        state.TeamID.ValueString(),
+		"certificate_id": state.ID.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update does nothing, as any change to a certificate requires it to be uploaded again.
+func (r *customCertificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError(
+		"Updating a Custom Certificate is not supported",
+		"Updating a Custom Certificate is not supported",
+	)
+}
+
+// Delete removes the certificate from Vercel.
+func (r *customCertificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state CustomCertificate
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteCustomCertificate(ctx, state.ID.ValueString(), state.TeamID.ValueString())
+	if client.NotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting Custom Certificate",
+			fmt.Sprintf(
+				"Could not delete Custom Certificate %s %s, unexpected error: %s",
+				state.TeamID.ValueString(),
+				state.ID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "deleted custom certificate", map[string]interface{}{
+		"team_id":        state.TeamID.ValueString(),
+		"certificate_id": state.ID.ValueString(),
+	})
+}
@@ -0,0 +1,118 @@
+package vercel_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+func testAccCustomCertificateExists(n, teamID string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		_, err := testClient().GetCustomCertificate(context.TODO(), rs.Primary.ID, teamID)
+		return err
+	}
+}
+
+func testAccCustomCertificateDestroy(n, teamID string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		_, err := testClient().GetCustomCertificate(context.TODO(), rs.Primary.ID, teamID)
+		if err == nil {
+			return fmt.Errorf("expected not_found error, but got no error")
+		}
+		if !client.NotFound(err) {
+			return fmt.Errorf("Unexpected error checking for deleted custom certificate: %s", err)
+		}
+		return nil
+	}
+}
+
+// testCertificateFile reads one of the PEM files in testdata. These are long lived, so that the recorded
+// requests for the acceptance tests can be replayed.
+func testCertificateFile(t *testing.T, name string) string {
+	b, err := os.ReadFile(filepath.Join("testdata", "custom_certificate", name))
+	if err != nil {
+		t.Fatalf("could not read test certificate %s: %s", name, err)
+	}
+	return string(b)
+}
+
+func TestAcc_CustomCertificateResource(t *testing.T) {
+	cert := testCertificateFile(t, "cert.pem")
+	key := testCertificateFile(t, "key.pem")
+	ca := testCertificateFile(t, "ca.pem")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCustomCertificateDestroy("vercel_custom_certificate.test", testTeam()),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCustomCertificateConfig(cert, testCertificateFile(t, "other_key.pem"), ca, teamIDConfig()),
+				ExpectError: regexp.MustCompile("private key does not match the certificate"),
+			},
+			{
+				Config:      testAccCustomCertificateConfig(cert, key, cert, teamIDConfig()),
+				ExpectError: regexp.MustCompile("not signed by the CA chain"),
+			},
+			{
+				Config: testAccCustomCertificateConfig(cert, key, ca, teamIDConfig()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCustomCertificateExists("vercel_custom_certificate.test", testTeam()),
+					resource.TestCheckResourceAttr("vercel_custom_certificate.test", "domains.#", "2"),
+					resource.TestCheckResourceAttr("vercel_custom_certificate.test", "domains.0", "terraform-provider-vercel.test"),
+					resource.TestCheckResourceAttr("vercel_custom_certificate.test", "domains.1", "*.terraform-provider-vercel.test"),
+					resource.TestCheckResourceAttr("vercel_custom_certificate.test", "issuer", "CN=Terraform Acceptance Test CA"),
+					resource.TestCheckResourceAttr("vercel_custom_certificate.test", "issued_at", "2025-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("vercel_custom_certificate.test", "expires_at", "2124-01-01T00:00:00Z"),
+					resource.TestMatchResourceAttr("vercel_custom_certificate.test", "fingerprint_sha256", regexp.MustCompile("^[0-9a-f]{64}$")),
+				),
+			},
+			{
+				Config: testAccCustomCertificateConfig(cert, key, ca, teamIDConfig()) + `
+output "expires_at" {
+  value = vercel_custom_certificate.test.expires_at
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("expires_at", "2124-01-01T00:00:00Z"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCustomCertificateConfig(cert, key, ca, teamID string) string {
+	return fmt.Sprintf(`
+resource "vercel_custom_certificate" "test" {
+  certificate           = <<EOT
+%sEOT
+  private_key           = <<EOT
+%sEOT
+  certificate_authority = <<EOT
+%sEOT
+  %s
+}
+`, cert, key, ca, teamID)
+}
@@ -34,8 +34,14 @@ func newDeploymentResource() resource.Resource {
 	return &deploymentResource{}
 }
 
+// deploymentClient is the part of the Vercel API used to manage deployments.
+type deploymentClient interface {
+	client.DeploymentsAPI
+	client.ProjectsAPI
+}
+
 type deploymentResource struct {
-	client *client.Client
+	client deploymentClient
 }
 
 func (r *deploymentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -48,11 +54,11 @@ func (r *deploymentResource) Configure(ctx context.Context, req resource.Configu
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(deploymentClient)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected a client implementing client.DeploymentsAPI and client.ProjectsAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -6,7 +6,6 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/vercel/terraform-provider-vercel/client"
@@ -68,7 +67,7 @@ func noopDestroyCheck(*terraform.State) error {
 }
 
 func TestAcc_Deployment(t *testing.T) {
-	projectSuffix := acctest.RandString(16)
+	projectSuffix := randString(t, 16)
 
 	testTeamID := resource.TestCheckNoResourceAttr("vercel_deployment.test", "team_id")
 	if testTeam() != "" {
@@ -100,7 +99,7 @@ func TestAcc_Deployment(t *testing.T) {
 }
 
 func TestAcc_DeploymentWithEnvironment(t *testing.T) {
-	projectSuffix := acctest.RandString(16)
+	projectSuffix := randString(t, 16)
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		CheckDestroy:             noopDestroyCheck,
@@ -124,7 +123,7 @@ func TestAcc_DeploymentWithEnvironment(t *testing.T) {
 }
 
 func TestAcc_DeploymentWithProjectSettings(t *testing.T) {
-	projectSuffix := acctest.RandString(16)
+	projectSuffix := randString(t, 16)
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		CheckDestroy:             noopDestroyCheck,
@@ -146,7 +145,7 @@ func TestAcc_DeploymentWithProjectSettings(t *testing.T) {
 }
 
 func TestAcc_DeploymentWithRootDirectoryOverride(t *testing.T) {
-	projectSuffix := acctest.RandString(16)
+	projectSuffix := randString(t, 16)
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		CheckDestroy:             noopDestroyCheck,
@@ -164,7 +163,7 @@ func TestAcc_DeploymentWithRootDirectoryOverride(t *testing.T) {
 }
 
 func TestAcc_DeploymentWithPathPrefix(t *testing.T) {
-	projectSuffix := acctest.RandString(16)
+	projectSuffix := randString(t, 16)
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		CheckDestroy:             noopDestroyCheck,
@@ -181,7 +180,7 @@ func TestAcc_DeploymentWithPathPrefix(t *testing.T) {
 }
 
 func TestAcc_DeploymentWithDeleteOnDestroy(t *testing.T) {
-	projectSuffix := acctest.RandString(16)
+	projectSuffix := randString(t, 16)
 	extraConfig := "delete_on_destroy = true"
 	deploymentID := ""
 	storeDeploymentID := func(n string, did *string) resource.TestCheckFunc {
@@ -232,7 +231,7 @@ func TestAcc_DeploymentWithDeleteOnDestroy(t *testing.T) {
 }
 
 func TestAcc_DeploymentWithGitSource(t *testing.T) {
-	projectSuffix := acctest.RandString(16)
+	projectSuffix := randString(t, 16)
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		CheckDestroy:             noopDestroyCheck,
@@ -6,6 +6,8 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -15,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/vercel/terraform-provider-vercel/client"
+	"github.com/vercel/terraform-provider-vercel/dns"
 )
 
 var (
@@ -27,7 +30,7 @@ func newDNSRecordResource() resource.Resource {
 }
 
 type dnsRecordResource struct {
-	client *client.Client
+	client client.DNSAPI
 }
 
 func (r *dnsRecordResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -40,11 +43,11 @@ func (r *dnsRecordResource) Configure(ctx context.Context, req resource.Configur
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(client.DNSAPI)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected client.DNSAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -59,7 +62,9 @@ Provides a DNS Record resource.
 
 DNS records are instructions that live in authoritative DNS servers and provide information about a domain.
 
-~> The ` + "`value` field" + ` must be specified on all DNS record types except ` + "`SRV`" + `. When using ` + "`SRV`" + ` DNS records, the ` + "`srv`" + ` field must be specified.
+~> The ` + "`value` field" + ` must be specified on all DNS record types except ` + "`SRV`" + ` and ` + "`HTTPS`" + `. When using ` + "`SRV`" + ` DNS records, the ` + "`srv`" + ` field must be specified. When using ` + "`HTTPS`" + ` DNS records, the ` + "`https`" + ` field must be specified.
+
+Values are validated according to the type of the record before any changes are made, for example ensuring that ` + "`A`" + ` records hold an IPv4 address, and that ` + "`CAA`" + ` records use a known tag.
 
 For more detailed information, please see the [Vercel documentation](https://vercel.com/docs/concepts/projects/custom-domains#dns-records)
         `,
@@ -83,16 +88,16 @@ For more detailed information, please see the [Vercel documentation](https://ver
 				Required:    true,
 			},
 			"type": schema.StringAttribute{
-				Description:   "The type of DNS record. Available types: " + "`A`" + ", " + "`AAAA`" + ", " + "`ALIAS`" + ", " + "`CAA`" + ", " + "`CNAME`" + ", " + "`MX`" + ", " + "`NS`" + ", " + "`SRV`" + ", " + "`TXT`" + ".",
+				Description:   "The type of DNS record. Available types: " + "`A`" + ", " + "`AAAA`" + ", " + "`ALIAS`" + ", " + "`CAA`" + ", " + "`CNAME`" + ", " + "`HTTPS`" + ", " + "`MX`" + ", " + "`NS`" + ", " + "`SRV`" + ", " + "`TXT`" + ".",
 				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
 				Required:      true,
 				Validators: []validator.String{
-					stringOneOf("A", "AAAA", "ALIAS", "CAA", "CNAME", "MX", "NS", "SRV", "TXT"),
+					stringOneOf("A", "AAAA", "ALIAS", "CAA", "CNAME", "HTTPS", "MX", "NS", "SRV", "TXT"),
 				},
 			},
 			"value": schema.StringAttribute{
 				// required if any record type apart from SRV.
-				Description: "The value of the DNS record. The format depends on the 'type' property.\nFor an 'A' record, this should be a valid IPv4 address.\nFor an 'AAAA' record, this should be an IPv6 address.\nFor 'ALIAS' records, this should be a hostname.\nFor 'CAA' records, this should specify specify which Certificate Authorities (CAs) are allowed to issue certificates for the domain.\nFor 'CNAME' records, this should be a different domain name.\nFor 'MX' records, this should specify the mail server responsible for accepting messages on behalf of the domain name.\nFor 'TXT' records, this can contain arbitrary text.",
+				Description: "The value of the DNS record. The format depends on the 'type' property.\nFor an 'A' record, this should be a valid IPv4 address.\nFor an 'AAAA' record, this should be an IPv6 address.\nFor 'ALIAS' records, this should be a hostname.\nFor 'CAA' records, this should specify specify which Certificate Authorities (CAs) are allowed to issue certificates for the domain.\nFor 'CNAME' records, this should be a different domain name.\nFor 'MX' records, this should specify the mail server responsible for accepting messages on behalf of the domain name.\nFor 'TXT' records, this can contain arbitrary text.\nFor 'NS' records, this should be the hostname of a nameserver.",
 				Optional:    true,
 			},
 			"ttl": schema.Int64Attribute{
@@ -155,6 +160,28 @@ For more detailed information, please see the [Vercel documentation](https://ver
 					},
 				},
 			},
+			"https": schema.SingleNestedAttribute{
+				Description: "Settings for an HTTPS record, which uses the SVCB record format to advertise how to connect to an HTTPS service.",
+				Optional:    true, // required for HTTPS records.
+				Attributes: map[string]schema.Attribute{
+					"priority": schema.Int64Attribute{
+						Description: "The priority of the record. A priority of 0 makes the record an alias for `target`, in which case `params` must not be set. Otherwise, lower values are more preferred.",
+						Required:    true,
+						Validators: []validator.Int64{
+							int64GreaterThan(0),
+							int64LessThan(65535),
+						},
+					},
+					"target": schema.StringAttribute{
+						Description: "The hostname of the service, or `.` to use the name of the record itself.",
+						Required:    true,
+					},
+					"params": schema.StringAttribute{
+						Description: "The SvcParams of the record, as space separated `key=value` pairs. For example, `alpn=h2,h3 port=8443`.",
+						Optional:    true,
+					},
+				},
+			},
 		},
 	}
 }
@@ -167,6 +194,13 @@ type SRV struct {
 	Weight   types.Int64  `tfsdk:"weight"`
 }
 
+// HTTPSRecord reflects the state terraform stores internally for a nested HTTPS Record.
+type HTTPSRecord struct {
+	Priority types.Int64  `tfsdk:"priority"`
+	Target   types.String `tfsdk:"target"`
+	Params   types.String `tfsdk:"params"`
+}
+
 // DNSRecord reflects the state terraform stores internally for a DNS Record.
 type DNSRecord struct {
 	ID         types.String `tfsdk:"id"`
@@ -174,6 +208,7 @@ type DNSRecord struct {
 	MXPriority types.Int64  `tfsdk:"mx_priority"`
 	Name       types.String `tfsdk:"name"`
 	SRV        *SRV         `tfsdk:"srv"`
+	HTTPS      *HTTPSRecord `tfsdk:"https"`
 	TTL        types.Int64  `tfsdk:"ttl"`
 	TeamID     types.String `tfsdk:"team_id"`
 	Type       types.String `tfsdk:"type"`
@@ -191,6 +226,14 @@ func (d DNSRecord) toCreateDNSRecordRequest() client.CreateDNSRecordRequest {
 			Weight:   d.SRV.Weight.ValueInt64(),
 		}
 	}
+	var https *client.HTTPS = nil
+	if d.Type.ValueString() == "HTTPS" {
+		https = &client.HTTPS{
+			Priority: d.HTTPS.Priority.ValueInt64(),
+			Target:   d.HTTPS.Target.ValueString(),
+			Params:   d.HTTPS.Params.ValueString(),
+		}
+	}
 
 	return client.CreateDNSRecordRequest{
 		Domain:     d.Domain.ValueString(),
@@ -200,6 +243,7 @@ func (d DNSRecord) toCreateDNSRecordRequest() client.CreateDNSRecordRequest {
 		Type:       d.Type.ValueString(),
 		Value:      d.Value.ValueString(),
 		SRV:        srv,
+		HTTPS:      https,
 		Comment:    d.Comment.ValueString(),
 	}
 }
@@ -214,17 +258,27 @@ func (d DNSRecord) toUpdateRequest() client.UpdateDNSRecordRequest {
 			Weight:   d.SRV.Weight.ValueInt64Pointer(),
 		}
 	}
+	var https *client.HTTPSUpdate = nil
+	if d.HTTPS != nil {
+		params := d.HTTPS.Params.ValueString()
+		https = &client.HTTPSUpdate{
+			Priority: d.HTTPS.Priority.ValueInt64Pointer(),
+			Target:   d.HTTPS.Target.ValueStringPointer(),
+			Params:   &params,
+		}
+	}
 	return client.UpdateDNSRecordRequest{
 		MXPriority: d.MXPriority.ValueInt64Pointer(),
 		Name:       d.Name.ValueStringPointer(),
 		SRV:        srv,
+		HTTPS:      https,
 		TTL:        d.TTL.ValueInt64Pointer(),
 		Value:      d.Value.ValueStringPointer(),
 		Comment:    d.Comment.ValueString(),
 	}
 }
 
-func convertResponseToDNSRecord(r client.DNSRecord, value types.String, srv *SRV) (record DNSRecord, err error) {
+func convertResponseToDNSRecord(r client.DNSRecord, value types.String, srv *SRV, https *HTTPSRecord) (record DNSRecord, err error) {
 	record = DNSRecord{
 		Domain:     types.StringValue(r.Domain),
 		ID:         types.StringValue(r.ID),
@@ -273,6 +327,31 @@ func convertResponseToDNSRecord(r client.DNSRecord, value types.String, srv *SRV
 		return record, nil
 	}
 
+	if r.RecordType == "HTTPS" {
+		// As with SRV records, the returned 'Value' is comprised of the parts of the HTTPS block.
+		split := strings.SplitN(r.Value, " ", 3)
+		if len(split) < 2 {
+			return record, fmt.Errorf("expected a 2 or 3 part value '{priority} {target} {params}', but got %s", r.Value)
+		}
+		priority, err := strconv.Atoi(split[0])
+		if err != nil {
+			return record, fmt.Errorf("expected HTTPS record priority to be an int, but got %s", split[0])
+		}
+		record.HTTPS = &HTTPSRecord{
+			Priority: types.Int64Value(int64(priority)),
+			Target:   types.StringValue(split[1]),
+			Params:   types.StringNull(),
+		}
+		if len(split) == 3 && split[2] != "" {
+			record.HTTPS.Params = types.StringValue(split[2])
+		}
+		record.Value = types.StringNull()
+		if https != nil && fmt.Sprintf("%s.", https.Target.ValueString()) == record.HTTPS.Target.ValueString() {
+			record.HTTPS.Target = https.Target
+		}
+		return record, nil
+	}
+
 	if r.RecordType == "MX" {
 		split := strings.Split(r.Value, " ")
 		if len(split) != 2 {
@@ -307,47 +386,120 @@ func (r *dnsRecordResource) ValidateConfig(ctx context.Context, req resource.Val
 		return
 	}
 
-	if config.Type.ValueString() == "SRV" && config.SRV == nil {
+	recordType := config.Type.ValueString()
+	if recordType == "SRV" && config.SRV == nil {
 		resp.Diagnostics.AddError(
 			"DNS Record Invalid",
 			"A DNS Record type of 'SRV' requires the `srv` attribute to be set",
 		)
 	}
 
-	if config.Type.ValueString() != "SRV" && config.Value.IsNull() {
+	if recordType == "HTTPS" && config.HTTPS == nil {
 		resp.Diagnostics.AddError(
 			"DNS Record Invalid",
-			fmt.Sprintf("The `value` attribute must be set on records of `type` '%s'", config.Type.ValueString()),
+			"A DNS Record type of 'HTTPS' requires the `https` attribute to be set",
 		)
 	}
 
-	if config.Type.ValueString() == "SRV" && !config.Value.IsNull() {
+	if recordType != "SRV" && recordType != "HTTPS" && config.Value.IsNull() {
 		resp.Diagnostics.AddError(
 			"DNS Record Invalid",
-			"The `value` attribute should not be set on records of `type` 'SRV'",
+			fmt.Sprintf("The `value` attribute must be set on records of `type` '%s'", recordType),
 		)
 	}
 
-	if config.Type.ValueString() != "SRV" && config.SRV != nil {
+	if (recordType == "SRV" || recordType == "HTTPS") && !config.Value.IsNull() {
+		resp.Diagnostics.AddError(
+			"DNS Record Invalid",
+			fmt.Sprintf("The `value` attribute should not be set on records of `type` '%s'", recordType),
+		)
+	}
+
+	if recordType != "SRV" && config.SRV != nil {
 		resp.Diagnostics.AddError(
 			"DNS Record Invalid",
 			"The `srv` attribute should only be set on records of `type` 'SRV'",
 		)
 	}
 
-	if config.Type.ValueString() != "MX" && !config.MXPriority.IsNull() {
+	if recordType != "HTTPS" && config.HTTPS != nil {
+		resp.Diagnostics.AddError(
+			"DNS Record Invalid",
+			"The `https` attribute should only be set on records of `type` 'HTTPS'",
+		)
+	}
+
+	if recordType != "MX" && !config.MXPriority.IsNull() {
 		resp.Diagnostics.AddError(
 			"DNS Record Invalid",
 			"The `mx_priority` attribute should only be set on records of `type` 'MX'",
 		)
 	}
 
-	if config.Type.ValueString() == "MX" && config.MXPriority.IsNull() {
+	if recordType == "MX" && config.MXPriority.IsNull() {
 		resp.Diagnostics.AddError(
 			"DNS Record Invalid",
 			"A DNS Record type of 'MX' requires the `mx_priority` attribute to be set",
 		)
 	}
+
+	resp.Diagnostics.Append(validateDNSRecordValues(path.Empty(), config.Type, config.Value, config.SRV, config.HTTPS)...)
+}
+
+// validateDNSRecordValues validates the value, SRV and HTTPS fields of a DNS record according to its type.
+// Errors are reported against the attribute at fault, relative to the given path of the record.
+func validateDNSRecordValues(p path.Path, recordType, value types.String, srv *SRV, https *HTTPSRecord) (diags diag.Diagnostics) {
+	if recordType.IsUnknown() || recordType.IsNull() {
+		return diags
+	}
+	if !value.IsUnknown() && !value.IsNull() {
+		if err := dns.ValidateValue(recordType.ValueString(), value.ValueString()); err != nil {
+			diags.AddAttributeError(
+				p.AtName("value"),
+				"Invalid DNS Record value",
+				fmt.Sprintf("The value of a DNS Record of `type` '%s' is invalid: %s", recordType.ValueString(), err),
+			)
+		}
+	}
+	if srv != nil && !srv.Target.IsUnknown() && !srv.Target.IsNull() {
+		if err := dns.ValidateTarget(srv.Target.ValueString()); err != nil {
+			diags.AddAttributeError(
+				p.AtName("srv").AtName("target"),
+				"Invalid DNS Record value",
+				"The SRV target is invalid: "+err.Error(),
+			)
+		}
+	}
+	if https == nil {
+		return diags
+	}
+	if !https.Target.IsUnknown() && !https.Target.IsNull() {
+		if err := dns.ValidateTarget(https.Target.ValueString()); err != nil {
+			diags.AddAttributeError(
+				p.AtName("https").AtName("target"),
+				"Invalid DNS Record value",
+				"The HTTPS target is invalid: "+err.Error(),
+			)
+		}
+	}
+	if https.Params.IsUnknown() || https.Params.IsNull() {
+		return diags
+	}
+	if https.Priority.ValueInt64() == 0 && !https.Priority.IsUnknown() && https.Params.ValueString() != "" {
+		diags.AddAttributeError(
+			p.AtName("https").AtName("params"),
+			"Invalid DNS Record value",
+			"HTTPS records with a `priority` of 0 are aliases, and must not have any `params`",
+		)
+	}
+	if err := dns.ValidateSvcParams(https.Params.ValueString()); err != nil {
+		diags.AddAttributeError(
+			p.AtName("https").AtName("params"),
+			"Invalid DNS Record value",
+			"The HTTPS params are invalid: "+err.Error(),
+		)
+	}
+	return diags
 }
 
 // Create will create a DNS record within Vercel by calling the Vercel API.
@@ -369,7 +521,7 @@ func (r *dnsRecordResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
-	result, err := convertResponseToDNSRecord(out, plan.Value, plan.SRV)
+	result, err := convertResponseToDNSRecord(out, plan.Value, plan.SRV, plan.HTTPS)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error parsing DNS Record response",
@@ -417,7 +569,7 @@ func (r *dnsRecordResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	result, err := convertResponseToDNSRecord(out, state.Value, state.SRV)
+	result, err := convertResponseToDNSRecord(out, state.Value, state.SRV, state.HTTPS)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error parsing DNS Record response",
@@ -473,7 +625,7 @@ func (r *dnsRecordResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
-	result, err := convertResponseToDNSRecord(out, plan.Value, plan.SRV)
+	result, err := convertResponseToDNSRecord(out, plan.Value, plan.SRV, plan.HTTPS)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error parsing DNS Record response",
@@ -551,7 +703,7 @@ func (r *dnsRecordResource) ImportState(ctx context.Context, req resource.Import
 		return
 	}
 
-	result, err := convertResponseToDNSRecord(out, types.String{}, nil)
+	result, err := convertResponseToDNSRecord(out, types.String{}, nil, nil)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error processing DNS Record response",
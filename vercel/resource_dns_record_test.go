@@ -3,9 +3,9 @@ package vercel_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/vercel/terraform-provider-vercel/client"
@@ -53,7 +53,7 @@ func testAccDNSRecordExists(n, teamID string) resource.TestCheckFunc {
 
 func TestAcc_DNSRecord(t *testing.T) {
 	t.Skip("Skipping until i have a domain in a suitable location to test with")
-	nameSuffix := acctest.RandString(16)
+	nameSuffix := randString(t, 16)
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -67,6 +67,7 @@ func TestAcc_DNSRecord(t *testing.T) {
 			testAccDNSRecordDestroy("vercel_dns_record.mx", testTeam()),
 			testAccDNSRecordDestroy("vercel_dns_record.srv", testTeam()),
 			testAccDNSRecordDestroy("vercel_dns_record.txt", testTeam()),
+			testAccDNSRecordDestroy("vercel_dns_record.https", testTeam()),
 		),
 		Steps: []resource.TestStep{
 			{
@@ -143,6 +144,10 @@ func TestAcc_DNSRecord(t *testing.T) {
 					resource.TestCheckResourceAttr("vercel_dns_record.ns", "type", "NS"),
 					resource.TestCheckResourceAttr("vercel_dns_record.ns", "ttl", "120"),
 					resource.TestCheckResourceAttr("vercel_dns_record.ns", "value", "example.com."),
+					testAccDNSRecordExists("vercel_dns_record.https", testTeam()),
+					resource.TestCheckResourceAttr("vercel_dns_record.https", "https.priority", "1"),
+					resource.TestCheckResourceAttr("vercel_dns_record.https", "https.target", "."),
+					resource.TestCheckResourceAttr("vercel_dns_record.https", "https.params", "alpn=h2,h3"),
 					resource.TestCheckResourceAttr("vercel_dns_record.ns", "comment", "ns"),
 				),
 			},
@@ -208,6 +213,54 @@ func TestAcc_DNSRecord(t *testing.T) {
 	})
 }
 
+func TestAcc_DNSRecordInvalidValues(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDNSRecordInvalidConfig("A", `value = "2001:db8::1"`),
+				ExpectError: regexp.MustCompile(`is not a valid IPv4 address`),
+			},
+			{
+				Config:      testAccDNSRecordInvalidConfig("CNAME", `value = "76.76.21.21"`),
+				ExpectError: regexp.MustCompile(`not an IP address`),
+			},
+			{
+				Config:      testAccDNSRecordInvalidConfig("CAA", `value = "0 issue letsencrypt.org"`),
+				ExpectError: regexp.MustCompile(`enclosed in double quotes`),
+			},
+			{
+				Config: testAccDNSRecordInvalidConfig("HTTPS", `https = {
+    priority = 1
+    target   = "."
+    params   = "alpn=h2 alpn=h3"
+  }`),
+				ExpectError: regexp.MustCompile(`specified more than once`),
+			},
+			{
+				Config: testAccDNSRecordInvalidConfig("HTTPS", `https = {
+    priority = 0
+    target   = "example.com"
+    params   = "alpn=h2"
+  }`),
+				ExpectError: regexp.MustCompile(`must not have any`),
+			},
+		},
+	})
+}
+
+func testAccDNSRecordInvalidConfig(recordType, values string) string {
+	return fmt.Sprintf(`
+resource "vercel_dns_record" "invalid" {
+  domain = "example.com"
+  name   = "test-acc-invalid"
+  type   = "%s"
+  %s
+}
+`, recordType, values)
+}
+
 func testAccDNSRecordConfig(testDomain, nameSuffix, teamID string) string {
 	return fmt.Sprintf(`
 resource "vercel_dns_record" "a_without_ttl" {
@@ -319,6 +372,18 @@ resource "vercel_dns_record" "ns" {
   comment = "ns"
   %[3]s
 }
+resource "vercel_dns_record" "https" {
+  domain = "%[1]s"
+  name = "test-acc-%[2]s-https"
+  type = "HTTPS"
+  ttl  = 120
+  https = {
+    priority = 1
+    target   = "."
+    params   = "alpn=h2,h3"
+  }
+  %[3]s
+}
 `, testDomain, nameSuffix, teamID)
 }
 
@@ -0,0 +1,725 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+var (
+	_ resource.Resource                   = &dnsZoneResource{}
+	_ resource.ResourceWithConfigure      = &dnsZoneResource{}
+	_ resource.ResourceWithImportState    = &dnsZoneResource{}
+	_ resource.ResourceWithValidateConfig = &dnsZoneResource{}
+)
+
+func newDNSZoneResource() resource.Resource {
+	return &dnsZoneResource{}
+}
+
+// dnsZoneClient is the subset of the Vercel client used by the DNS zone resource.
+type dnsZoneClient interface {
+	client.DNSAPI
+	client.TeamsAPI
+}
+
+type dnsZoneResource struct {
+	client dnsZoneClient
+}
+
+func (r *dnsZoneResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_zone"
+}
+
+func (r *dnsZoneResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(dnsZoneClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected a client implementing client.DNSAPI and client.TeamsAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *dnsZoneResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides a DNS Zone resource, which manages the full set of DNS records for a domain.
+
+Records are compared by their content: adding a record to the zone creates it, removing a record deletes it, and
+changing any field of a record replaces it. This makes the resource well suited to migrating a domain with many
+records, for example by using the ` + "`vercel_dns_zone_file`" + ` data source to parse an existing zone file.
+
+By default, records that exist for the domain but are not part of the zone are left alone. Set ` + "`remove_unmanaged`" + `
+to delete them, keeping the zone exact. Default records created by Vercel are never removed.
+
+~> A DNS record should not be managed by both a ` + "`vercel_dns_zone`" + ` and a ` + "`vercel_dns_record`" + ` resource.
+
+For more detailed information, please see the [Vercel documentation](https://vercel.com/docs/concepts/projects/custom-domains#dns-records)
+        `,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"team_id": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "The team ID that the domain and DNS records belong to. Required when configuring a team resource if a default team has not been set in the provider.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplaceIfConfigured(), stringplanmodifier.UseStateForUnknown()},
+			},
+			"domain": schema.StringAttribute{
+				Description:   "The domain name, or zone, that the DNS records belong to.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Required:      true,
+			},
+			"remove_unmanaged": schema.BoolAttribute{
+				Description: "Whether DNS records that exist for the domain, but are not part of `records`, should be removed. Default records created by Vercel are never removed. Defaults to `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"records": schema.SetNestedAttribute{
+				Description: "The DNS records within the zone.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The subdomain name of the record. This should be an empty string if the record is for the root domain.",
+							Required:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The type of DNS record. Available types: " + "`A`" + ", " + "`AAAA`" + ", " + "`ALIAS`" + ", " + "`CAA`" + ", " + "`CNAME`" + ", " + "`HTTPS`" + ", " + "`MX`" + ", " + "`NS`" + ", " + "`SRV`" + ", " + "`TXT`" + ".",
+							Required:    true,
+							Validators: []validator.String{
+								stringOneOf("A", "AAAA", "ALIAS", "CAA", "CNAME", "HTTPS", "MX", "NS", "SRV", "TXT"),
+							},
+						},
+						"value": schema.StringAttribute{
+							Description: "The value of the DNS record. The format depends on the 'type' property, in the same way as the `vercel_dns_record` resource. Required for all record types except SRV and HTTPS.",
+							Optional:    true,
+						},
+						"ttl": schema.Int64Attribute{
+							Description: "The TTL value in seconds. Must be a number between 60 and 2147483647. If unspecified, it will default to 60 seconds.",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64GreaterThan(60),
+								int64LessThan(2147483647),
+							},
+						},
+						"mx_priority": schema.Int64Attribute{
+							Description: "The priority of the MX record. Required for MX records.",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64GreaterThan(0),
+								int64LessThan(65535),
+							},
+						},
+						"srv": schema.SingleNestedAttribute{
+							Description: "Settings for an SRV record. Required for SRV records.",
+							Optional:    true,
+							Attributes: map[string]schema.Attribute{
+								"weight": schema.Int64Attribute{
+									Description: "A relative weight for records with the same priority, higher value means higher chance of getting picked.",
+									Required:    true,
+								},
+								"port": schema.Int64Attribute{
+									Description: "The TCP or UDP port on which the service is to be found.",
+									Required:    true,
+								},
+								"priority": schema.Int64Attribute{
+									Description: "The priority of the target host, lower value means more preferred.",
+									Required:    true,
+								},
+								"target": schema.StringAttribute{
+									Description: "The canonical hostname of the machine providing the service.",
+									Required:    true,
+								},
+							},
+						},
+						"https": schema.SingleNestedAttribute{
+							Description: "Settings for an HTTPS record. Required for HTTPS records.",
+							Optional:    true,
+							Attributes: map[string]schema.Attribute{
+								"priority": schema.Int64Attribute{
+									Description: "The priority of the record. A priority of 0 makes the record an alias for `target`.",
+									Required:    true,
+								},
+								"target": schema.StringAttribute{
+									Description: "The hostname of the service, or `.` to use the name of the record itself.",
+									Required:    true,
+								},
+								"params": schema.StringAttribute{
+									Description: "The SvcParams of the record, as space separated `key=value` pairs.",
+									Optional:    true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// DNSZoneRecord reflects the state terraform stores internally for a record within a DNS Zone.
+type DNSZoneRecord struct {
+	Name       types.String `tfsdk:"name"`
+	Type       types.String `tfsdk:"type"`
+	Value      types.String `tfsdk:"value"`
+	TTL        types.Int64  `tfsdk:"ttl"`
+	MXPriority types.Int64  `tfsdk:"mx_priority"`
+	SRV        *SRV         `tfsdk:"srv"`
+	HTTPS      *HTTPSRecord `tfsdk:"https"`
+}
+
+// DNSZone reflects the state terraform stores internally for a DNS Zone.
+type DNSZone struct {
+	ID              types.String    `tfsdk:"id"`
+	TeamID          types.String    `tfsdk:"team_id"`
+	Domain          types.String    `tfsdk:"domain"`
+	RemoveUnmanaged types.Bool      `tfsdk:"remove_unmanaged"`
+	Records         []DNSZoneRecord `tfsdk:"records"`
+}
+
+// dnsHostnameTypes are the record types whose value is a hostname, which may or may not have a trailing dot.
+var dnsHostnameTypes = map[string]bool{
+	"ALIAS": true,
+	"CNAME": true,
+	"MX":    true,
+	"NS":    true,
+	"SRV":   true,
+	"HTTPS": true,
+}
+
+// normalizeDNSValue converts a DNS record value, in the format returned by the Vercel API, into a canonical
+// form so that equivalent values can be compared. Hostnames are compared case-insensitively, and with or
+// without a trailing dot.
+func normalizeDNSValue(recordType, value string) string {
+	if !dnsHostnameTypes[recordType] {
+		return value
+	}
+	fields := strings.Fields(value)
+	if len(fields) == 0 {
+		return value
+	}
+	// The hostname is the last field, apart from HTTPS records where it is followed by the params.
+	host := len(fields) - 1
+	if recordType == "HTTPS" {
+		host = min(1, host)
+	}
+	if fields[host] != "." {
+		fields[host] = strings.TrimSuffix(strings.ToLower(fields[host]), ".")
+	}
+	return strings.Join(fields, " ")
+}
+
+// dnsRecordKey identifies a DNS record by its content.
+func dnsRecordKey(name, recordType, value string, ttl int64) string {
+	if ttl == 0 {
+		ttl = 60
+	}
+	return strings.Join([]string{
+		strings.ToLower(name),
+		recordType,
+		normalizeDNSValue(recordType, value),
+		strconv.FormatInt(ttl, 10),
+	}, "|")
+}
+
+// key identifies the record in the same way as a record returned by the Vercel API.
+func (d DNSZoneRecord) key() string {
+	value := d.Value.ValueString()
+	switch d.Type.ValueString() {
+	case "MX":
+		value = fmt.Sprintf("%d %s", d.MXPriority.ValueInt64(), value)
+	case "SRV":
+		if d.SRV != nil {
+			value = fmt.Sprintf("%d %d %d %s", d.SRV.Priority.ValueInt64(), d.SRV.Weight.ValueInt64(), d.SRV.Port.ValueInt64(), d.SRV.Target.ValueString())
+		}
+	case "HTTPS":
+		if d.HTTPS != nil {
+			value = strings.TrimSpace(fmt.Sprintf("%d %s %s", d.HTTPS.Priority.ValueInt64(), d.HTTPS.Target.ValueString(), d.HTTPS.Params.ValueString()))
+		}
+	}
+	return dnsRecordKey(d.Name.ValueString(), d.Type.ValueString(), value, d.TTL.ValueInt64())
+}
+
+func (d DNSZoneRecord) String() string {
+	if d.Name.ValueString() == "" {
+		return d.Type.ValueString() + " record for the root domain"
+	}
+	return fmt.Sprintf("%s record %q", d.Type.ValueString(), d.Name.ValueString())
+}
+
+func (d DNSZoneRecord) toCreateDNSRecordRequest(domain string) client.CreateDNSRecordRequest {
+	return DNSRecord{
+		Domain:     types.StringValue(domain),
+		MXPriority: d.MXPriority,
+		Name:       d.Name,
+		SRV:        d.SRV,
+		HTTPS:      d.HTTPS,
+		TTL:        d.TTL,
+		Type:       d.Type,
+		Value:      d.Value,
+		Comment:    types.StringValue(""),
+	}.toCreateDNSRecordRequest()
+}
+
+func responseKey(r client.DNSRecord) string {
+	return dnsRecordKey(r.Name, r.RecordType, r.Value, r.TTL)
+}
+
+// convertResponseToDNSZoneRecord converts a DNS record that is not managed by the zone into the format
+// used by the zone. A TTL of 60 seconds is the default, and so is left unset.
+func convertResponseToDNSZoneRecord(r client.DNSRecord) (DNSZoneRecord, error) {
+	record, err := convertResponseToDNSRecord(r, types.String{}, nil, nil)
+	if err != nil {
+		return DNSZoneRecord{}, err
+	}
+	if !record.Value.IsNull() {
+		record.Value = types.StringValue(normalizeDNSValue(r.RecordType, record.Value.ValueString()))
+	}
+	if record.SRV != nil {
+		record.SRV.Target = types.StringValue(normalizeDNSValue("SRV", record.SRV.Target.ValueString()))
+	}
+	if record.HTTPS != nil {
+		record.HTTPS.Target = types.StringValue(normalizeDNSValue("HTTPS", record.HTTPS.Target.ValueString()))
+	}
+	ttl := record.TTL
+	if ttl.ValueInt64() == 60 {
+		ttl = types.Int64Null()
+	}
+	return DNSZoneRecord{
+		Name:       record.Name,
+		Type:       record.Type,
+		Value:      record.Value,
+		TTL:        ttl,
+		MXPriority: record.MXPriority,
+		SRV:        record.SRV,
+		HTTPS:      record.HTTPS,
+	}, nil
+}
+
+// isSystemDNSRecord returns whether a DNS record is one of the default records created by Vercel.
+func isSystemDNSRecord(r client.DNSRecord) bool {
+	return r.Creator == "system"
+}
+
+// recordPool groups the existing DNS records for a domain by their content, so that desired records
+// can be matched against them.
+type recordPool map[string][]client.DNSRecord
+
+func newRecordPool(records []client.DNSRecord) recordPool {
+	pool := recordPool{}
+	for _, r := range records {
+		pool[responseKey(r)] = append(pool[responseKey(r)], r)
+	}
+	return pool
+}
+
+// take removes and returns an existing record matching the given key, if there is one.
+func (p recordPool) take(key string) (client.DNSRecord, bool) {
+	records := p[key]
+	if len(records) == 0 {
+		return client.DNSRecord{}, false
+	}
+	p[key] = records[1:]
+	return records[0], true
+}
+
+// remaining returns every record that has not been taken.
+func (p recordPool) remaining() (records []client.DNSRecord) {
+	for _, rs := range p {
+		records = append(records, rs...)
+	}
+	return records
+}
+
+// ValidateConfig validates the Resource configuration.
+func (r *dnsZoneResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var records types.Set
+	diags := req.Config.GetAttribute(ctx, path.Root("records"), &records)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || records.IsNull() || records.IsUnknown() {
+		return
+	}
+
+	for _, element := range records.Elements() {
+		obj, ok := element.(types.Object)
+		if !ok || obj.IsUnknown() {
+			continue
+		}
+		var record DNSZoneRecord
+		diags := obj.As(ctx, &record, basetypes.ObjectAsOptions{})
+		if diags.HasError() {
+			// The record contains unknown values, so cannot be validated yet.
+			continue
+		}
+		resp.Diagnostics.Append(validateDNSZoneRecord(path.Root("records").AtSetValue(obj), record)...)
+	}
+}
+
+func validateDNSZoneRecord(p path.Path, record DNSZoneRecord) (diags diag.Diagnostics) {
+	recordType := record.Type.ValueString()
+	if record.Type.IsUnknown() {
+		return diags
+	}
+	invalid := func(attribute, detail string) {
+		diags.AddAttributeError(p.AtName(attribute), "DNS Zone Invalid", fmt.Sprintf("The %s is invalid: %s", record, detail))
+	}
+	if recordType == "SRV" && record.SRV == nil {
+		invalid("srv", "records of `type` 'SRV' require the `srv` attribute to be set")
+	}
+	if recordType == "HTTPS" && record.HTTPS == nil {
+		invalid("https", "records of `type` 'HTTPS' require the `https` attribute to be set")
+	}
+	if recordType != "SRV" && recordType != "HTTPS" && record.Value.IsNull() {
+		invalid("value", fmt.Sprintf("the `value` attribute must be set on records of `type` '%s'", recordType))
+	}
+	if (recordType == "SRV" || recordType == "HTTPS") && !record.Value.IsNull() {
+		invalid("value", fmt.Sprintf("the `value` attribute should not be set on records of `type` '%s'", recordType))
+	}
+	if recordType != "SRV" && record.SRV != nil {
+		invalid("srv", "the `srv` attribute should only be set on records of `type` 'SRV'")
+	}
+	if recordType != "HTTPS" && record.HTTPS != nil {
+		invalid("https", "the `https` attribute should only be set on records of `type` 'HTTPS'")
+	}
+	if recordType != "MX" && !record.MXPriority.IsNull() {
+		invalid("mx_priority", "the `mx_priority` attribute should only be set on records of `type` 'MX'")
+	}
+	if recordType == "MX" && record.MXPriority.IsNull() {
+		invalid("mx_priority", "records of `type` 'MX' require the `mx_priority` attribute to be set")
+	}
+	diags.Append(validateDNSRecordValues(p, record.Type, record.Value, record.SRV, record.HTTPS)...)
+	return diags
+}
+
+// apply makes the records of a domain match the planned zone. Records in the prior state that are no
+// longer planned are deleted, as are any unmanaged records if `remove_unmanaged` is set. Records are
+// deleted before any are created, so that a record can be swapped for a conflicting one, such as
+// replacing an A record with a CNAME.
+func (r *dnsZoneResource) apply(ctx context.Context, plan DNSZone, prior []DNSZoneRecord) (diags diag.Diagnostics) {
+	domain, teamID := plan.Domain.ValueString(), plan.TeamID.ValueString()
+	existing, err := r.client.ListDNSRecords(ctx, domain, teamID)
+	if err != nil {
+		diags.AddError(
+			"Error reading DNS Zone",
+			fmt.Sprintf("Could not list DNS Records for domain %s, unexpected error: %s", domain, err),
+		)
+		return diags
+	}
+
+	pool := newRecordPool(existing)
+	priorKeys := map[string]int{}
+	for _, record := range prior {
+		priorKeys[record.key()]++
+	}
+	var create []DNSZoneRecord
+	for _, record := range plan.Records {
+		key := record.key()
+		priorKeys[key]--
+		if _, ok := pool.take(key); !ok {
+			create = append(create, record)
+		}
+	}
+
+	for _, existing := range pool.remaining() {
+		key := responseKey(existing)
+		managed := priorKeys[key] > 0
+		if !managed && (!plan.RemoveUnmanaged.ValueBool() || isSystemDNSRecord(existing)) {
+			continue
+		}
+		priorKeys[key]--
+		err := r.client.DeleteDNSRecord(ctx, domain, existing.ID, teamID)
+		if client.NotFound(err) {
+			continue
+		}
+		if err != nil {
+			diags.AddError(
+				"Error deleting DNS Record",
+				fmt.Sprintf("Could not delete DNS Record %s for domain %s, unexpected error: %s", existing.ID, domain, err),
+			)
+			return diags
+		}
+		tflog.Info(ctx, "deleted DNS zone record", map[string]interface{}{
+			"domain":    domain,
+			"record_id": existing.ID,
+			"team_id":   teamID,
+			"managed":   managed,
+		})
+	}
+
+	for _, record := range create {
+		out, err := r.client.CreateDNSRecord(ctx, teamID, record.toCreateDNSRecordRequest(domain))
+		if err != nil {
+			diags.AddError(
+				"Error creating DNS Record",
+				fmt.Sprintf("Could not create %s for domain %s, unexpected error: %s", record, domain, err),
+			)
+			return diags
+		}
+		tflog.Info(ctx, "created DNS zone record", map[string]interface{}{
+			"domain":    domain,
+			"record_id": out.ID,
+			"team_id":   teamID,
+		})
+	}
+	return diags
+}
+
+// setComputed populates the computed attributes of a DNS Zone after it has been applied.
+func (r *dnsZoneResource) setComputed(ctx context.Context, plan *DNSZone) error {
+	plan.ID = plan.Domain
+	if plan.TeamID.IsUnknown() || plan.TeamID.IsNull() {
+		team, err := r.client.Team(ctx, "")
+		if err != nil {
+			return err
+		}
+		plan.TeamID = toTeamID(team.ID)
+	}
+	if plan.Records == nil {
+		plan.Records = []DNSZoneRecord{}
+	}
+	return nil
+}
+
+// Create will create the DNS records for a DNS Zone within Vercel by calling the Vercel API.
+// This is called automatically by the provider when a new resource should be created.
+func (r *dnsZoneResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan DNSZone
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, plan, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := r.setComputed(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating DNS Zone",
+			"Could not determine the team for the DNS Zone, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	tflog.Info(ctx, "created DNS Zone", map[string]interface{}{
+		"team_id": plan.TeamID,
+		"domain":  plan.Domain,
+		"records": len(plan.Records),
+	})
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read will read the DNS records of a DNS Zone from the vercel API and provide terraform with information about it.
+// It is called by the provider whenever values should be read to update state.
+//
+// Managed records that no longer exist are removed from state, so that they will be recreated. If
+// `remove_unmanaged` is set, unmanaged records are added to state, so that their removal is planned.
+func (r *dnsZoneResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state DNSZone
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	existing, err := r.client.ListDNSRecords(ctx, state.Domain.ValueString(), state.TeamID.ValueString())
+	if client.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading DNS Zone",
+			fmt.Sprintf("Could not list DNS Records for domain %s, unexpected error: %s", state.Domain.ValueString(), err),
+		)
+		return
+	}
+
+	pool := newRecordPool(existing)
+	records := []DNSZoneRecord{}
+	for _, record := range state.Records {
+		if _, ok := pool.take(record.key()); ok {
+			records = append(records, record)
+		}
+	}
+	if state.RemoveUnmanaged.ValueBool() {
+		for _, existing := range pool.remaining() {
+			if isSystemDNSRecord(existing) {
+				continue
+			}
+			record, err := convertResponseToDNSZoneRecord(existing)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error parsing DNS Record response",
+					fmt.Sprintf("Could not parse DNS Record %s, unexpected error: %s", existing.ID, err),
+				)
+				return
+			}
+			records = append(records, record)
+		}
+	}
+	state.Records = records
+	tflog.Info(ctx, "read DNS Zone", map[string]interface{}{
+		"team_id": state.TeamID,
+		"domain":  state.Domain,
+		"records": len(state.Records),
+	})
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update will update the DNS records of a DNS Zone via the vercel API.
+func (r *dnsZoneResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan DNSZone
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state DNSZone
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, plan, state.Records)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := r.setComputed(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating DNS Zone",
+			"Could not determine the team for the DNS Zone, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	tflog.Info(ctx, "updated DNS Zone", map[string]interface{}{
+		"team_id": plan.TeamID,
+		"domain":  plan.Domain,
+		"records": len(plan.Records),
+	})
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete removes the DNS records managed by a DNS Zone. Unmanaged records are left in place.
+func (r *dnsZoneResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state DNSZone
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Deleting is the same as applying an empty zone, without touching unmanaged records.
+	prior := state.Records
+	state.Records = nil
+	state.RemoveUnmanaged = types.BoolValue(false)
+	resp.Diagnostics.Append(r.apply(ctx, state, prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "deleted DNS Zone", map[string]interface{}{
+		"team_id": state.TeamID,
+		"domain":  state.Domain,
+	})
+}
+
+// ImportState takes an identifier and reads all the DNS Records of a domain from the Vercel API.
+// Default records created by Vercel are not imported.
+func (r *dnsZoneResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	teamID, domain, ok := splitInto1Or2(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Error importing DNS Zone",
+			fmt.Sprintf("Invalid id '%s' specified. should be in format \"team_id/domain\" or \"domain\"", req.ID),
+		)
+		return
+	}
+
+	existing, err := r.client.ListDNSRecords(ctx, domain, teamID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading DNS Zone",
+			fmt.Sprintf("Could not list DNS Records for domain %s, unexpected error: %s", domain, err),
+		)
+		return
+	}
+
+	result := DNSZone{
+		ID:              types.StringValue(domain),
+		TeamID:          toTeamID(teamID),
+		Domain:          types.StringValue(domain),
+		RemoveUnmanaged: types.BoolValue(false),
+		Records:         []DNSZoneRecord{},
+	}
+	for _, e := range existing {
+		if isSystemDNSRecord(e) {
+			continue
+		}
+		record, err := convertResponseToDNSZoneRecord(e)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error processing DNS Record response",
+				fmt.Sprintf("Could not process DNS Record %s, unexpected error: %s", e.ID, err),
+			)
+			return
+		}
+		result.Records = append(result.Records, record)
+	}
+	if err := r.setComputed(ctx, &result); err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing DNS Zone",
+			"Could not determine the team for the DNS Zone, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	diags := resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
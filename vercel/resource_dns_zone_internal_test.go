@@ -0,0 +1,265 @@
+package vercel
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/vercel/terraform-provider-vercel/client"
+	"github.com/vercel/terraform-provider-vercel/client/clienttest"
+)
+
+// recordingDNS wraps the in-memory DNS API, recording the order that records are created and deleted in,
+// and listing a set of default records created by Vercel alongside the records that exist.
+type recordingDNS struct {
+	*clienttest.DNS
+	system []client.DNSRecord
+	calls  []string
+}
+
+func (d *recordingDNS) ListDNSRecords(ctx context.Context, domain, teamID string) ([]client.DNSRecord, error) {
+	records, err := d.DNS.ListDNSRecords(ctx, domain, teamID)
+	return append(records, d.system...), err
+}
+
+func (d *recordingDNS) CreateDNSRecord(ctx context.Context, teamID string, request client.CreateDNSRecordRequest) (client.DNSRecord, error) {
+	d.calls = append(d.calls, "create "+request.Type+" "+request.Name)
+	return d.DNS.CreateDNSRecord(ctx, teamID, request)
+}
+
+func (d *recordingDNS) DeleteDNSRecord(ctx context.Context, domain, recordID, teamID string) error {
+	d.calls = append(d.calls, "delete "+recordID)
+	return d.DNS.DeleteDNSRecord(ctx, domain, recordID, teamID)
+}
+
+type fakeDNSZoneClient struct {
+	*recordingDNS
+	*clienttest.Teams
+}
+
+func newTestDNSZoneResource() (*dnsZoneResource, *recordingDNS) {
+	dns := &recordingDNS{DNS: clienttest.NewDNS()}
+	return &dnsZoneResource{
+		client: fakeDNSZoneClient{recordingDNS: dns, Teams: clienttest.NewTeams(client.Team{ID: "team_a"})},
+	}, dns
+}
+
+func zoneRecord(name, recordType, value string) DNSZoneRecord {
+	return DNSZoneRecord{
+		Name:       types.StringValue(name),
+		Type:       types.StringValue(recordType),
+		Value:      types.StringValue(value),
+		TTL:        types.Int64Null(),
+		MXPriority: types.Int64Null(),
+	}
+}
+
+func testZone(removeUnmanaged bool, records ...DNSZoneRecord) DNSZone {
+	return DNSZone{
+		ID:              types.StringValue("example.com"),
+		TeamID:          types.StringValue("team_a"),
+		Domain:          types.StringValue("example.com"),
+		RemoveUnmanaged: types.BoolValue(removeUnmanaged),
+		Records:         records,
+	}
+}
+
+// remoteRecords lists the records of the test domain, excluding the system records, as sorted keys.
+func remoteRecords(t *testing.T, dns *recordingDNS) (keys []string) {
+	t.Helper()
+	records, err := dns.DNS.ListDNSRecords(context.Background(), "example.com", "team_a")
+	if err != nil {
+		t.Fatalf("unable to list DNS records: %s", err)
+	}
+	for _, r := range records {
+		keys = append(keys, responseKey(r))
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func createRemote(t *testing.T, dns *recordingDNS, record DNSZoneRecord) client.DNSRecord {
+	t.Helper()
+	r, err := dns.DNS.CreateDNSRecord(context.Background(), "team_a", record.toCreateDNSRecordRequest("example.com"))
+	if err != nil {
+		t.Fatalf("unable to create DNS record: %s", err)
+	}
+	return r
+}
+
+func TestNormalizeDNSValue(t *testing.T) {
+	for _, tc := range []struct {
+		recordType string
+		value      string
+		expected   string
+	}{
+		{"A", "1.2.3.4", "1.2.3.4"},
+		{"TXT", "Some Text.", "Some Text."},
+		{"CNAME", "Target.Example.COM.", "target.example.com"},
+		{"CNAME", "target.example.com", "target.example.com"},
+		{"CNAME", "", ""},
+		{"MX", "10 Mail.Example.com.", "10 mail.example.com"},
+		{"SRV", "10 5 443 Target.Example.com.", "10 5 443 target.example.com"},
+		{"HTTPS", "1 Svc.Example.com. alpn=h2", "1 svc.example.com alpn=h2"},
+		{"HTTPS", "1 .", "1 ."},
+	} {
+		if actual := normalizeDNSValue(tc.recordType, tc.value); actual != tc.expected {
+			t.Errorf("normalizeDNSValue(%q, %q) = %q, want %q", tc.recordType, tc.value, actual, tc.expected)
+		}
+	}
+}
+
+// TestDNSZoneRecordKeyMatchesResponse checks that a configured record matches the record the API returns
+// after creating it, so that records are not recreated on every apply.
+func TestDNSZoneRecordKeyMatchesResponse(t *testing.T) {
+	_, dns := newTestDNSZoneResource()
+	mx := zoneRecord("", "MX", "Mail.Example.com.")
+	mx.MXPriority = types.Int64Value(10)
+	srv := zoneRecord("_sip._tcp", "SRV", "")
+	srv.Value = types.StringNull()
+	srv.SRV = &SRV{
+		Port:     types.Int64Value(5060),
+		Priority: types.Int64Value(10),
+		Target:   types.StringValue("sip.example.com"),
+		Weight:   types.Int64Value(5),
+	}
+	https := zoneRecord("svc", "HTTPS", "")
+	https.Value = types.StringNull()
+	https.HTTPS = &HTTPSRecord{
+		Priority: types.Int64Value(1),
+		Target:   types.StringValue("svc.example.com."),
+		Params:   types.StringValue("alpn=h2"),
+	}
+	ttl := zoneRecord("ttl", "A", "1.2.3.4")
+	ttl.TTL = types.Int64Value(120)
+
+	for _, record := range []DNSZoneRecord{
+		zoneRecord("www", "A", "1.2.3.4"),
+		zoneRecord("Alias", "CNAME", "Target.Example.com."),
+		mx,
+		srv,
+		https,
+		ttl,
+	} {
+		created := createRemote(t, dns, record)
+		if responseKey(created) != record.key() {
+			t.Errorf("expected %s to match the created record, got %q, want %q", record, responseKey(created), record.key())
+		}
+	}
+}
+
+func TestDNSZoneApply(t *testing.T) {
+	ctx := context.Background()
+	r, dns := newTestDNSZoneResource()
+	dns.system = []client.DNSRecord{{
+		ID:         "rec_system",
+		Domain:     "example.com",
+		TeamID:     "team_a",
+		Name:       "",
+		RecordType: "ALIAS",
+		Value:      "cname.vercel-dns.com.",
+		TTL:        60,
+		Creator:    "system",
+	}}
+	unmanaged := createRemote(t, dns, zoneRecord("unmanaged", "TXT", "hello"))
+
+	// A record that already exists is matched rather than created, even if the API formats it differently.
+	createRemote(t, dns, zoneRecord("alias", "CNAME", "target.example.com"))
+	www := zoneRecord("www", "A", "1.2.3.4")
+	alias := zoneRecord("Alias", "CNAME", "Target.Example.com.")
+	diags := r.apply(ctx, testZone(false, www, alias), nil)
+	if diags.HasError() {
+		t.Fatalf("unable to apply zone: %v", diags)
+	}
+	expected := []string{"create A www"}
+	if !slices.Equal(dns.calls, expected) {
+		t.Errorf("expected calls %v, got %v", expected, dns.calls)
+	}
+
+	// Swapping an A record for a CNAME with the same name must delete the A record first, as the API
+	// rejects a CNAME alongside any other record.
+	dns.calls = nil
+	wwwRecords := recordsNamed(t, dns, "www")
+	cname := zoneRecord("www", "CNAME", "target.example.com")
+	diags = r.apply(ctx, testZone(false, cname, alias), []DNSZoneRecord{www, alias})
+	if diags.HasError() {
+		t.Fatalf("unable to apply zone: %v", diags)
+	}
+	expected = []string{"delete " + wwwRecords[0].ID, "create CNAME www"}
+	if !slices.Equal(dns.calls, expected) {
+		t.Errorf("expected calls %v, got %v", expected, dns.calls)
+	}
+
+	// A duplicate of a managed record is not managed, so is only deleted when removing unmanaged records,
+	// along with any other unmanaged records apart from the default records created by Vercel.
+	duplicate := createRemote(t, dns, cname)
+	dns.calls = nil
+	diags = r.apply(ctx, testZone(false, cname, alias), []DNSZoneRecord{cname, alias})
+	if diags.HasError() {
+		t.Fatalf("unable to apply zone: %v", diags)
+	}
+	if len(dns.calls) != 0 {
+		t.Errorf("expected no changes without remove_unmanaged, got %v", dns.calls)
+	}
+	diags = r.apply(ctx, testZone(true, cname, alias), []DNSZoneRecord{cname, alias})
+	if diags.HasError() {
+		t.Fatalf("unable to apply zone: %v", diags)
+	}
+	if len(dns.calls) != 2 || !slices.Contains(dns.calls, "delete "+unmanaged.ID) || slices.Contains(dns.calls, "delete rec_system") {
+		t.Errorf("expected the unmanaged records to be deleted, got %v", dns.calls)
+	}
+	if len(recordsNamed(t, dns, "www")) != 1 {
+		t.Errorf("expected the duplicate %s to be deleted, got %v", duplicate.ID, remoteRecords(t, dns))
+	}
+	expected = []string{cname.key(), alias.key()}
+	sort.Strings(expected)
+	if actual := remoteRecords(t, dns); !slices.Equal(actual, expected) {
+		t.Errorf("expected records %v, got %v", expected, actual)
+	}
+}
+
+// TestDNSZoneDelete checks that deleting a zone only deletes the records it manages.
+func TestDNSZoneDelete(t *testing.T) {
+	ctx := context.Background()
+	r, dns := newTestDNSZoneResource()
+	unmanaged := createRemote(t, dns, zoneRecord("unmanaged", "TXT", "hello"))
+	www := zoneRecord("www", "A", "1.2.3.4")
+	createRemote(t, dns, www)
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	diags := state.Set(ctx, testZone(true, www))
+	if diags.HasError() {
+		t.Fatalf("unable to set state: %v", diags)
+	}
+
+	resp := resource.DeleteResponse{State: state}
+	r.Delete(ctx, resource.DeleteRequest{State: state}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unable to delete zone: %v", resp.Diagnostics)
+	}
+	expected := []string{responseKey(unmanaged)}
+	if actual := remoteRecords(t, dns); !slices.Equal(actual, expected) {
+		t.Errorf("expected only the unmanaged record to remain, got %v", actual)
+	}
+}
+
+func recordsNamed(t *testing.T, dns *recordingDNS, name string) (records []client.DNSRecord) {
+	t.Helper()
+	all, err := dns.DNS.ListDNSRecords(context.Background(), "example.com", "team_a")
+	if err != nil {
+		t.Fatalf("unable to list DNS records: %s", err)
+	}
+	for _, r := range all {
+		if r.Name == name {
+			records = append(records, r)
+		}
+	}
+	return records
+}
@@ -0,0 +1,108 @@
+package vercel_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// testAccDNSZoneRecordCount checks the number of records within a domain that have the given name prefix.
+func testAccDNSZoneRecordCount(domain, teamID, prefix string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		records, err := testClient().ListDNSRecords(context.TODO(), domain, teamID)
+		if err != nil {
+			return err
+		}
+		count := 0
+		for _, r := range records {
+			if strings.HasPrefix(r.Name, prefix) {
+				count++
+			}
+		}
+		if count != expected {
+			return fmt.Errorf("expected %d DNS records with prefix %s, got %d", expected, prefix, count)
+		}
+		return nil
+	}
+}
+
+func TestAcc_DNSZone(t *testing.T) {
+	nameSuffix := randString(t, 16)
+	prefix := "test-acc-zone-" + nameSuffix
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccDNSZoneRecordCount(testDomain(), testTeam(), prefix, 0),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSZoneConfig(testDomain(), prefix, teamIDConfig(), `
+    {
+        name  = "%[1]s-a"
+        type  = "A"
+        value = "127.0.0.1"
+    },
+    {
+        name        = "%[1]s-mx"
+        type        = "MX"
+        mx_priority = 10
+        value       = "mail.example.com"
+    },
+    {
+        name = "%[1]s-srv"
+        type = "SRV"
+        ttl  = 120
+        srv = {
+            port     = 5060
+            weight   = 60
+            priority = 10
+            target   = "sip.example.com"
+        }
+    },
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vercel_dns_zone.test", "id", testDomain()),
+					resource.TestCheckResourceAttr("vercel_dns_zone.test", "records.#", "3"),
+					testAccDNSZoneRecordCount(testDomain(), testTeam(), prefix, 3),
+				),
+			},
+			{
+				Config: testAccDNSZoneConfig(testDomain(), prefix, teamIDConfig(), `
+    {
+        name  = "%[1]s-a"
+        type  = "A"
+        value = "127.0.0.2"
+    },
+    {
+        name  = "%[1]s-txt"
+        type  = "TXT"
+        value = "some text"
+    },
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vercel_dns_zone.test", "records.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("vercel_dns_zone.test", "records.*", map[string]string{
+						"type":  "A",
+						"value": "127.0.0.2",
+					}),
+					testAccDNSZoneRecordCount(testDomain(), testTeam(), prefix, 2),
+				),
+			},
+		},
+	})
+}
+
+func testAccDNSZoneConfig(domain, prefix, teamID, records string) string {
+	return fmt.Sprintf(`
+resource "vercel_dns_zone" "test" {
+    domain = "%[2]s"
+    %[3]s
+    records = [
+%[4]s
+    ]
+}
+`, prefix, domain, teamID, fmt.Sprintf(records, prefix))
+}
@@ -0,0 +1,510 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/client"
+	"github.com/vercel/terraform-provider-vercel/dns"
+)
+
+var (
+	_ resource.Resource                   = &domainResource{}
+	_ resource.ResourceWithConfigure      = &domainResource{}
+	_ resource.ResourceWithImportState    = &domainResource{}
+	_ resource.ResourceWithValidateConfig = &domainResource{}
+)
+
+func newDomainResource() resource.Resource {
+	return &domainResource{}
+}
+
+type domainResource struct {
+	client client.DomainsAPI
+}
+
+func (r *domainResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain"
+}
+
+func (r *domainResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.DomainsAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.DomainsAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Schema returns the schema information for a domain resource.
+func (r *domainResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides a Domain resource.
+
+A Domain adds a domain name to your Vercel account or team, so that it can be used by ` + "`vercel_project_domain`" + `
+and ` + "`vercel_dns_record`" + ` resources. A domain registered elsewhere can either be added as is, or its registration can be
+transferred to Vercel by setting ` + "`auth_code`" + ` and ` + "`expected_price`" + `.
+
+Vercel attempts to verify the ownership of the domain when it is added. Use the ` + "`vercel_domain_config`" + ` data source
+to check whether the domain is configured correctly.
+
+~> Deleting this resource removes the domain from Vercel, along with any DNS records and aliases that use it.
+
+For more detailed information, please see the [Vercel documentation](https://vercel.com/docs/projects/domains).
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:   "The ID of the domain.",
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"team_id": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "The ID of the team the domain should exist under. Required when configuring a team resource if a default team has not been set in the provider.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplaceIfConfigured(), stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				Description:   "The domain name, for example `example.com`.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"auth_code": schema.StringAttribute{
+				Description: "The authorization code provided by the current registrar of the domain. When set, the registration of the domain is transferred to Vercel. This is only used when the domain is added.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"expected_price": schema.Float64Attribute{
+				Description: "The price, in USD, that you expect to pay to transfer the domain. The transfer fails if the price does not match. Required when `auth_code` is set.",
+				Optional:    true,
+			},
+			"auto_renew": schema.BoolAttribute{
+				Description:   "Whether the domain should be renewed automatically before it expires. This can only be configured for domains registered with Vercel.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"custom_nameservers": schema.SetAttribute{
+				Description: "Nameservers to use for the domain instead of the Vercel nameservers. This can only be configured for domains registered with Vercel.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					stringSetMinCount(1),
+				},
+			},
+			"verified": schema.BoolAttribute{
+				Description: "Whether the ownership of the domain has been verified.",
+				Computed:    true,
+			},
+			"service_type": schema.StringAttribute{
+				Description:   "How the domain is managed. `zeit.world` for domains registered with Vercel, `external` for domains registered elsewhere, or `na` if unknown.",
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"nameservers": schema.ListAttribute{
+				Description: "The nameservers the domain currently uses.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"intended_nameservers": schema.ListAttribute{
+				Description:   "The Vercel nameservers the domain should use in order for Vercel to manage its DNS records.",
+				Computed:      true,
+				ElementType:   types.StringType,
+				PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+			},
+			"expires_at": schema.StringAttribute{
+				Description: "When the registration of the domain expires, as an RFC 3339 timestamp. This is only known for domains registered with Vercel.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Domain represents the terraform state for a domain resource.
+type Domain struct {
+	ID                  types.String  `tfsdk:"id"`
+	TeamID              types.String  `tfsdk:"team_id"`
+	Name                types.String  `tfsdk:"name"`
+	AuthCode            types.String  `tfsdk:"auth_code"`
+	ExpectedPrice       types.Float64 `tfsdk:"expected_price"`
+	AutoRenew           types.Bool    `tfsdk:"auto_renew"`
+	CustomNameservers   types.Set     `tfsdk:"custom_nameservers"`
+	Verified            types.Bool    `tfsdk:"verified"`
+	ServiceType         types.String  `tfsdk:"service_type"`
+	Nameservers         types.List    `tfsdk:"nameservers"`
+	IntendedNameservers types.List    `tfsdk:"intended_nameservers"`
+	ExpiresAt           types.String  `tfsdk:"expires_at"`
+}
+
+// convertResponseToDomain converts a domain response into terraform state. The auth code and expected price
+// are never returned by the API, so these are kept from the previous plan or state.
+func convertResponseToDomain(ctx context.Context, response client.Domain, previous Domain) (Domain, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	result := Domain{
+		ID:            types.StringValue(response.ID),
+		TeamID:        toTeamID(response.TeamID),
+		Name:          types.StringValue(response.Name),
+		AuthCode:      previous.AuthCode,
+		ExpectedPrice: previous.ExpectedPrice,
+		AutoRenew:     types.BoolValue(response.Renew != nil && *response.Renew),
+		Verified:      types.BoolValue(response.Verified),
+		ServiceType:   types.StringValue(response.ServiceType),
+		ExpiresAt:     types.StringNull(),
+	}
+	if response.ExpiresAt != nil {
+		result.ExpiresAt = types.StringValue(time.UnixMilli(*response.ExpiresAt).UTC().Format(time.RFC3339))
+	}
+
+	result.CustomNameservers = types.SetNull(types.StringType)
+	if len(response.CustomNameservers) > 0 {
+		nameservers, d := types.SetValueFrom(ctx, types.StringType, response.CustomNameservers)
+		diags.Append(d...)
+		result.CustomNameservers = nameservers
+	}
+	nameservers, d := types.ListValueFrom(ctx, types.StringType, nonNil(response.Nameservers))
+	diags.Append(d...)
+	result.Nameservers = nameservers
+	intended, d := types.ListValueFrom(ctx, types.StringType, nonNil(response.IntendedNameservers))
+	diags.Append(d...)
+	result.IntendedNameservers = intended
+	return result, diags
+}
+
+func nonNil(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
+
+// ValidateConfig validates the domain name, and checks that transfers specify both an auth code and price.
+func (r *domainResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config Domain
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Name.IsUnknown() && !config.Name.IsNull() {
+		name := config.Name.ValueString()
+		if err := dns.ValidateHostname(name); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"Domain Invalid",
+				fmt.Sprintf("The domain name is invalid: %s", err),
+			)
+		} else if name != strings.ToLower(strings.TrimSuffix(name, ".")) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"Domain Invalid",
+				fmt.Sprintf("The domain name %q must be lowercase, without a trailing dot.", name),
+			)
+		}
+	}
+	if !config.AuthCode.IsNull() && config.ExpectedPrice.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("expected_price"),
+			"Domain Invalid",
+			"The `expected_price` attribute must be set when transferring a domain with an `auth_code`.",
+		)
+	}
+	if !config.ExpectedPrice.IsNull() && config.AuthCode.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("auth_code"),
+			"Domain Invalid",
+			"The `auth_code` attribute must be set when an `expected_price` is specified.",
+		)
+	}
+}
+
+// configure applies the renewal and nameserver settings of a domain, and attempts to verify it if it is not
+// already verified. Verification failing is not an error, as it may take time for DNS changes to propagate.
+func (r *domainResource) configure(ctx context.Context, plan Domain, state *Domain, domain client.Domain) (client.Domain, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	request := client.UpdateDomainRequest{}
+	if !plan.AutoRenew.IsUnknown() && !plan.AutoRenew.IsNull() && plan.AutoRenew.ValueBool() != (domain.Renew != nil && *domain.Renew) {
+		request.Renew = plan.AutoRenew.ValueBoolPointer()
+	}
+	var nameservers []string
+	diags.Append(plan.CustomNameservers.ElementsAs(ctx, &nameservers, false)...)
+	if diags.HasError() {
+		return domain, diags
+	}
+	if len(nameservers) > 0 || (state != nil && !state.CustomNameservers.IsNull()) {
+		nameservers = nonNil(nameservers)
+		request.CustomNameservers = &nameservers
+	}
+
+	if request.Renew != nil || request.CustomNameservers != nil {
+		err := r.client.UpdateDomain(ctx, domain.Name, domain.TeamID, request)
+		if err != nil {
+			diags.AddError(
+				"Error updating domain",
+				fmt.Sprintf("Could not update domain %s, unexpected error: %s", domain.Name, err),
+			)
+			return domain, diags
+		}
+	}
+
+	if !domain.Verified {
+		_, err := r.client.VerifyDomain(ctx, domain.Name, domain.TeamID)
+		if err != nil && !client.NotFound(err) {
+			diags.AddWarning(
+				"Domain not verified",
+				fmt.Sprintf("The domain %s could not be verified yet. Use the `vercel_domain_config` data source to see how the domain should be configured. The verification error was: %s", domain.Name, err),
+			)
+		}
+	}
+
+	domain, err := r.client.GetDomain(ctx, domain.Name, domain.TeamID)
+	if err != nil {
+		diags.AddError(
+			"Error reading domain",
+			fmt.Sprintf("Could not read domain %s, unexpected error: %s", plan.Name.ValueString(), err),
+		)
+	}
+	return domain, diags
+}
+
+// Create will add a domain to Vercel.
+// This is called automatically by the provider when a new resource should be created.
+func (r *domainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan Domain
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := client.CreateDomainRequest{
+		Name:   plan.Name.ValueString(),
+		Method: "add",
+	}
+	if !plan.AuthCode.IsNull() {
+		request.Method = "transfer-in"
+		request.AuthCode = plan.AuthCode.ValueString()
+		request.ExpectedPrice = plan.ExpectedPrice.ValueFloat64Pointer()
+	}
+	out, err := r.client.CreateDomain(ctx, plan.TeamID.ValueString(), request)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error adding domain",
+			fmt.Sprintf("Could not add domain %s, unexpected error: %s", plan.Name.ValueString(), err),
+		)
+		return
+	}
+
+	out, diags = r.configure(ctx, plan, nil, out)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, diags := convertResponseToDomain(ctx, out, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "added domain", map[string]interface{}{
+		"team_id": result.TeamID.ValueString(),
+		"domain":  result.Name.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read will read the domain information by requesting it from the Vercel API, and will update terraform
+// with this information.
+func (r *domainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state Domain
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := r.client.GetDomain(ctx, state.Name.ValueString(), state.TeamID.ValueString())
+	if client.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading domain",
+			fmt.Sprintf("Could not read domain %s %s, unexpected error: %s",
+				state.TeamID.ValueString(),
+				state.Name.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	result, diags := convertResponseToDomain(ctx, out, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "read domain", map[string]interface{}{
+		"team_id": result.TeamID.ValueString(),
+		"domain":  result.Name.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update will update the renewal and nameserver settings of a domain, retrying verification if the domain
+// has not been verified.
+func (r *domainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state Domain
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := r.client.GetDomain(ctx, state.Name.ValueString(), state.TeamID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading domain",
+			fmt.Sprintf("Could not read domain %s %s, unexpected error: %s",
+				state.TeamID.ValueString(),
+				state.Name.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	out, diags = r.configure(ctx, plan, &state, out)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, diags := convertResponseToDomain(ctx, out, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "updated domain", map[string]interface{}{
+		"team_id": result.TeamID.ValueString(),
+		"domain":  result.Name.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete removes the domain from Vercel.
+func (r *domainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state Domain
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteDomain(ctx, state.Name.ValueString(), state.TeamID.ValueString())
+	if client.NotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting domain",
+			fmt.Sprintf(
+				"Could not delete domain %s %s, unexpected error: %s",
+				state.TeamID.ValueString(),
+				state.Name.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "deleted domain", map[string]interface{}{
+		"team_id": state.TeamID.ValueString(),
+		"domain":  state.Name.ValueString(),
+	})
+}
+
+// ImportState takes an identifier and reads all the domain information from the Vercel API.
+// The results are then stored in terraform state.
+func (r *domainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	teamID, name, ok := splitInto1Or2(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Error importing domain",
+			fmt.Sprintf("Invalid id '%s' specified. should be in format \"team_id/domain\" or \"domain\"", req.ID),
+		)
+		return
+	}
+
+	out, err := r.client.GetDomain(ctx, name, teamID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading domain",
+			fmt.Sprintf("Could not read domain %s %s, unexpected error: %s", teamID, name, err),
+		)
+		return
+	}
+
+	result, diags := convertResponseToDomain(ctx, out, Domain{
+		AuthCode:      types.StringNull(),
+		ExpectedPrice: types.Float64Null(),
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "imported domain", map[string]interface{}{
+		"team_id": result.TeamID.ValueString(),
+		"domain":  result.Name.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,103 @@
+package vercel_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+func testAccDomainExists(n, teamID string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		_, err := testClient().GetDomain(context.TODO(), rs.Primary.Attributes["name"], teamID)
+		return err
+	}
+}
+
+func testAccDomainDestroy(name, teamID string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, err := testClient().GetDomain(context.TODO(), name, teamID)
+		if err == nil {
+			return fmt.Errorf("expected not_found error, but got no error")
+		}
+		if !client.NotFound(err) {
+			return fmt.Errorf("Unexpected error checking for deleted domain: %s", err)
+		}
+		return nil
+	}
+}
+
+func TestAcc_DomainResource(t *testing.T) {
+	// Domains that are not owned can still be added, they just remain unverified.
+	name := fmt.Sprintf("test-acc-%s.com", randString(t, 16))
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccDomainDestroy(name, testTeam()),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDomainConfig("Not_A_Domain..com", teamIDConfig()),
+				ExpectError: regexp.MustCompile("The domain name is invalid"),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "vercel_domain" "test" {
+  name      = "example.com"
+  auth_code = "abc123"
+  %s
+}
+`, teamIDConfig()),
+				ExpectError: regexp.MustCompile("`expected_price` attribute must be set"),
+			},
+			{
+				Config: testAccDomainConfig(name, teamIDConfig()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccDomainExists("vercel_domain.test", testTeam()),
+					resource.TestCheckResourceAttr("vercel_domain.test", "name", name),
+					resource.TestCheckResourceAttr("vercel_domain.test", "verified", "false"),
+					resource.TestCheckResourceAttr("vercel_domain.test", "service_type", "external"),
+					resource.TestCheckResourceAttrSet("vercel_domain.test", "id"),
+					resource.TestCheckResourceAttrSet("vercel_domain.test", "intended_nameservers.0"),
+				),
+			},
+			{
+				ResourceName:      "vercel_domain.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getDomainImportID("vercel_domain.test"),
+			},
+		},
+	})
+}
+
+func getDomainImportID(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.Attributes["team_id"] == "" {
+			return rs.Primary.Attributes["name"], nil
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["team_id"], rs.Primary.Attributes["name"]), nil
+	}
+}
+
+func testAccDomainConfig(name, teamID string) string {
+	return fmt.Sprintf(`
+resource "vercel_domain" "test" {
+  name = "%s"
+  %s
+}
+`, name, teamID)
+}
@@ -27,7 +27,7 @@ func newEdgeConfigSchemaResource() resource.Resource {
 }
 
 type edgeConfigSchemaResource struct {
-	client *client.Client
+	client client.EdgeConfigAPI
 }
 
 func (r *edgeConfigSchemaResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -40,11 +40,11 @@ func (r *edgeConfigSchemaResource) Configure(ctx context.Context, req resource.C
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(client.EdgeConfigAPI)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected client.EdgeConfigAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
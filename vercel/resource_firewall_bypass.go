@@ -0,0 +1,379 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/client"
+	"github.com/vercel/terraform-provider-vercel/firewall"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &firewallBypassResource{}
+	_ resource.ResourceWithConfigure      = &firewallBypassResource{}
+	_ resource.ResourceWithImportState    = &firewallBypassResource{}
+	_ resource.ResourceWithValidateConfig = &firewallBypassResource{}
+)
+
+func newFirewallBypassResource() resource.Resource {
+	return &firewallBypassResource{}
+}
+
+type firewallBypassResource struct {
+	client client.FirewallAPI
+}
+
+func (r *firewallBypassResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_firewall_bypass"
+}
+
+func (r *firewallBypassResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.FirewallAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.FirewallAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// allFirewallBypassDomains is the domain used for a bypass that applies to every domain of a project.
+const allFirewallBypassDomains = "*"
+
+// Schema returns the schema information for a firewall bypass resource.
+func (r *firewallBypassResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides a system bypass rule within the firewall of a Project.
+
+A system bypass allows requests from an IP address or CIDR range to skip the system mitigations of a project, such as
+managed rulesets and Attack Challenge Mode. This is useful for trusted traffic, such as monitoring services or
+internal tooling, that would otherwise be challenged or blocked.
+
+A bypass is identified by its project, domain and source IP, so changing any of them will create a new bypass.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:   "The ID of the bypass.",
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"project_id": schema.StringAttribute{
+				Description:   "The ID of the project the bypass belongs to.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"team_id": schema.StringAttribute{
+				Description:   "The ID of the team the project exists under. Required when configuring a team resource if a default team has not been set in the provider.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplaceIfConfigured(), stringplanmodifier.UseStateForUnknown()},
+			},
+			"domain": schema.StringAttribute{
+				Description:   "The domain the bypass applies to. If not set, or set to `*`, the bypass applies to every domain of the project.",
+				Optional:      true,
+				Computed:      true,
+				Default:       stringdefault.StaticString(allFirewallBypassDomains),
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"source_ip": schema.StringAttribute{
+				Description:   "The IP address or CIDR range that should bypass the system mitigations, e.g. `203.0.113.7` or `10.0.0.0/8`.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"note": schema.StringAttribute{
+				Description:   "A note describing why the bypass exists.",
+				Optional:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(500),
+				},
+			},
+		},
+	}
+}
+
+// FirewallBypass represents the terraform state for a firewall bypass resource.
+type FirewallBypass struct {
+	ID        types.String `tfsdk:"id"`
+	ProjectID types.String `tfsdk:"project_id"`
+	TeamID    types.String `tfsdk:"team_id"`
+	Domain    types.String `tfsdk:"domain"`
+	SourceIP  types.String `tfsdk:"source_ip"`
+	Note      types.String `tfsdk:"note"`
+}
+
+func (b FirewallBypass) toRequest() client.FirewallBypassRequest {
+	request := client.FirewallBypassRequest{
+		ProjectID: b.ProjectID.ValueString(),
+		TeamID:    b.TeamID.ValueString(),
+		SourceIP:  b.SourceIP.ValueString(),
+		Note:      b.Note.ValueString(),
+	}
+	if b.Domain.ValueString() == allFirewallBypassDomains {
+		request.ProjectScope = true
+	} else {
+		request.Domain = b.Domain.ValueString()
+	}
+	return request
+}
+
+func convertResponseToFirewallBypass(response client.FirewallBypass, ref FirewallBypass) FirewallBypass {
+	result := FirewallBypass{
+		ID:        types.StringValue(response.ID),
+		ProjectID: ref.ProjectID,
+		TeamID:    toTeamID(response.TeamID),
+		Domain:    types.StringValue(response.Domain),
+		SourceIP:  types.StringValue(response.IP),
+		Note:      types.StringValue(response.Note),
+	}
+	if response.IsProjectRule || response.Domain == "" {
+		result.Domain = types.StringValue(allFirewallBypassDomains)
+	}
+	// the note doesn't have to be set
+	if response.Note == "" && ref.Note.IsNull() {
+		result.Note = types.StringNull()
+	}
+	return result
+}
+
+// ValidateConfig validates the source IP address or CIDR range of the bypass offline.
+func (r *firewallBypassResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config FirewallBypass
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if config.SourceIP.IsUnknown() || config.SourceIP.IsNull() {
+		return
+	}
+	if err := firewall.ValidateIP(config.SourceIP.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("source_ip"),
+			"Invalid firewall bypass",
+			err.Error(),
+		)
+	}
+}
+
+// Create will create a new firewall bypass for a project.
+// This is called automatically by the provider when a new resource should be created.
+func (r *firewallBypassResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan FirewallBypass
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := r.client.CreateFirewallBypass(ctx, plan.toRequest())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating firewall bypass",
+			fmt.Sprintf("Could not create firewall bypass for %s on %s for project %s, unexpected error: %s",
+				plan.SourceIP.ValueString(),
+				plan.Domain.ValueString(),
+				plan.ProjectID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	result := convertResponseToFirewallBypass(out, plan)
+	tflog.Info(ctx, "created firewall bypass", map[string]interface{}{
+		"team_id":    result.TeamID.ValueString(),
+		"project_id": result.ProjectID.ValueString(),
+		"bypass_id":  result.ID.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read will read a firewall bypass of a project by requesting it from the Vercel API, and will update terraform
+// with this information.
+func (r *firewallBypassResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state FirewallBypass
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := r.client.GetFirewallBypass(ctx, state.toRequest())
+	if client.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading firewall bypass",
+			fmt.Sprintf("Could not read firewall bypass for %s on %s for project %s, unexpected error: %s",
+				state.SourceIP.ValueString(),
+				state.Domain.ValueString(),
+				state.ProjectID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	result := convertResponseToFirewallBypass(out, state)
+	tflog.Info(ctx, "read firewall bypass", map[string]interface{}{
+		"team_id":    result.TeamID.ValueString(),
+		"project_id": result.ProjectID.ValueString(),
+		"bypass_id":  result.ID.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update does nothing, as every attribute of a firewall bypass requires replacement.
+func (r *firewallBypassResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError(
+		"Updating a Firewall Bypass is not supported",
+		"Updating a Firewall Bypass is not supported",
+	)
+}
+
+// Delete removes a firewall bypass from a project.
+func (r *firewallBypassResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state FirewallBypass
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteFirewallBypass(ctx, state.toRequest())
+	if client.NotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting firewall bypass",
+			fmt.Sprintf("Could not delete firewall bypass for %s on %s for project %s, unexpected error: %s",
+				state.SourceIP.ValueString(),
+				state.Domain.ValueString(),
+				state.ProjectID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "deleted firewall bypass", map[string]interface{}{
+		"team_id":    state.TeamID.ValueString(),
+		"project_id": state.ProjectID.ValueString(),
+		"bypass_id":  state.ID.ValueString(),
+	})
+}
+
+// splitFirewallBypassID splits an import ID of the form `[team_id/]project_id/domain/source_ip`. The source IP may
+// be a CIDR range, whose mask is separated from the address by a `/`, so a trailing mask is joined back onto it.
+func splitFirewallBypassID(id string) (teamID, projectID, domain, sourceIP string, ok bool) {
+	parts := strings.Split(id, "/")
+	if n := len(parts); n > 1 {
+		if _, err := strconv.Atoi(parts[n-1]); err == nil {
+			if _, err := netip.ParseAddr(parts[n-2]); err == nil {
+				parts = append(parts[:n-2], parts[n-2]+"/"+parts[n-1])
+			}
+		}
+	}
+	switch len(parts) {
+	case 3:
+		return "", parts[0], parts[1], parts[2], true
+	case 4:
+		return parts[0], parts[1], parts[2], parts[3], true
+	}
+	return "", "", "", "", false
+}
+
+// ImportState takes an identifier and reads the firewall bypass from the Vercel API.
+// The results are then stored in terraform state.
+func (r *firewallBypassResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	teamID, projectID, domain, sourceIP, ok := splitFirewallBypassID(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Error importing firewall bypass",
+			fmt.Sprintf("Invalid id '%s' specified. should be in format \"team_id/project_id/domain/source_ip\" or \"project_id/domain/source_ip\", using * as the domain for a bypass of every domain", req.ID),
+		)
+		return
+	}
+	if err := firewall.ValidateIP(sourceIP); err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing firewall bypass",
+			fmt.Sprintf("Invalid id '%s' specified: %s", req.ID, err),
+		)
+		return
+	}
+
+	ref := FirewallBypass{
+		ProjectID: types.StringValue(projectID),
+		TeamID:    types.StringValue(teamID),
+		Domain:    types.StringValue(domain),
+		SourceIP:  types.StringValue(sourceIP),
+		Note:      types.StringNull(),
+	}
+	out, err := r.client.GetFirewallBypass(ctx, ref.toRequest())
+	if client.NotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error importing firewall bypass",
+			fmt.Sprintf("Could not find firewall bypass for %s on %s for project %s", sourceIP, domain, projectID),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing firewall bypass",
+			fmt.Sprintf("Could not read firewall bypass for %s on %s for project %s, unexpected error: %s", sourceIP, domain, projectID, err),
+		)
+		return
+	}
+
+	result := convertResponseToFirewallBypass(out, ref)
+	tflog.Info(ctx, "imported firewall bypass", map[string]interface{}{
+		"team_id":    result.TeamID.ValueString(),
+		"project_id": result.ProjectID.ValueString(),
+		"bypass_id":  result.ID.ValueString(),
+	})
+
+	diags := resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,96 @@
+package vercel_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func getFirewallBypassImportID(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return "", fmt.Errorf("no ID is set")
+		}
+
+		id := fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["project_id"], rs.Primary.Attributes["domain"], rs.Primary.Attributes["source_ip"])
+		if rs.Primary.Attributes["team_id"] == "" {
+			return id, nil
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["team_id"], id), nil
+	}
+}
+
+func TestAcc_FirewallBypassResource(t *testing.T) {
+	name := randString(t, 16)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFirewallBypassResource(name, teamIDConfig(), "10.0.0.1/8"),
+				ExpectError: regexp.MustCompile(`did you mean "10.0.0.0/8"`),
+			},
+			{
+				Config: testAccFirewallBypassResource(name, teamIDConfig(), "10.0.0.0/8"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("vercel_firewall_bypass.monitoring", "id"),
+					resource.TestCheckResourceAttr("vercel_firewall_bypass.monitoring", "domain", "*"),
+					resource.TestCheckResourceAttr("vercel_firewall_bypass.monitoring", "source_ip", "10.0.0.0/8"),
+					resource.TestCheckResourceAttr("vercel_firewall_bypass.monitoring", "note", "uptime monitoring"),
+					resource.TestCheckResourceAttr("vercel_firewall_bypass.office", "domain", fmt.Sprintf("test-acc-%s.vercel.app", name)),
+					resource.TestCheckResourceAttr("vercel_firewall_bypass.office", "source_ip", "203.0.113.7"),
+					resource.TestCheckNoResourceAttr("vercel_firewall_bypass.office", "note"),
+				),
+			},
+			{
+				ImportState:       true,
+				ImportStateVerify: true,
+				ResourceName:      "vercel_firewall_bypass.monitoring",
+				ImportStateIdFunc: getFirewallBypassImportID("vercel_firewall_bypass.monitoring"),
+			},
+			{
+				ImportState:       true,
+				ImportStateVerify: true,
+				ResourceName:      "vercel_firewall_bypass.office",
+				ImportStateIdFunc: getFirewallBypassImportID("vercel_firewall_bypass.office"),
+			},
+			{
+				Config: testAccFirewallBypassResource(name, teamIDConfig(), "192.168.0.0/16"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vercel_firewall_bypass.monitoring", "source_ip", "192.168.0.0/16"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFirewallBypassResource(name, teamID, sourceIP string) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "test" {
+  name = "test-acc-%[1]s"
+  %[2]s
+}
+
+resource "vercel_firewall_bypass" "monitoring" {
+  project_id = vercel_project.test.id
+  %[2]s
+  source_ip  = "%[3]s"
+  note       = "uptime monitoring"
+}
+
+resource "vercel_firewall_bypass" "office" {
+  project_id = vercel_project.test.id
+  %[2]s
+  domain     = "test-acc-%[1]s.vercel.app"
+  source_ip  = "203.0.113.7"
+}
+`, name, teamID, sourceIP)
+}
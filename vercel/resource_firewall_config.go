@@ -3,10 +3,14 @@ package vercel
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -17,19 +21,21 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/vercel/terraform-provider-vercel/client"
+	"github.com/vercel/terraform-provider-vercel/firewall"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &firewallConfigResource{}
-	_ resource.ResourceWithConfigure   = &firewallConfigResource{}
-	_ resource.ResourceWithImportState = &firewallConfigResource{}
+	_ resource.Resource                   = &firewallConfigResource{}
+	_ resource.ResourceWithConfigure      = &firewallConfigResource{}
+	_ resource.ResourceWithImportState    = &firewallConfigResource{}
+	_ resource.ResourceWithValidateConfig = &firewallConfigResource{}
 )
 
 func newFirewallConfigResource() resource.Resource { return &firewallConfigResource{} }
 
 type firewallConfigResource struct {
-	client *client.Client
+	client client.FirewallAPI
 }
 
 func (r *firewallConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -172,201 +178,24 @@ Define Custom Rules to shape the way your traffic is handled by the Vercel Edge
 				},
 			},
 			"rules": schema.SingleNestedBlock{
-				Description: "Custom rules to apply to the project",
+				Description: "Custom rules to apply to the project. Rules that are not defined here are deleted, unless `manage_rules` is false.",
 				Blocks: map[string]schema.Block{
 					"rule": schema.ListNestedBlock{
 						Validators: []validator.List{
 							listvalidator.UniqueValues(),
 						},
 						NestedObject: schema.NestedBlockObject{
-							Attributes: map[string]schema.Attribute{
-								"id": schema.StringAttribute{
-									Computed: true,
-								},
-								"name": schema.StringAttribute{
-									Description: "Name to identify the rule",
-									Required:    true,
-									Validators: []validator.String{
-										stringvalidator.LengthBetween(4, 160),
-									},
-								},
-								"description": schema.StringAttribute{
-									Optional: true,
-									Validators: []validator.String{
-										stringvalidator.LengthAtMost(260),
-									},
-								},
-								"active": schema.BoolAttribute{
-									Description: "Rule is active or disabled",
-									Optional:    true,
-								},
-								"action": schema.SingleNestedAttribute{
-									Description: "Actions to take when the condition groups match a request",
-									Required:    true,
-									Attributes: map[string]schema.Attribute{
-										"action": schema.StringAttribute{
-											Description: "Base action",
-											Required:    true,
-											Validators: []validator.String{
-												stringvalidator.OneOf("bypass", "log", "challenge", "deny", "rate_limit", "redirect"),
-											},
-										},
-										"rate_limit": schema.SingleNestedAttribute{
-											Description: "Behavior or a rate limiting action. Required if action is rate_limit",
-											Optional:    true,
-											Attributes: map[string]schema.Attribute{
-												"algo": schema.StringAttribute{
-													Description: "Rate limiting algorithm",
-													Required:    true,
-												},
-												"window": schema.Int64Attribute{
-													Description: "Time window in seconds",
-													Required:    true,
-												},
-												"limit": schema.Int64Attribute{
-													Description: "number of requests allowed in the window",
-													Required:    true,
-												},
-												"keys": schema.ListAttribute{
-													Description: "Keys used to bucket an individual client",
-													Required:    true,
-													ElementType: types.StringType,
-												},
-												"action": schema.StringAttribute{
-													Description: "Action to take when rate limit is exceeded",
-													Required:    true,
-													Validators: []validator.String{
-														stringvalidator.OneOf("bypass", "log", "challenge", "deny", "rate_limit"),
-													},
-												},
-											},
-										},
-										"redirect": schema.SingleNestedAttribute{
-											Description: "How to redirect a request. Required if action is redirect",
-											Optional:    true,
-											Attributes: map[string]schema.Attribute{
-												"location": schema.StringAttribute{
-													Required: true,
-												},
-												"permanent": schema.BoolAttribute{
-													Required: true,
-												},
-											},
-										},
-										"action_duration": schema.StringAttribute{
-											Description: "Forward persistence of a rule aciton",
-											Optional:    true,
-										},
-									},
-								},
-								"condition_group": schema.ListNestedAttribute{
-									Description: "Sets of conditions that may match a request",
-									Required:    true,
-									NestedObject: schema.NestedAttributeObject{
-										Attributes: map[string]schema.Attribute{
-											"conditions": schema.ListNestedAttribute{
-												Description: "Conditions that must all match within a group",
-												Required:    true,
-												NestedObject: schema.NestedAttributeObject{
-													Attributes: map[string]schema.Attribute{
-														"type": schema.StringAttribute{
-															Description: "Request key type to match against",
-															Required:    true,
-															Validators: []validator.String{
-																stringvalidator.OneOf(
-																	"host",
-																	"path",
-																	"method",
-																	"header",
-																	"query",
-																	"cookie",
-																	"target_path",
-																	"ip_address",
-																	"region",
-																	"protocol",
-																	"scheme",
-																	"environment",
-																	"user_agent",
-																	"geo_continent",
-																	"geo_country",
-																	"geo_country_region",
-																	"geo_city",
-																	"geo_as_number",
-																	"ja4_digest",
-																	"ja3_digest",
-																),
-															},
-														},
-														"op": schema.StringAttribute{
-															Description: "How to comparse type to value",
-															Required:    true,
-															Validators: []validator.String{
-																stringvalidator.OneOf(
-																	"re",
-																	"eq",
-																	"neq",
-																	"ex",
-																	"nex",
-																	"inc",
-																	"ninc",
-																	"pre",
-																	"suf",
-																	"sub",
-																	"gt",
-																	"gte",
-																	"lt",
-																	"lte",
-																),
-															},
-														},
-														"neg": schema.BoolAttribute{
-															Optional: true,
-														},
-														"key": schema.StringAttribute{
-															Description: "Key within type to match against",
-															Optional:    true,
-														},
-														"value": schema.StringAttribute{
-															Optional: true,
-														},
-													},
-												},
-											},
-										},
-									},
-								},
-							},
+							Attributes: firewallRuleAttributes(),
 						},
 					},
 				},
 			},
 			"ip_rules": schema.SingleNestedBlock{
-				Description: "IP rules to apply to the project.",
+				Description: "IP rules to apply to the project. IP rules that are not defined here are deleted, unless `manage_ip_rules` is false.",
 				Blocks: map[string]schema.Block{
 					"rule": schema.ListNestedBlock{
 						NestedObject: schema.NestedBlockObject{
-							Attributes: map[string]schema.Attribute{
-								"id": schema.StringAttribute{
-									Computed: true,
-								},
-								"hostname": schema.StringAttribute{
-									Description: "Hosts to apply these rules to",
-									Required:    true,
-								},
-								"notes": schema.StringAttribute{
-									Optional: true,
-								},
-								"ip": schema.StringAttribute{
-									Description: "IP or CIDR to block",
-									Required:    true,
-								},
-								"action": schema.StringAttribute{
-									Required: true,
-									Validators: []validator.String{
-										stringvalidator.OneOf("bypass", "log", "challenge", "deny"),
-									},
-								},
-							},
+							Attributes: firewallIPRuleAttributes(),
 						},
 					},
 				},
@@ -390,6 +219,213 @@ Define Custom Rules to shape the way your traffic is handled by the Vercel Edge
 				Computed:    true,
 				Default:     booldefault.StaticBool(true),
 			},
+			"manage_rules": schema.BoolAttribute{
+				Description: "Whether the custom rules of the project are managed by this resource. Set to false to manage them with `vercel_firewall_rule` instead, in which case `rules` must not be set and the rules of the project are left untouched.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"manage_ip_rules": schema.BoolAttribute{
+				Description: "Whether the IP rules of the project are managed by this resource. Set to false to manage them with `vercel_firewall_ip_rule` instead, in which case `ip_rules` must not be set and the IP rules of the project are left untouched.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+// firewallRuleAttributes returns the schema of a custom firewall rule, which is shared by the rules of a
+// firewall config and the standalone firewall rule resource.
+func firewallRuleAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed: true,
+		},
+		"name": schema.StringAttribute{
+			Description: "Name to identify the rule",
+			Required:    true,
+			Validators: []validator.String{
+				stringvalidator.LengthBetween(4, 160),
+			},
+		},
+		"description": schema.StringAttribute{
+			Optional: true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtMost(260),
+			},
+		},
+		"active": schema.BoolAttribute{
+			Description: "Rule is active or disabled",
+			Optional:    true,
+		},
+		"action": schema.SingleNestedAttribute{
+			Description: "Actions to take when the condition groups match a request",
+			Required:    true,
+			Attributes: map[string]schema.Attribute{
+				"action": schema.StringAttribute{
+					Description: "Base action",
+					Required:    true,
+					Validators: []validator.String{
+						stringvalidator.OneOf("bypass", "log", "challenge", "deny", "rate_limit", "redirect"),
+					},
+				},
+				"rate_limit": schema.SingleNestedAttribute{
+					Description: "Behavior or a rate limiting action. Required if action is rate_limit",
+					Optional:    true,
+					Attributes: map[string]schema.Attribute{
+						"algo": schema.StringAttribute{
+							Description: "Rate limiting algorithm",
+							Required:    true,
+						},
+						"window": schema.Int64Attribute{
+							Description: "Time window in seconds",
+							Required:    true,
+						},
+						"limit": schema.Int64Attribute{
+							Description: "number of requests allowed in the window",
+							Required:    true,
+						},
+						"keys": schema.ListAttribute{
+							Description: "Keys used to bucket an individual client",
+							Required:    true,
+							ElementType: types.StringType,
+						},
+						"action": schema.StringAttribute{
+							Description: "Action to take when rate limit is exceeded",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("bypass", "log", "challenge", "deny", "rate_limit"),
+							},
+						},
+					},
+				},
+				"redirect": schema.SingleNestedAttribute{
+					Description: "How to redirect a request. Required if action is redirect",
+					Optional:    true,
+					Attributes: map[string]schema.Attribute{
+						"location": schema.StringAttribute{
+							Required: true,
+						},
+						"permanent": schema.BoolAttribute{
+							Required: true,
+						},
+					},
+				},
+				"action_duration": schema.StringAttribute{
+					Description: "Forward persistence of a rule aciton",
+					Optional:    true,
+				},
+			},
+		},
+		"condition_group": schema.ListNestedAttribute{
+			Description: "Sets of conditions that may match a request",
+			Required:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"conditions": schema.ListNestedAttribute{
+						Description: "Conditions that must all match within a group",
+						Required:    true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"type": schema.StringAttribute{
+									Description: "Request key type to match against",
+									Required:    true,
+									Validators: []validator.String{
+										stringvalidator.OneOf(
+											"host",
+											"path",
+											"method",
+											"header",
+											"query",
+											"cookie",
+											"target_path",
+											"ip_address",
+											"region",
+											"protocol",
+											"scheme",
+											"environment",
+											"user_agent",
+											"geo_continent",
+											"geo_country",
+											"geo_country_region",
+											"geo_city",
+											"geo_as_number",
+											"ja4_digest",
+											"ja3_digest",
+										),
+									},
+								},
+								"op": schema.StringAttribute{
+									Description: "How to comparse type to value",
+									Required:    true,
+									Validators: []validator.String{
+										stringvalidator.OneOf(
+											"re",
+											"eq",
+											"neq",
+											"ex",
+											"nex",
+											"inc",
+											"ninc",
+											"pre",
+											"suf",
+											"sub",
+											"gt",
+											"gte",
+											"lt",
+											"lte",
+										),
+									},
+								},
+								"neg": schema.BoolAttribute{
+									Optional: true,
+								},
+								"key": schema.StringAttribute{
+									Description: "Key within type to match against",
+									Optional:    true,
+								},
+								"value": schema.StringAttribute{
+									Description: "Value to match against. Not used by the `inc` and `ninc` operators, which use `values`, or by the `ex` and `nex` operators.",
+									Optional:    true,
+								},
+								"values": schema.ListAttribute{
+									Description: "Values to match against, used by the `inc` and `ninc` operators.",
+									Optional:    true,
+									ElementType: types.StringType,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// firewallIPRuleAttributes returns the schema of an IP rule, which is shared by the IP rules of a firewall
+// config and the standalone firewall IP rule resource.
+func firewallIPRuleAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed: true,
+		},
+		"hostname": schema.StringAttribute{
+			Description: "Hosts to apply these rules to",
+			Required:    true,
+		},
+		"notes": schema.StringAttribute{
+			Optional: true,
+		},
+		"ip": schema.StringAttribute{
+			Description: "IP or CIDR to block",
+			Required:    true,
+		},
+		"action": schema.StringAttribute{
+			Required: true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("bypass", "log", "challenge", "deny"),
+			},
 		},
 	}
 }
@@ -400,11 +436,11 @@ func (r *firewallConfigResource) Configure(ctx context.Context, req resource.Con
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(client.FirewallAPI)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Expected *client.Client, got: %T. Please report this issue to the provider developers.",
+			"Expected client.FirewallAPI, got: %T. Please report this issue to the provider developers.",
 		)
 		return
 	}
@@ -418,8 +454,22 @@ type FirewallConfig struct {
 	Enabled         types.Bool               `tfsdk:"enabled"`
 	ManagedRulesets *FirewallManagedRulesets `tfsdk:"managed_rulesets"`
 
-	Rules   *FirewallRules `tfsdk:"rules"`
-	IPRules *IPRules       `tfsdk:"ip_rules"`
+	Rules         *FirewallRules `tfsdk:"rules"`
+	IPRules       *IPRules       `tfsdk:"ip_rules"`
+	ManageRules   types.Bool     `tfsdk:"manage_rules"`
+	ManageIPRules types.Bool     `tfsdk:"manage_ip_rules"`
+}
+
+// managesRules returns whether the custom rules of the project are managed by the firewall config, rather
+// than by vercel_firewall_rule.
+func (f FirewallConfig) managesRules() bool {
+	return f.ManageRules.IsNull() || f.ManageRules.ValueBool()
+}
+
+// managesIPRules returns whether the IP rules of the project are managed by the firewall config, rather
+// than by vercel_firewall_ip_rule.
+func (f FirewallConfig) managesIPRules() bool {
+	return f.ManageIPRules.IsNull() || f.ManageIPRules.ValueBool()
 }
 
 type FirewallManagedRulesets struct {
@@ -477,13 +527,7 @@ func (r *FirewallRule) Conditions() []client.ConditionGroup {
 	for _, group := range r.ConditionGroup {
 		var conditions []client.Condition
 		for _, condition := range group.Conditions {
-			conditions = append(conditions, client.Condition{
-				Type:  condition.Type.ValueString(),
-				Op:    condition.Op.ValueString(),
-				Neg:   condition.Neg.ValueBool(),
-				Key:   condition.Key.ValueString(),
-				Value: condition.Value.ValueString(),
-			})
+			conditions = append(conditions, condition.toClient())
 		}
 		groups = append(groups, client.ConditionGroup{
 			Conditions: conditions,
@@ -651,11 +695,31 @@ type ConditionGroup struct {
 }
 
 type Condition struct {
-	Type  types.String `tfsdk:"type"`
-	Op    types.String `tfsdk:"op"`
-	Neg   types.Bool   `tfsdk:"neg"`
-	Key   types.String `tfsdk:"key"`
-	Value types.String `tfsdk:"value"`
+	Type   types.String `tfsdk:"type"`
+	Op     types.String `tfsdk:"op"`
+	Neg    types.Bool   `tfsdk:"neg"`
+	Key    types.String `tfsdk:"key"`
+	Value  types.String `tfsdk:"value"`
+	Values types.List   `tfsdk:"values"`
+}
+
+func (c Condition) toClient() client.Condition {
+	condition := client.Condition{
+		Type:  c.Type.ValueString(),
+		Op:    c.Op.ValueString(),
+		Neg:   c.Neg.ValueBool(),
+		Key:   c.Key.ValueString(),
+		Value: c.Value.ValueString(),
+	}
+	if !c.Values.IsNull() && !c.Values.IsUnknown() {
+		condition.Values = []string{}
+		for _, v := range c.Values.Elements() {
+			if s, ok := v.(types.String); ok {
+				condition.Values = append(condition.Values, s.ValueString())
+			}
+		}
+	}
+	return condition
 }
 
 func fromCondition(condition client.Condition, ref Condition) Condition {
@@ -666,6 +730,18 @@ func fromCondition(condition client.Condition, ref Condition) Condition {
 		Key:   types.StringValue(condition.Key),
 		Neg:   types.BoolValue(condition.Neg),
 	}
+	c.Values = types.ListNull(types.StringType)
+	if condition.Values != nil {
+		values := make([]attr.Value, len(condition.Values))
+		for i, v := range condition.Values {
+			values[i] = types.StringValue(v)
+		}
+		c.Values = types.ListValueMust(types.StringType, values)
+		// values replaces value for list operators
+		if ref.Value.IsNull() {
+			c.Value = types.StringNull()
+		}
+	}
 	// Neg and Key are optional
 	if ref.Neg == types.BoolNull() {
 		c.Neg = types.BoolNull()
@@ -693,6 +769,82 @@ type IPRule struct {
 	Action   types.String `tfsdk:"action"`
 }
 
+// validateFirewallRule validates the conditions and action of a rule offline, reporting errors against the
+// attribute at fault relative to the given path of the rule. Values that are not yet known are skipped.
+func validateFirewallRule(ctx context.Context, p path.Path, rule FirewallRule) (diags diag.Diagnostics) {
+	for j, group := range rule.ConditionGroup {
+		for k, condition := range group.Conditions {
+			cp := p.AtName("condition_group").AtListIndex(j).AtName("conditions").AtListIndex(k)
+			if condition.Type.IsUnknown() || condition.Op.IsUnknown() || condition.Key.IsUnknown() ||
+				condition.Value.IsUnknown() || condition.Values.IsUnknown() || firewall.Operators(condition.Type.ValueString()) == nil {
+				continue
+			}
+			c := condition.toClient()
+			for _, err := range firewall.ValidateCondition(c) {
+				diags.AddAttributeError(
+					firewallFieldPath(cp, err.Field),
+					"Invalid firewall condition",
+					fmt.Sprintf("The %s condition of rule %q is invalid: %s", c.Type, rule.Name.ValueString(), err.Message),
+				)
+			}
+		}
+	}
+
+	if rule.Action.Action.IsUnknown() {
+		return diags
+	}
+	mit := client.Mitigate{
+		Action: rule.Action.Action.ValueString(),
+	}
+	if !rule.Action.RateLimit.IsNull() {
+		mit.RateLimit = &client.RateLimit{}
+		if d := rule.Action.RateLimit.As(ctx, mit.RateLimit, basetypes.ObjectAsOptions{}); d.HasError() {
+			// The rate limit contains unknown values, so cannot be validated yet.
+			return diags
+		}
+	}
+	if !rule.Action.Redirect.IsNull() {
+		mit.Redirect = &client.Redirect{}
+		if d := rule.Action.Redirect.As(ctx, mit.Redirect, basetypes.ObjectAsOptions{}); d.HasError() {
+			return diags
+		}
+	}
+	for _, err := range firewall.ValidateAction(mit) {
+		diags.AddAttributeError(
+			firewallFieldPath(p.AtName("action"), err.Field),
+			"Invalid firewall action",
+			fmt.Sprintf("The action of rule %q is invalid: %s", rule.Name.ValueString(), err.Message),
+		)
+	}
+	return diags
+}
+
+// validateFirewallIPRule validates the IP address or CIDR range of an IP rule.
+func validateFirewallIPRule(p path.Path, rule IPRule) (diags diag.Diagnostics) {
+	if rule.IP.IsUnknown() || rule.IP.IsNull() {
+		return diags
+	}
+	if err := firewall.ValidateIP(rule.IP.ValueString()); err != nil {
+		diags.AddAttributeError(p.AtName("ip"), "Invalid firewall IP rule", "The IP rule is invalid: "+err.Error())
+	}
+	return diags
+}
+
+// firewallFieldPath converts a field reported by the firewall package, such as `values[1]` or
+// `rate_limit.window`, into a path relative to p.
+func firewallFieldPath(p path.Path, field string) path.Path {
+	for _, segment := range strings.Split(field, ".") {
+		name, index, ok := strings.Cut(segment, "[")
+		p = p.AtName(name)
+		if ok {
+			if i, err := strconv.Atoi(strings.TrimSuffix(index, "]")); err == nil {
+				p = p.AtListIndex(i)
+			}
+		}
+	}
+	return p
+}
+
 func fromCRS(conf map[string]client.CoreRuleSet, refMr *FirewallManagedRulesets) *CRSRule {
 	var ref = &CRSRule{}
 	if refMr != nil && refMr.OWASP != nil {
@@ -735,15 +887,19 @@ func fromClient(conf client.FirewallConfig, state FirewallConfig) (FirewallConfi
 	cfg := FirewallConfig{
 		ProjectID: state.ProjectID,
 		// Take the teamID from the response/provider if it wasn't provided in resource
-		TeamID:  types.StringValue(conf.TeamID),
-		Enabled: state.Enabled,
+		TeamID:        types.StringValue(conf.TeamID),
+		Enabled:       state.Enabled,
+		ManageRules:   state.ManageRules,
+		ManageIPRules: state.ManageIPRules,
 	}
 	// Enabled can be null
 	if conf.Enabled && state.Enabled.IsNull() {
 		cfg.Enabled = state.Enabled
 	}
 
-	if len(conf.Rules) > 0 {
+	// Rules and IP rules are only read back if they are managed by this resource, as they are otherwise
+	// managed by vercel_firewall_rule and vercel_firewall_ip_rule.
+	if state.managesRules() && len(conf.Rules) > 0 {
 		rules := make([]FirewallRule, len(conf.Rules))
 		for i, rule := range conf.Rules {
 			// Set empty optional types
@@ -761,7 +917,7 @@ func fromClient(conf client.FirewallConfig, state FirewallConfig) (FirewallConfi
 		cfg.Rules = &FirewallRules{Rules: rules}
 	}
 
-	if len(conf.IPRules) > 0 {
+	if state.managesIPRules() && len(conf.IPRules) > 0 {
 		ipRules := make([]IPRule, len(conf.IPRules))
 		for i, iprule := range conf.IPRules {
 			ipRules[i] = IPRule{
@@ -846,6 +1002,84 @@ func (f *FirewallConfig) toClient() (client.FirewallConfig, error) {
 	return conf, nil
 }
 
+// withUnmanagedRules copies the rules and IP rules that are not managed by a firewall config, because
+// `manage_rules` or `manage_ip_rules` is false, from the current config of the project, as a PUT replaces
+// every rule of the project.
+func (r *firewallConfigResource) withUnmanagedRules(ctx context.Context, managed FirewallConfig, conf client.FirewallConfig) (client.FirewallConfig, error) {
+	if managed.managesRules() && managed.managesIPRules() {
+		return conf, nil
+	}
+	current, err := r.client.GetFirewallConfig(ctx, conf.ProjectID, conf.TeamID)
+	if err != nil {
+		return conf, err
+	}
+	if !managed.managesRules() {
+		conf.Rules = current.Rules
+	}
+	if !managed.managesIPRules() {
+		conf.IPRules = current.IPRules
+	}
+	return conf, nil
+}
+
+// ValidateConfig validates the rules of a firewall config offline, so that conditions and actions the API
+// would reject are reported at plan time.
+func (r *firewallConfigResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	for _, block := range []struct{ block, manage string }{{"rules", "manage_rules"}, {"ip_rules", "manage_ip_rules"}} {
+		var manage types.Bool
+		var rules types.Object
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(block.manage), &manage)...)
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(block.block), &rules)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if manage.Equal(types.BoolValue(false)) && !rules.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(block.block),
+				"Invalid Firewall Config",
+				fmt.Sprintf("`%s` cannot be set when `%s` is false, as the firewall config does not manage them.", block.block, block.manage),
+			)
+		}
+	}
+
+	var rules types.List
+	diags := req.Config.GetAttribute(ctx, path.Root("rules").AtName("rule"), &rules)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for i, element := range rules.Elements() {
+		obj, ok := element.(types.Object)
+		if !ok || obj.IsUnknown() {
+			continue
+		}
+		var rule FirewallRule
+		if diags := obj.As(ctx, &rule, basetypes.ObjectAsOptions{}); diags.HasError() {
+			// The rule contains unknown values, so cannot be validated yet.
+			continue
+		}
+		resp.Diagnostics.Append(validateFirewallRule(ctx, path.Root("rules").AtName("rule").AtListIndex(i), rule)...)
+	}
+
+	var ipRules types.List
+	diags = req.Config.GetAttribute(ctx, path.Root("ip_rules").AtName("rule"), &ipRules)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for i, element := range ipRules.Elements() {
+		obj, ok := element.(types.Object)
+		if !ok || obj.IsUnknown() {
+			continue
+		}
+		var rule IPRule
+		if diags := obj.As(ctx, &rule, basetypes.ObjectAsOptions{}); diags.HasError() {
+			continue
+		}
+		resp.Diagnostics.Append(validateFirewallIPRule(path.Root("ip_rules").AtName("rule").AtListIndex(i), rule)...)
+	}
+}
+
 func (r *firewallConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 
 	var plan FirewallConfig
@@ -854,11 +1088,23 @@ func (r *firewallConfigResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	unlock, err := lockFirewall(ctx, plan.ProjectID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create firewall config", err.Error())
+		return
+	}
+	defer unlock()
+
 	conf, err := plan.toClient()
 	if err != nil {
 		diags.AddError("failed to convert plan to client", err.Error())
 		return
 	}
+	conf, err = r.withUnmanagedRules(ctx, plan, conf)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to read firewall config", err.Error())
+		return
+	}
 
 	out, err := r.client.PutFirewallConfig(ctx, conf)
 	if err != nil {
@@ -912,11 +1158,23 @@ func (r *firewallConfigResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	unlock, err := lockFirewall(ctx, plan.ProjectID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to update firewall config", err.Error())
+		return
+	}
+	defer unlock()
+
 	conf, err := plan.toClient()
 	if err != nil {
 		diags.AddError("failed to convert plan to client", err.Error())
 		return
 	}
+	conf, err = r.withUnmanagedRules(ctx, plan, conf)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to read firewall config", err.Error())
+		return
+	}
 
 	out, err := r.client.PutFirewallConfig(ctx, conf)
 	if err != nil {
@@ -944,13 +1202,24 @@ func (r *firewallConfigResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
-	conf := client.FirewallConfig{
+	unlock, err := lockFirewall(ctx, state.ProjectID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to delete firewall config", err.Error())
+		return
+	}
+	defer unlock()
+
+	conf, err := r.withUnmanagedRules(ctx, state, client.FirewallConfig{
 		Enabled:   false,
 		ProjectID: state.ProjectID.ValueString(),
 		TeamID:    state.TeamID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to delete firewall config", err.Error())
+		return
 	}
 
-	_, err := r.client.PutFirewallConfig(ctx, conf)
+	_, err = r.client.PutFirewallConfig(ctx, conf)
 	if err != nil {
 		resp.Diagnostics.AddError("failed to delete firewall config", err.Error())
 		return
@@ -975,8 +1244,10 @@ func (r *firewallConfigResource) ImportState(ctx context.Context, req resource.I
 		return
 	}
 	conf, err := fromClient(out, FirewallConfig{
-		ProjectID: types.StringValue(projectID),
-		TeamID:    types.StringValue(out.TeamID), // use output teamID if not provided on import
+		ProjectID:     types.StringValue(projectID),
+		TeamID:        types.StringValue(out.TeamID), // use output teamID if not provided on import
+		ManageRules:   types.BoolValue(true),
+		ManageIPRules: types.BoolValue(true),
 	})
 	if err != nil {
 		resp.Diagnostics.AddError("failed to read firewall config", err.Error())
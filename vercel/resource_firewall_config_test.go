@@ -2,9 +2,9 @@ package vercel_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
@@ -28,7 +28,7 @@ func getFirewallImportID(n string) resource.ImportStateIdFunc {
 }
 
 func TestAcc_FirewallConfigResource(t *testing.T) {
-	name := acctest.RandString(16)
+	name := randString(t, 16)
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -541,3 +541,79 @@ resource "vercel_firewall_config" "ips" {
     }
 }`, name, teamID)
 }
+
+func TestAcc_FirewallConfigInvalidRules(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFirewallConfigInvalidCondition(`type = "header", op = "eq", value = "Bearer token"`),
+				ExpectError: regexp.MustCompile(`a key is required for type "header"`),
+			},
+			{
+				Config:      testAccFirewallConfigInvalidCondition(`type = "ip_address", op = "eq", value = "10.0.0"`),
+				ExpectError: regexp.MustCompile(`not a valid IP address or CIDR range`),
+			},
+			{
+				Config:      testAccFirewallConfigInvalidCondition(`type = "path", op = "re", value = "^/api/("`),
+				ExpectError: regexp.MustCompile(`not a valid regular expression`),
+			},
+			{
+				Config:      testAccFirewallConfigInvalidCondition(`type = "geo_country", op = "inc", value = "US"`),
+				ExpectError: regexp.MustCompile("`values` must be set instead of `value`"),
+			},
+			{
+				Config:      testAccFirewallConfigInvalidCondition(`type = "path", op = "gt", value = "/api"`),
+				ExpectError: regexp.MustCompile(`operator "gt" cannot be used with type "path"`),
+			},
+			{
+				Config: `
+resource "vercel_firewall_config" "invalid" {
+  project_id = "prj_123"
+
+  rules {
+    rule {
+      name = "rate limit"
+      condition_group = [{
+        conditions = [{ type = "path", op = "pre", value = "/api" }]
+      }]
+      action = {
+        action = "rate_limit"
+        rate_limit = {
+          algo   = "fixed_window"
+          window = 5
+          limit  = 100
+          keys   = ["ip"]
+          action = "deny"
+        }
+      }
+    }
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`the window must be between 10 and 3600 seconds`),
+			},
+		},
+	})
+}
+
+func testAccFirewallConfigInvalidCondition(condition string) string {
+	return fmt.Sprintf(`
+resource "vercel_firewall_config" "invalid" {
+  project_id = "prj_123"
+
+  rules {
+    rule {
+      name = "invalid"
+      condition_group = [{
+        conditions = [{ %s }]
+      }]
+      action = {
+        action = "deny"
+      }
+    }
+  }
+}
+`, condition)
+}
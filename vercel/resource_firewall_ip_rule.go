@@ -0,0 +1,384 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &firewallIPRuleResource{}
+	_ resource.ResourceWithConfigure      = &firewallIPRuleResource{}
+	_ resource.ResourceWithImportState    = &firewallIPRuleResource{}
+	_ resource.ResourceWithValidateConfig = &firewallIPRuleResource{}
+)
+
+func newFirewallIPRuleResource() resource.Resource {
+	return &firewallIPRuleResource{}
+}
+
+type firewallIPRuleResource struct {
+	client client.FirewallAPI
+}
+
+func (r *firewallIPRuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_firewall_ip_rule"
+}
+
+func (r *firewallIPRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.FirewallAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.FirewallAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Schema returns the schema information for a firewall IP rule resource.
+func (r *firewallIPRuleResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides a single IP rule within the firewall of a Project.
+
+IP rules allow or block traffic from an IP address or CIDR range to a hostname, and are evaluated before custom rules.
+Unlike ` + "`vercel_firewall_config`" + `, which owns every IP rule of a project, this resource only manages a single rule.
+IP rules that are not managed by this resource are left untouched.
+
+~> A ` + "`vercel_firewall_config`" + ` resource replaces every IP rule of a project when it is applied. To use this resource
+alongside one, set ` + "`manage_ip_rules = false`" + ` on the firewall config.`,
+		Attributes: firewallRuleStandaloneAttributes(firewallIPRuleAttributes()),
+	}
+}
+
+// ProjectFirewallIPRule represents the terraform state for a firewall IP rule resource.
+type ProjectFirewallIPRule struct {
+	ID        types.String `tfsdk:"id"`
+	ProjectID types.String `tfsdk:"project_id"`
+	TeamID    types.String `tfsdk:"team_id"`
+	Priority  types.Int64  `tfsdk:"priority"`
+	Hostname  types.String `tfsdk:"hostname"`
+	IP        types.String `tfsdk:"ip"`
+	Notes     types.String `tfsdk:"notes"`
+	Action    types.String `tfsdk:"action"`
+}
+
+func (r ProjectFirewallIPRule) toClient() client.IPRule {
+	return client.IPRule{
+		ID:       r.ID.ValueString(),
+		Hostname: r.Hostname.ValueString(),
+		IP:       r.IP.ValueString(),
+		Notes:    r.Notes.ValueString(),
+		Action:   r.Action.ValueString(),
+	}
+}
+
+func convertResponseToProjectFirewallIPRule(cfg client.FirewallConfig, id string, ref ProjectFirewallIPRule) (ProjectFirewallIPRule, bool) {
+	position := slices.IndexFunc(cfg.IPRules, func(r client.IPRule) bool { return r.ID == id })
+	if position < 0 {
+		return ProjectFirewallIPRule{}, false
+	}
+	rule := cfg.IPRules[position]
+	result := ProjectFirewallIPRule{
+		ID:        types.StringValue(rule.ID),
+		ProjectID: ref.ProjectID,
+		TeamID:    toTeamID(cfg.TeamID),
+		Priority:  firewallRulePriority(position, len(cfg.IPRules), ref.Priority),
+		Hostname:  types.StringValue(rule.Hostname),
+		IP:        types.StringValue(rule.IP),
+		Notes:     types.StringValue(rule.Notes),
+		Action:    types.StringValue(rule.Action),
+	}
+	// notes don't have to be set
+	if rule.Notes == "" && ref.Notes.IsNull() {
+		result.Notes = types.StringNull()
+	}
+	return result, true
+}
+
+// ValidateConfig validates the IP address or CIDR range of the rule offline.
+func (r *firewallIPRuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ProjectFirewallIPRule
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(validateFirewallIPRule(path.Empty(), IPRule{IP: config.IP})...)
+}
+
+// Create will create a new IP rule within the firewall config of a project.
+// This is called automatically by the provider when a new resource should be created.
+func (r *firewallIPRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ProjectFirewallIPRule
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	unlock, err := lockFirewall(ctx, plan.ProjectID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating firewall IP rule", err.Error())
+		return
+	}
+	defer unlock()
+
+	rule := plan.toClient()
+	id, err := insertFirewallRule(ctx, r.client, "ip", plan.ProjectID.ValueString(), plan.TeamID.ValueString(), rule, func(cfg client.FirewallConfig, i int) bool {
+		return cfg.IPRules[i].Hostname == rule.Hostname && cfg.IPRules[i].IP == rule.IP
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating firewall IP rule",
+			fmt.Sprintf("Could not create firewall IP rule for %s on %s for project %s, unexpected error: %s",
+				plan.IP.ValueString(),
+				plan.Hostname.ValueString(),
+				plan.ProjectID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	cfg, err := placeFirewallRule(ctx, r.client, "ip", plan.ProjectID.ValueString(), plan.TeamID.ValueString(), id, plan.Priority)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating firewall IP rule",
+			fmt.Sprintf("Could not set the priority of firewall IP rule %s for project %s, unexpected error: %s",
+				id,
+				plan.ProjectID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	result, _ := convertResponseToProjectFirewallIPRule(cfg, id, plan)
+	tflog.Info(ctx, "created firewall IP rule", map[string]interface{}{
+		"team_id":    result.TeamID.ValueString(),
+		"project_id": result.ProjectID.ValueString(),
+		"ip_rule_id": result.ID.ValueString(),
+		"priority":   result.Priority.ValueInt64(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read will read a firewall IP rule of a project by requesting the firewall config from the Vercel API, and will
+// update terraform with this information.
+func (r *firewallIPRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ProjectFirewallIPRule
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cfg, err := r.client.GetFirewallConfig(ctx, state.ProjectID.ValueString(), state.TeamID.ValueString())
+	if client.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading firewall IP rule",
+			fmt.Sprintf("Could not read firewall IP rule %s for project %s, unexpected error: %s",
+				state.ID.ValueString(),
+				state.ProjectID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	result, ok := convertResponseToProjectFirewallIPRule(cfg, state.ID.ValueString(), state)
+	if !ok {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	tflog.Info(ctx, "read firewall IP rule", map[string]interface{}{
+		"team_id":    result.TeamID.ValueString(),
+		"project_id": result.ProjectID.ValueString(),
+		"ip_rule_id": result.ID.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update will update a firewall IP rule, and move it if its priority has changed.
+func (r *firewallIPRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state ProjectFirewallIPRule
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	unlock, err := lockFirewall(ctx, plan.ProjectID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating firewall IP rule", err.Error())
+		return
+	}
+	defer unlock()
+
+	plan.ID = state.ID
+	err = r.client.PatchFirewallConfig(ctx, client.PatchFirewallConfigRequest{
+		ProjectID: plan.ProjectID.ValueString(),
+		TeamID:    plan.TeamID.ValueString(),
+		Action:    "ip.update",
+		ID:        state.ID.ValueString(),
+		Value:     plan.toClient(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating firewall IP rule",
+			fmt.Sprintf("Could not update firewall IP rule %s for project %s, unexpected error: %s",
+				state.ID.ValueString(),
+				plan.ProjectID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	cfg, err := placeFirewallRule(ctx, r.client, "ip", plan.ProjectID.ValueString(), plan.TeamID.ValueString(), state.ID.ValueString(), plan.Priority)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating firewall IP rule",
+			fmt.Sprintf("Could not set the priority of firewall IP rule %s for project %s, unexpected error: %s",
+				state.ID.ValueString(),
+				plan.ProjectID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	result, _ := convertResponseToProjectFirewallIPRule(cfg, state.ID.ValueString(), plan)
+	tflog.Info(ctx, "updated firewall IP rule", map[string]interface{}{
+		"team_id":    result.TeamID.ValueString(),
+		"project_id": result.ProjectID.ValueString(),
+		"ip_rule_id": result.ID.ValueString(),
+		"priority":   result.Priority.ValueInt64(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete removes a firewall IP rule from the firewall config of a project, leaving any other rules in place.
+func (r *firewallIPRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ProjectFirewallIPRule
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	unlock, err := lockFirewall(ctx, state.ProjectID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting firewall IP rule", err.Error())
+		return
+	}
+	defer unlock()
+
+	err = r.client.PatchFirewallConfig(ctx, client.PatchFirewallConfigRequest{
+		ProjectID: state.ProjectID.ValueString(),
+		TeamID:    state.TeamID.ValueString(),
+		Action:    "ip.remove",
+		ID:        state.ID.ValueString(),
+	})
+	if client.NotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting firewall IP rule",
+			fmt.Sprintf("Could not delete firewall IP rule %s for project %s, unexpected error: %s",
+				state.ID.ValueString(),
+				state.ProjectID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "deleted firewall IP rule", map[string]interface{}{
+		"team_id":    state.TeamID.ValueString(),
+		"project_id": state.ProjectID.ValueString(),
+		"ip_rule_id": state.ID.ValueString(),
+	})
+}
+
+// ImportState takes an identifier and reads all the firewall IP rule information from the Vercel API.
+// The results are then stored in terraform state.
+func (r *firewallIPRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	teamID, projectID, ruleID, ok := splitInto2Or3(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Error importing firewall IP rule",
+			fmt.Sprintf("Invalid id '%s' specified. should be in format \"team_id/project_id/rule_id\" or \"project_id/rule_id\"", req.ID),
+		)
+		return
+	}
+
+	cfg, err := r.client.GetFirewallConfig(ctx, projectID, teamID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing firewall IP rule",
+			fmt.Sprintf("Could not read firewall IP rule %s for project %s, unexpected error: %s", ruleID, projectID, err),
+		)
+		return
+	}
+	result, ok := convertResponseToProjectFirewallIPRule(cfg, ruleID, ProjectFirewallIPRule{
+		ProjectID: types.StringValue(projectID),
+	})
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Error importing firewall IP rule",
+			fmt.Sprintf("Could not find firewall IP rule %s for project %s", ruleID, projectID),
+		)
+		return
+	}
+	tflog.Info(ctx, "imported firewall IP rule", map[string]interface{}{
+		"team_id":    result.TeamID.ValueString(),
+		"project_id": result.ProjectID.ValueString(),
+		"ip_rule_id": result.ID.ValueString(),
+	})
+
+	diags := resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,72 @@
+package vercel_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_FirewallIPRuleResource(t *testing.T) {
+	name := randString(t, 16)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallIPRuleResource(name, teamIDConfig(), "deny"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("vercel_firewall_ip_rule.office", "id"),
+					resource.TestCheckResourceAttr("vercel_firewall_ip_rule.office", "priority", "0"),
+					resource.TestCheckResourceAttr("vercel_firewall_ip_rule.office", "ip", "10.0.0.0/8"),
+					resource.TestCheckResourceAttr("vercel_firewall_ip_rule.office", "action", "bypass"),
+					resource.TestCheckResourceAttr("vercel_firewall_ip_rule.blocked", "priority", "1"),
+					resource.TestCheckResourceAttr("vercel_firewall_ip_rule.blocked", "action", "deny"),
+					resource.TestCheckNoResourceAttr("vercel_firewall_ip_rule.blocked", "notes"),
+				),
+			},
+			{
+				ImportState:       true,
+				ImportStateVerify: true,
+				ResourceName:      "vercel_firewall_ip_rule.office",
+				ImportStateIdFunc: getFirewallRuleImportID("vercel_firewall_ip_rule.office"),
+			},
+			{
+				Config: testAccFirewallIPRuleResource(name, teamIDConfig(), "challenge"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vercel_firewall_ip_rule.office", "priority", "0"),
+					resource.TestCheckResourceAttr("vercel_firewall_ip_rule.blocked", "action", "challenge"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFirewallIPRuleResource(name, teamID, action string) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "test" {
+  name = "test-acc-%[1]s"
+  %[2]s
+}
+
+resource "vercel_firewall_ip_rule" "blocked" {
+  project_id = vercel_project.test.id
+  %[2]s
+  hostname   = "*"
+  ip         = "1.2.3.4"
+  action     = "%[3]s"
+
+  depends_on = [vercel_firewall_ip_rule.office]
+}
+
+resource "vercel_firewall_ip_rule" "office" {
+  project_id = vercel_project.test.id
+  %[2]s
+  priority   = 0
+  hostname   = "*"
+  ip         = "10.0.0.0/8"
+  notes      = "office network"
+  action     = "bypass"
+}
+`, name, teamID, action)
+}
@@ -0,0 +1,584 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &firewallRuleResource{}
+	_ resource.ResourceWithConfigure      = &firewallRuleResource{}
+	_ resource.ResourceWithImportState    = &firewallRuleResource{}
+	_ resource.ResourceWithValidateConfig = &firewallRuleResource{}
+)
+
+func newFirewallRuleResource() resource.Resource {
+	return &firewallRuleResource{}
+}
+
+type firewallRuleResource struct {
+	client client.FirewallAPI
+}
+
+func (r *firewallRuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_firewall_rule"
+}
+
+func (r *firewallRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.FirewallAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.FirewallAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// firewallRuleStandaloneAttributes adds the attributes shared by the standalone firewall rule resources to the
+// attributes of a rule.
+func firewallRuleStandaloneAttributes(attributes map[string]schema.Attribute) map[string]schema.Attribute {
+	attributes["id"] = schema.StringAttribute{
+		Description:   "The ID of the rule.",
+		Computed:      true,
+		PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+	}
+	attributes["project_id"] = schema.StringAttribute{
+		Description:   "The ID of the project the rule belongs to.",
+		Required:      true,
+		PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+	}
+	attributes["team_id"] = schema.StringAttribute{
+		Description:   "The ID of the team the project exists under. Required when configuring a team resource if a default team has not been set in the provider.",
+		Optional:      true,
+		Computed:      true,
+		PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplaceIfConfigured(), stringplanmodifier.UseStateForUnknown()},
+	}
+	attributes["priority"] = schema.Int64Attribute{
+		Description: "The zero-based position of the rule, with lower values being evaluated first. If another rule is moved into this position, the rule is moved back on the next apply. A priority past the end of the list places the rule last. If not set, the rule is added to the end of the list. Each rule of a project must be given a different priority, including priorities past the end of the list, as rules with the same priority move each other on every apply.",
+		Optional:    true,
+		Computed:    true,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(0),
+		},
+		PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+	}
+	return attributes
+}
+
+// Schema returns the schema information for a firewall rule resource.
+func (r *firewallRuleResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides a single custom rule within the firewall of a Project.
+
+Unlike ` + "`vercel_firewall_config`" + `, which owns every rule of a project, this resource only manages a single rule. This
+allows rules to be managed separately, for example by different teams or Terraform configurations. Rules that are not
+managed by this resource are left untouched.
+
+~> A ` + "`vercel_firewall_config`" + ` resource replaces every rule of a project when it is applied. To use this resource
+alongside one, set ` + "`manage_rules = false`" + ` on the firewall config.`,
+		Attributes: firewallRuleStandaloneAttributes(firewallRuleAttributes()),
+	}
+}
+
+// ProjectFirewallRule represents the terraform state for a firewall rule resource.
+type ProjectFirewallRule struct {
+	ID             types.String     `tfsdk:"id"`
+	ProjectID      types.String     `tfsdk:"project_id"`
+	TeamID         types.String     `tfsdk:"team_id"`
+	Priority       types.Int64      `tfsdk:"priority"`
+	Name           types.String     `tfsdk:"name"`
+	Description    types.String     `tfsdk:"description"`
+	Active         types.Bool       `tfsdk:"active"`
+	ConditionGroup []ConditionGroup `tfsdk:"condition_group"`
+	Action         Mitigate         `tfsdk:"action"`
+}
+
+func (r ProjectFirewallRule) rule() FirewallRule {
+	return FirewallRule{
+		ID:             r.ID,
+		Name:           r.Name,
+		Description:    r.Description,
+		Active:         r.Active,
+		ConditionGroup: r.ConditionGroup,
+		Action:         r.Action,
+	}
+}
+
+func (r ProjectFirewallRule) toClient() (client.FirewallRule, error) {
+	rule := r.rule()
+	mit, err := rule.Mitigate()
+	if err != nil {
+		return client.FirewallRule{}, err
+	}
+	return client.FirewallRule{
+		ID:             r.ID.ValueString(),
+		Name:           r.Name.ValueString(),
+		Description:    r.Description.ValueString(),
+		Active:         r.Active.IsNull() || r.Active.ValueBool(),
+		ConditionGroup: rule.Conditions(),
+		Action: client.Action{
+			Mitigate: mit,
+		},
+	}, nil
+}
+
+func convertResponseToProjectFirewallRule(cfg client.FirewallConfig, id string, ref ProjectFirewallRule) (ProjectFirewallRule, bool, error) {
+	position := slices.IndexFunc(cfg.Rules, func(r client.FirewallRule) bool { return r.ID == id })
+	if position < 0 {
+		return ProjectFirewallRule{}, false, nil
+	}
+	rule, err := fromFirewallRule(cfg.Rules[position], ref.rule())
+	if err != nil {
+		return ProjectFirewallRule{}, true, err
+	}
+	return ProjectFirewallRule{
+		ID:             rule.ID,
+		ProjectID:      ref.ProjectID,
+		TeamID:         toTeamID(cfg.TeamID),
+		Priority:       firewallRulePriority(position, len(cfg.Rules), ref.Priority),
+		Name:           rule.Name,
+		Description:    rule.Description,
+		Active:         rule.Active,
+		ConditionGroup: rule.ConditionGroup,
+		Action:         rule.Action,
+	}, true, nil
+}
+
+// firewallRulePriority converts the position of a rule into its priority. A priority past the end of the list
+// places a rule last, so it is kept as long as the rule is still last.
+func firewallRulePriority(position, count int, ref types.Int64) types.Int64 {
+	if !ref.IsNull() && !ref.IsUnknown() && ref.ValueInt64() > int64(position) && position == count-1 {
+		return ref
+	}
+	return types.Int64Value(int64(position))
+}
+
+func firewallRuleIDs(cfg client.FirewallConfig, kind string) []string {
+	var ids []string
+	if kind == "ip" {
+		for _, r := range cfg.IPRules {
+			ids = append(ids, r.ID)
+		}
+		return ids
+	}
+	for _, r := range cfg.Rules {
+		ids = append(ids, r.ID)
+	}
+	return ids
+}
+
+// firewallLocks serializes changes made to the firewall config of a project by vercel_firewall_config,
+// vercel_firewall_rule and vercel_firewall_ip_rule. Each change reads the firewall config before changing it, and
+// reads it again afterwards to find the rule that was changed, so changes made to the same project in parallel
+// could otherwise overwrite or pick up each other's rules. This applies whether or not
+// `serialize_project_writes` is set, and locks are keyed by the project ID as configured.
+var firewallLocks = struct {
+	mu    sync.Mutex
+	locks map[string]chan struct{}
+}{locks: map[string]chan struct{}{}}
+
+// lockFirewall acquires the firewall lock for a project, and returns a function to release it. If the context is
+// cancelled while waiting for the lock, the context's error is returned instead.
+func lockFirewall(ctx context.Context, projectID string) (func(), error) {
+	firewallLocks.mu.Lock()
+	// Each lock is a channel with a single slot, which is held while the slot is filled.
+	m, ok := firewallLocks.locks[projectID]
+	if !ok {
+		m = make(chan struct{}, 1)
+		firewallLocks.locks[projectID] = m
+	}
+	firewallLocks.mu.Unlock()
+
+	select {
+	case m <- struct{}{}:
+		return func() { <-m }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// insertFirewallRule adds a rule to the end of the custom rules (`rules`) or IP rules (`ip`) of a project, and
+// returns the ID it was given. The patch API does not return the new rule, so it is found by comparing the rules
+// before and after the insert. The caller must hold the firewall lock for the project.
+func insertFirewallRule(ctx context.Context, c client.FirewallAPI, kind, projectID, teamID string, value interface{}, matches func(cfg client.FirewallConfig, i int) bool) (string, error) {
+	before, err := c.GetFirewallConfig(ctx, projectID, teamID)
+	if err != nil && !client.NotFound(err) {
+		return "", err
+	}
+	existing := firewallRuleIDs(before, kind)
+
+	err = c.PatchFirewallConfig(ctx, client.PatchFirewallConfigRequest{
+		ProjectID: projectID,
+		TeamID:    teamID,
+		Action:    kind + ".insert",
+		Value:     value,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	after, err := c.GetFirewallConfig(ctx, projectID, teamID)
+	if err != nil {
+		return "", err
+	}
+	for i, id := range firewallRuleIDs(after, kind) {
+		if !slices.Contains(existing, id) && matches(after, i) {
+			return id, nil
+		}
+	}
+	return "", fmt.Errorf("the rule was added, but could not be found in the firewall config")
+}
+
+// placeFirewallRule moves a rule to the position given by its priority, and returns the resulting firewall
+// config. Rules are only moved when they are out of place, so other rules are not reordered unnecessarily. The
+// caller must hold the firewall lock for the project.
+func placeFirewallRule(ctx context.Context, c client.FirewallAPI, kind, projectID, teamID, id string, priority types.Int64) (client.FirewallConfig, error) {
+	cfg, err := c.GetFirewallConfig(ctx, projectID, teamID)
+	if err != nil {
+		return cfg, err
+	}
+	ids := firewallRuleIDs(cfg, kind)
+	position := slices.Index(ids, id)
+	if position < 0 {
+		return cfg, fmt.Errorf("rule %s could not be found in the firewall config", id)
+	}
+	if priority.IsNull() || priority.IsUnknown() {
+		return cfg, nil
+	}
+	target := int(min(priority.ValueInt64(), int64(len(ids)-1)))
+	if target == position {
+		return cfg, nil
+	}
+
+	err = c.PatchFirewallConfig(ctx, client.PatchFirewallConfigRequest{
+		ProjectID: projectID,
+		TeamID:    teamID,
+		Action:    kind + ".priority",
+		ID:        id,
+		Value:     target,
+	})
+	if err != nil {
+		return cfg, err
+	}
+	return c.GetFirewallConfig(ctx, projectID, teamID)
+}
+
+// ValidateConfig validates the conditions and action of the rule offline, so that rules the API would reject
+// are reported at plan time.
+func (r *firewallRuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ProjectFirewallRule
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		// The rule contains unknown values, so cannot be validated yet.
+		return
+	}
+	resp.Diagnostics.Append(validateFirewallRule(ctx, path.Empty(), config.rule())...)
+}
+
+// Create will create a new firewall rule within the firewall config of a project.
+// This is called automatically by the provider when a new resource should be created.
+func (r *firewallRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ProjectFirewallRule
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	unlock, err := lockFirewall(ctx, plan.ProjectID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating firewall rule", err.Error())
+		return
+	}
+	defer unlock()
+
+	rule, err := plan.toClient()
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating firewall rule", err.Error())
+		return
+	}
+	id, err := insertFirewallRule(ctx, r.client, "rules", plan.ProjectID.ValueString(), plan.TeamID.ValueString(), rule, func(cfg client.FirewallConfig, i int) bool {
+		return cfg.Rules[i].Name == rule.Name
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating firewall rule",
+			fmt.Sprintf("Could not create firewall rule %s for project %s, unexpected error: %s",
+				plan.Name.ValueString(),
+				plan.ProjectID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	cfg, err := placeFirewallRule(ctx, r.client, "rules", plan.ProjectID.ValueString(), plan.TeamID.ValueString(), id, plan.Priority)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating firewall rule",
+			fmt.Sprintf("Could not set the priority of firewall rule %s for project %s, unexpected error: %s",
+				id,
+				plan.ProjectID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	result, _, err := convertResponseToProjectFirewallRule(cfg, id, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating firewall rule", err.Error())
+		return
+	}
+	tflog.Info(ctx, "created firewall rule", map[string]interface{}{
+		"team_id":    result.TeamID.ValueString(),
+		"project_id": result.ProjectID.ValueString(),
+		"rule_id":    result.ID.ValueString(),
+		"priority":   result.Priority.ValueInt64(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read will read a firewall rule of a project by requesting the firewall config from the Vercel API, and will
+// update terraform with this information.
+func (r *firewallRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ProjectFirewallRule
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cfg, err := r.client.GetFirewallConfig(ctx, state.ProjectID.ValueString(), state.TeamID.ValueString())
+	if client.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading firewall rule",
+			fmt.Sprintf("Could not read firewall rule %s for project %s, unexpected error: %s",
+				state.ID.ValueString(),
+				state.ProjectID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	result, ok, err := convertResponseToProjectFirewallRule(cfg, state.ID.ValueString(), state)
+	if !ok {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading firewall rule", err.Error())
+		return
+	}
+	tflog.Info(ctx, "read firewall rule", map[string]interface{}{
+		"team_id":    result.TeamID.ValueString(),
+		"project_id": result.ProjectID.ValueString(),
+		"rule_id":    result.ID.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update will update a firewall rule, and move it if its priority has changed.
+func (r *firewallRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state ProjectFirewallRule
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	unlock, err := lockFirewall(ctx, plan.ProjectID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating firewall rule", err.Error())
+		return
+	}
+	defer unlock()
+
+	plan.ID = state.ID
+	rule, err := plan.toClient()
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating firewall rule", err.Error())
+		return
+	}
+	err = r.client.PatchFirewallConfig(ctx, client.PatchFirewallConfigRequest{
+		ProjectID: plan.ProjectID.ValueString(),
+		TeamID:    plan.TeamID.ValueString(),
+		Action:    "rules.update",
+		ID:        state.ID.ValueString(),
+		Value:     rule,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating firewall rule",
+			fmt.Sprintf("Could not update firewall rule %s for project %s, unexpected error: %s",
+				state.ID.ValueString(),
+				plan.ProjectID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	cfg, err := placeFirewallRule(ctx, r.client, "rules", plan.ProjectID.ValueString(), plan.TeamID.ValueString(), state.ID.ValueString(), plan.Priority)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating firewall rule",
+			fmt.Sprintf("Could not set the priority of firewall rule %s for project %s, unexpected error: %s",
+				state.ID.ValueString(),
+				plan.ProjectID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	result, _, err := convertResponseToProjectFirewallRule(cfg, state.ID.ValueString(), plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating firewall rule", err.Error())
+		return
+	}
+	tflog.Info(ctx, "updated firewall rule", map[string]interface{}{
+		"team_id":    result.TeamID.ValueString(),
+		"project_id": result.ProjectID.ValueString(),
+		"rule_id":    result.ID.ValueString(),
+		"priority":   result.Priority.ValueInt64(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete removes a firewall rule from the firewall config of a project, leaving any other rules in place.
+func (r *firewallRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ProjectFirewallRule
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	unlock, err := lockFirewall(ctx, state.ProjectID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting firewall rule", err.Error())
+		return
+	}
+	defer unlock()
+
+	err = r.client.PatchFirewallConfig(ctx, client.PatchFirewallConfigRequest{
+		ProjectID: state.ProjectID.ValueString(),
+		TeamID:    state.TeamID.ValueString(),
+		Action:    "rules.remove",
+		ID:        state.ID.ValueString(),
+	})
+	if client.NotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting firewall rule",
+			fmt.Sprintf("Could not delete firewall rule %s for project %s, unexpected error: %s",
+				state.ID.ValueString(),
+				state.ProjectID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "deleted firewall rule", map[string]interface{}{
+		"team_id":    state.TeamID.ValueString(),
+		"project_id": state.ProjectID.ValueString(),
+		"rule_id":    state.ID.ValueString(),
+	})
+}
+
+// ImportState takes an identifier and reads all the firewall rule information from the Vercel API.
+// The results are then stored in terraform state.
+func (r *firewallRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	teamID, projectID, ruleID, ok := splitInto2Or3(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Error importing firewall rule",
+			fmt.Sprintf("Invalid id '%s' specified. should be in format \"team_id/project_id/rule_id\" or \"project_id/rule_id\"", req.ID),
+		)
+		return
+	}
+
+	cfg, err := r.client.GetFirewallConfig(ctx, projectID, teamID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing firewall rule",
+			fmt.Sprintf("Could not read firewall rule %s for project %s, unexpected error: %s", ruleID, projectID, err),
+		)
+		return
+	}
+	result, ok, err := convertResponseToProjectFirewallRule(cfg, ruleID, ProjectFirewallRule{
+		ProjectID: types.StringValue(projectID),
+	})
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Error importing firewall rule",
+			fmt.Sprintf("Could not find firewall rule %s for project %s", ruleID, projectID),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing firewall rule", err.Error())
+		return
+	}
+	tflog.Info(ctx, "imported firewall rule", map[string]interface{}{
+		"team_id":    result.TeamID.ValueString(),
+		"project_id": result.ProjectID.ValueString(),
+		"rule_id":    result.ID.ValueString(),
+	})
+
+	diags := resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
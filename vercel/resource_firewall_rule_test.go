@@ -0,0 +1,221 @@
+package vercel_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func getFirewallRuleImportID(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return "", fmt.Errorf("no ID is set")
+		}
+
+		if rs.Primary.Attributes["team_id"] == "" {
+			return fmt.Sprintf("%s/%s", rs.Primary.Attributes["project_id"], rs.Primary.ID), nil
+		}
+		return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["team_id"], rs.Primary.Attributes["project_id"], rs.Primary.ID), nil
+	}
+}
+
+func TestAcc_FirewallRuleResource(t *testing.T) {
+	name := randString(t, 16)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallRuleResource(name, teamIDConfig(), 0, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("vercel_firewall_rule.deny", "id"),
+					resource.TestCheckResourceAttr("vercel_firewall_rule.deny", "priority", "0"),
+					resource.TestCheckResourceAttr("vercel_firewall_rule.deny", "action.action", "deny"),
+					resource.TestCheckResourceAttr("vercel_firewall_rule.deny", "condition_group.0.conditions.0.value", "/admin"),
+					resource.TestCheckResourceAttr("vercel_firewall_rule.deny", "condition_group.0.conditions.1.values.#", "2"),
+					resource.TestCheckNoResourceAttr("vercel_firewall_rule.deny", "condition_group.0.conditions.1.value"),
+					resource.TestCheckResourceAttr("vercel_firewall_rule.rate_limit", "priority", "1"),
+					resource.TestCheckResourceAttr("vercel_firewall_rule.rate_limit", "action.rate_limit.limit", "100"),
+					resource.TestCheckResourceAttr("vercel_firewall_rule.unordered", "priority", "2"),
+				),
+			},
+			{
+				ImportState:       true,
+				ImportStateVerify: true,
+				ResourceName:      "vercel_firewall_rule.deny",
+				ImportStateIdFunc: getFirewallRuleImportID("vercel_firewall_rule.deny"),
+			},
+			{
+				Config: testAccFirewallRuleResource(name, teamIDConfig(), 1, 0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vercel_firewall_rule.deny", "priority", "1"),
+					resource.TestCheckResourceAttr("vercel_firewall_rule.rate_limit", "priority", "0"),
+					resource.TestCheckResourceAttr("vercel_firewall_rule.unordered", "priority", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFirewallRuleResource(name, teamID string, denyPriority, rateLimitPriority int) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "test" {
+  name = "test-acc-%[1]s"
+  %[2]s
+}
+
+resource "vercel_firewall_rule" "deny" {
+  project_id = vercel_project.test.id
+  %[2]s
+  priority   = %[3]d
+  name       = "deny admin"
+  condition_group = [{
+    conditions = [
+      {
+        type  = "path"
+        op    = "pre"
+        value = "/admin"
+      },
+      {
+        type   = "geo_country"
+        op     = "inc"
+        neg    = true
+        values = ["US", "CA"]
+      },
+    ]
+  }]
+  action = {
+    action = "deny"
+  }
+}
+
+resource "vercel_firewall_rule" "rate_limit" {
+  project_id  = vercel_project.test.id
+  %[2]s
+  priority    = %[4]d
+  name        = "rate limit api"
+  description = "Limit requests to the API"
+  condition_group = [{
+    conditions = [{
+      type  = "path"
+      op    = "pre"
+      value = "/api"
+    }]
+  }]
+  action = {
+    action = "rate_limit"
+    rate_limit = {
+      algo   = "fixed_window"
+      window = 60
+      limit  = 100
+      keys   = ["ip"]
+      action = "deny"
+    }
+  }
+}
+
+resource "vercel_firewall_rule" "unordered" {
+  project_id = vercel_project.test.id
+  %[2]s
+  name       = "log curl"
+  condition_group = [{
+    conditions = [{
+      type  = "user_agent"
+      op    = "sub"
+      value = "curl"
+    }]
+  }]
+  action = {
+    action = "log"
+  }
+
+  depends_on = [vercel_firewall_rule.deny, vercel_firewall_rule.rate_limit]
+}
+`, name, teamID, denyPriority, rateLimitPriority)
+}
+
+func TestAcc_FirewallRuleResourceWithFirewallConfig(t *testing.T) {
+	name := randString(t, 16)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "vercel_firewall_config" "invalid" {
+  project_id   = "prj_invalid"
+  manage_rules = false
+
+  rules {}
+}
+`,
+				ExpectError: regexp.MustCompile("`rules` cannot be set when `manage_rules` is false"),
+			},
+			{
+				Config: testAccFirewallRuleResourceWithFirewallConfig(name, teamIDConfig()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("vercel_firewall_rule.deny", "id"),
+					resource.TestCheckNoResourceAttr("vercel_firewall_config.managed", "rules.rule.#"),
+				),
+			},
+			{
+				// The firewall config does not manage rules, so the standalone rule is neither drift for the firewall
+				// config, nor removed when the firewall config is applied.
+				Config: testAccFirewallRuleResourceWithFirewallConfig(name, teamIDConfig()),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func testAccFirewallRuleResourceWithFirewallConfig(name, teamID string) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "test" {
+  name = "test-acc-%[1]s"
+  %[2]s
+}
+
+resource "vercel_firewall_config" "managed" {
+  project_id   = vercel_project.test.id
+  %[2]s
+  manage_rules = false
+
+  managed_rulesets {
+    owasp {
+      xss = { action = "deny" }
+    }
+  }
+}
+
+resource "vercel_firewall_rule" "deny" {
+  project_id = vercel_project.test.id
+  %[2]s
+  name       = "deny admin"
+  condition_group = [{
+    conditions = [{
+      type  = "path"
+      op    = "pre"
+      value = "/admin"
+    }]
+  }]
+  action = {
+    action = "deny"
+  }
+
+  depends_on = [vercel_firewall_config.managed]
+}
+`, name, teamID)
+}
@@ -3,11 +3,16 @@ package vercel
 import (
 	"context"
 	"fmt"
+	"regexp"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
@@ -16,13 +21,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/vercel/terraform-provider-vercel/client"
+	"github.com/vercel/terraform-provider-vercel/logdrain"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &logDrainResource{}
-	_ resource.ResourceWithConfigure   = &logDrainResource{}
-	_ resource.ResourceWithImportState = &logDrainResource{}
+	_ resource.Resource                   = &logDrainResource{}
+	_ resource.ResourceWithConfigure      = &logDrainResource{}
+	_ resource.ResourceWithImportState    = &logDrainResource{}
+	_ resource.ResourceWithValidateConfig = &logDrainResource{}
 )
 
 func newLogDrainResource() resource.Resource {
@@ -30,7 +37,7 @@ func newLogDrainResource() resource.Resource {
 }
 
 type logDrainResource struct {
-	client *client.Client
+	client client.LogDrainsAPI
 }
 
 func (r *logDrainResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -43,11 +50,11 @@ func (r *logDrainResource) Configure(ctx context.Context, req resource.Configure
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(client.LogDrainsAPI)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected client.LogDrainsAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -66,6 +73,10 @@ Log Drains collect all of your logs using a service specializing in storing app
 
 Teams on Pro and Enterprise plans can subscribe to log drains that are generic and configurable from the Vercel dashboard without creating an integration. This allows you to use a HTTP service to receive logs through Vercel's log drains.
 
+Logs can be delivered as JSON, as protobuf to an OpenTelemetry (OTLP) collector, or over syslog. A ` + "`filter`" + ` expression and
+per-source ` + "`sampling_rules`" + ` reduce the logs that are delivered. An endpoint that does not suit the delivery format, or
+a sampling rule for a source that is not sent to the drain, is reported by the plan rather than by Vercel on apply.
+
 ~> Only Pro and Enterprise teams can create Configurable Log Drains.`,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -80,11 +91,11 @@ Teams on Pro and Enterprise plans can subscribe to log drains that are generic a
 				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplaceIfConfigured(), stringplanmodifier.UseStateForUnknown()},
 			},
 			"delivery_format": schema.StringAttribute{
-				Description:   "The format log data should be delivered in. Can be `json` or `ndjson`.",
+				Description:   "The format log data should be delivered in. Can be `json`, `ndjson`, `protobuf` for an OpenTelemetry (OTLP) collector, or `syslog`.",
 				Required:      true,
 				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
 				Validators: []validator.String{
-					stringOneOf("json", "ndjson"),
+					stringOneOf(logdrain.Formats...),
 				},
 			},
 			"environments": schema.SetAttribute{
@@ -93,12 +104,12 @@ Teams on Pro and Enterprise plans can subscribe to log drains that are generic a
 				PlanModifiers: []planmodifier.Set{setplanmodifier.RequiresReplace()},
 				Required:      true,
 				Validators: []validator.Set{
-					stringSetItemsIn("production", "preview"),
+					stringSetItemsIn(logdrain.Environments...),
 					stringSetMinCount(1),
 				},
 			},
 			"headers": schema.MapAttribute{
-				Description:   "Custom headers to include in requests to the log drain endpoint.",
+				Description:   "Custom headers to include in requests to the log drain endpoint. Headers cannot be used with the `syslog` format.",
 				ElementType:   types.StringType,
 				PlanModifiers: []planmodifier.Map{mapplanmodifier.RequiresReplace()},
 				Optional:      true,
@@ -137,33 +148,110 @@ Teams on Pro and Enterprise plans can subscribe to log drains that are generic a
 				ElementType:   types.StringType,
 				PlanModifiers: []planmodifier.Set{setplanmodifier.RequiresReplace()},
 				Validators: []validator.Set{
-					stringSetItemsIn("static", "edge", "external", "build", "lambda"),
+					stringSetItemsIn(logdrain.Sources...),
 					stringSetMinCount(1),
 				},
 			},
+			"sampling_rules": schema.ListNestedAttribute{
+				Description:   "Sampling rates for individual sources, which override `sampling_rate` for logs from that source. Each source can only have one rule, and must be one of the `sources` of the log drain.",
+				Optional:      true,
+				PlanModifiers: []planmodifier.List{listplanmodifier.RequiresReplace()},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"source": schema.StringAttribute{
+							Description: "The source the rule applies to.",
+							Required:    true,
+						},
+						"rate": schema.Float64Attribute{
+							Description: "The ratio of logs from the source that are sent to the log drain. Must be greater than 0, and at most 1.",
+							Required:    true,
+						},
+					},
+				},
+			},
+			"filter": schema.StringAttribute{
+				Description:   "A filter expression, which Vercel uses to select the logs to deliver. The expression is passed to Vercel as is, and is not validated when planning. If not set, every log is delivered.",
+				Optional:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.RegexMatches(regexp.MustCompile(`\S`), "must not be blank"),
+				},
+			},
 			"endpoint": schema.StringAttribute{
-				Description:   "Logs will be sent as POST requests to this URL. The endpoint will be verified, and must return a `200` status code and an `x-vercel-verify` header taken from the endpoint_verification data source. The value the `x-vercel-verify` header should be can be read from the `vercel_endpoint_verification_code` data source.",
+				Description:   "Logs will be sent as POST requests to this URL, or to a `syslog+tls://` or `syslog://` URL, including a port, for the `syslog` format. HTTP endpoints will be verified, and must return a `200` status code and an `x-vercel-verify` header taken from the endpoint_verification data source. The value the `x-vercel-verify` header should be can be read from the `vercel_endpoint_verification_code` data source.",
 				Required:      true,
 				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
+			"verification_code": schema.StringAttribute{
+				Description:   "The verification code the endpoint was expected to return in the `x-vercel-verify` header when the Log Drain was created. Not set for the `syslog` format, which is not verified.",
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
 		},
 	}
 }
 
 type LogDrain struct {
-	ID             types.String  `tfsdk:"id"`
-	TeamID         types.String  `tfsdk:"team_id"`
-	DeliveryFormat types.String  `tfsdk:"delivery_format"`
-	Environments   types.Set     `tfsdk:"environments"`
-	Headers        types.Map     `tfsdk:"headers"`
-	ProjectIDs     types.Set     `tfsdk:"project_ids"`
-	SamplingRate   types.Float64 `tfsdk:"sampling_rate"`
-	Secret         types.String  `tfsdk:"secret"`
-	Sources        types.Set     `tfsdk:"sources"`
-	Endpoint       types.String  `tfsdk:"endpoint"`
+	ID               types.String  `tfsdk:"id"`
+	TeamID           types.String  `tfsdk:"team_id"`
+	DeliveryFormat   types.String  `tfsdk:"delivery_format"`
+	Environments     types.Set     `tfsdk:"environments"`
+	Headers          types.Map     `tfsdk:"headers"`
+	ProjectIDs       types.Set     `tfsdk:"project_ids"`
+	SamplingRate     types.Float64 `tfsdk:"sampling_rate"`
+	Secret           types.String  `tfsdk:"secret"`
+	SamplingRules    types.List    `tfsdk:"sampling_rules"`
+	Filter           types.String  `tfsdk:"filter"`
+	Sources          types.Set     `tfsdk:"sources"`
+	Endpoint         types.String  `tfsdk:"endpoint"`
+	VerificationCode types.String  `tfsdk:"verification_code"`
+}
+
+// LogDrainSamplingRule represents the sampling rate of a single source of a Log Drain.
+type LogDrainSamplingRule struct {
+	Source types.String  `tfsdk:"source"`
+	Rate   types.Float64 `tfsdk:"rate"`
+}
+
+var logDrainSamplingRuleElemType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"source": types.StringType,
+		"rate":   types.Float64Type,
+	},
 }
 
-func responseToLogDrain(ctx context.Context, out client.LogDrain, secret types.String) (LogDrain, diag.Diagnostics) {
+func (l LogDrain) samplingRules(ctx context.Context) ([]client.LogDrainSamplingRule, diag.Diagnostics) {
+	var rules []LogDrainSamplingRule
+	diags := l.SamplingRules.ElementsAs(ctx, &rules, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+	var out []client.LogDrainSamplingRule
+	for _, r := range rules {
+		out = append(out, client.LogDrainSamplingRule{
+			Source: r.Source.ValueString(),
+			Rate:   r.Rate.ValueFloat64(),
+		})
+	}
+	return out, nil
+}
+
+func samplingRulesToList(ctx context.Context, rules []client.LogDrainSamplingRule) (types.List, diag.Diagnostics) {
+	if len(rules) == 0 {
+		return types.ListNull(logDrainSamplingRuleElemType), nil
+	}
+	var elements []LogDrainSamplingRule
+	for _, r := range rules {
+		elements = append(elements, LogDrainSamplingRule{
+			Source: types.StringValue(r.Source),
+			Rate:   types.Float64Value(r.Rate),
+		})
+	}
+	return types.ListValueFrom(ctx, logDrainSamplingRuleElemType, elements)
+}
+
+func responseToLogDrain(ctx context.Context, out client.LogDrain, secret, verificationCode types.String) (LogDrain, diag.Diagnostics) {
 	projectIDs, diags := types.SetValueFrom(ctx, types.StringType, out.ProjectIDs)
 	if diags.HasError() {
 		return LogDrain{}, diags
@@ -184,24 +272,94 @@ func responseToLogDrain(ctx context.Context, out client.LogDrain, secret types.S
 		return LogDrain{}, diags
 	}
 
+	samplingRules, diags := samplingRulesToList(ctx, out.SamplingRules)
+	if diags.HasError() {
+		return LogDrain{}, diags
+	}
+
 	if secret.IsNull() || secret.IsUnknown() {
 		secret = types.StringValue(out.Secret)
 	}
 
 	return LogDrain{
-		ID:             types.StringValue(out.ID),
-		TeamID:         toTeamID(out.TeamID),
-		DeliveryFormat: types.StringValue(out.DeliveryFormat),
-		SamplingRate:   types.Float64PointerValue(out.SamplingRate),
-		Secret:         secret,
-		Endpoint:       types.StringValue(out.Endpoint),
-		Environments:   environments,
-		Headers:        headers,
-		Sources:        sources,
-		ProjectIDs:     projectIDs,
+		ID:               types.StringValue(out.ID),
+		TeamID:           toTeamID(out.TeamID),
+		DeliveryFormat:   types.StringValue(out.DeliveryFormat),
+		SamplingRate:     types.Float64PointerValue(out.SamplingRate),
+		Secret:           secret,
+		Endpoint:         types.StringValue(out.Endpoint),
+		Environments:     environments,
+		Headers:          headers,
+		Sources:          sources,
+		ProjectIDs:       projectIDs,
+		SamplingRules:    samplingRules,
+		Filter:           stringOrNull(out.Filter),
+		VerificationCode: verificationCode,
 	}, nil
 }
 
+// ValidateConfig validates the endpoint and sampling rules of a Log Drain offline.
+func (r *logDrainResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config LogDrain
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	format := config.DeliveryFormat
+	if !format.IsUnknown() && !format.IsNull() {
+		if !config.Endpoint.IsUnknown() && !config.Endpoint.IsNull() {
+			if err := logdrain.ValidateEndpoint(format.ValueString(), config.Endpoint.ValueString()); err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("endpoint"),
+					"Invalid Log Drain endpoint",
+					err.Error(),
+				)
+			}
+		}
+		if !logdrain.HTTPFormat(format.ValueString()) && !config.Headers.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("headers"),
+				"Invalid Log Drain headers",
+				fmt.Sprintf("Headers cannot be used with the %s format, as logs are not delivered as HTTP requests.", format.ValueString()),
+			)
+		}
+	}
+
+	if config.SamplingRules.IsUnknown() || config.SamplingRules.IsNull() {
+		return
+	}
+	var rules []LogDrainSamplingRule
+	diags = config.SamplingRules.ElementsAs(ctx, &rules, false)
+	if diags.HasError() {
+		return
+	}
+	var sources []string
+	if !config.Sources.IsUnknown() && !config.Sources.IsNull() {
+		diags = config.Sources.ElementsAs(ctx, &sources, false)
+		if diags.HasError() {
+			sources = nil
+		}
+	}
+	var known []client.LogDrainSamplingRule
+	var indexes []int
+	for i, r := range rules {
+		if r.Source.IsUnknown() || r.Rate.IsUnknown() {
+			continue
+		}
+		known = append(known, client.LogDrainSamplingRule{Source: r.Source.ValueString(), Rate: r.Rate.ValueFloat64()})
+		indexes = append(indexes, i)
+	}
+	for _, err := range logdrain.ValidateSamplingRules(sources, known) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("sampling_rules").AtListIndex(indexes[err.Index]).AtName(err.Field),
+			"Invalid Log Drain sampling rule",
+			err.Message,
+		)
+	}
+}
+
 func (r *logDrainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan LogDrain
 	diags := req.Plan.Get(ctx, &plan)
@@ -238,6 +396,27 @@ func (r *logDrainResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	samplingRules, diags := plan.samplingRules(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The endpoint is verified when the Log Drain is created, so the code it needs to return is
+	// read first, to be included in any error.
+	verificationCode := types.StringNull()
+	if logdrain.HTTPFormat(plan.DeliveryFormat.ValueString()) {
+		code, err := r.client.GetEndpointVerificationCode(ctx, plan.TeamID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating Log Drain",
+				"Could not get endpoint verification code, unexpected error: "+err.Error(),
+			)
+			return
+		}
+		verificationCode = types.StringValue(code)
+	}
+
 	out, err := r.client.CreateLogDrain(ctx, client.CreateLogDrainRequest{
 		TeamID:         plan.TeamID.ValueString(),
 		DeliveryFormat: plan.DeliveryFormat.ValueString(),
@@ -245,10 +424,22 @@ func (r *logDrainResource) Create(ctx context.Context, req resource.CreateReques
 		Headers:        headers,
 		ProjectIDs:     projectIDs,
 		SamplingRate:   plan.SamplingRate.ValueFloat64(),
+		SamplingRules:  samplingRules,
+		Filter:         plan.Filter.ValueString(),
 		Secret:         plan.Secret.ValueString(),
 		Sources:        sources,
 		Endpoint:       plan.Endpoint.ValueString(),
 	})
+	if err != nil && !verificationCode.IsNull() {
+		resp.Diagnostics.AddError(
+			"Error creating Log Drain",
+			fmt.Sprintf("Could not create Log Drain, unexpected error: %s. The endpoint must respond to the verification request with a 200 status code and an `x-vercel-verify: %s` header.",
+				err,
+				verificationCode.ValueString(),
+			),
+		)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating Log Drain",
@@ -257,7 +448,7 @@ func (r *logDrainResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	result, diags := responseToLogDrain(ctx, out, plan.Secret)
+	result, diags := responseToLogDrain(ctx, out, plan.Secret, verificationCode)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -299,7 +490,7 @@ func (r *logDrainResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	result, diags := responseToLogDrain(ctx, out, state.Secret)
+	result, diags := responseToLogDrain(ctx, out, state.Secret, state.VerificationCode)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -380,7 +571,7 @@ func (r *logDrainResource) ImportState(ctx context.Context, req resource.ImportS
 		return
 	}
 
-	result, diags := responseToLogDrain(ctx, out, types.StringNull())
+	result, diags := responseToLogDrain(ctx, out, types.StringNull(), types.StringNull())
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -3,9 +3,9 @@ package vercel_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/vercel/terraform-provider-vercel/client"
@@ -51,7 +51,7 @@ func testCheckLogDrainDeleted(n, teamID string) resource.TestCheckFunc {
 }
 
 func TestAcc_LogDrainResource(t *testing.T) {
-	name := acctest.RandString(16)
+	name := randString(t, 16)
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -84,6 +84,11 @@ func TestAcc_LogDrainResource(t *testing.T) {
 					resource.TestCheckResourceAttr("vercel_log_drain.maximal", "sources.4", "static"),
 					resource.TestCheckResourceAttr("vercel_log_drain.maximal", "secret", "a_very_long_and_very_well_specified_secret"),
 					resource.TestCheckResourceAttr("vercel_log_drain.maximal", "headers.%", "1"),
+					resource.TestCheckResourceAttr("vercel_log_drain.maximal", "filter", `status_code >= 400 and path starts_with "/api"`),
+					resource.TestCheckResourceAttr("vercel_log_drain.maximal", "sampling_rules.#", "1"),
+					resource.TestCheckResourceAttr("vercel_log_drain.maximal", "sampling_rules.0.source", "lambda"),
+					resource.TestCheckResourceAttr("vercel_log_drain.maximal", "sampling_rules.0.rate", "0.5"),
+					resource.TestCheckResourceAttrPair("vercel_log_drain.maximal", "verification_code", "data.vercel_endpoint_verification.test", "verification_code"),
 					resource.TestCheckResourceAttrSet("vercel_log_drain.maximal", "endpoint"),
 					resource.TestCheckResourceAttrSet("vercel_log_drain.maximal", "id"),
 					resource.TestCheckResourceAttrSet("vercel_log_drain.maximal", "team_id"),
@@ -121,6 +126,10 @@ resource "vercel_log_drain" "maximal" {
     }
     project_ids             = [vercel_project.test.id]
     sampling_rate           = 0.8
+    sampling_rules          = [
+        { source = "lambda", rate = 0.5 },
+    ]
+    filter                  = "status_code >= 400 and path starts_with \"/api\""
     secret                  = "a_very_long_and_very_well_specified_secret"
     sources                 = ["static", "edge", "external", "build", "lambda"]
     endpoint = "https://verify-test-rouge.vercel.app/api?${data.vercel_endpoint_verification.test.verification_code}"
@@ -129,3 +138,46 @@ resource "vercel_log_drain" "maximal" {
 }
 `, name, team)
 }
+
+func TestAcc_LogDrainResourceInvalid(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceLogDrainInvalid(teamIDConfig(), "json", "syslog+tls://logs.example.com:6514", `filter = "status_code >= 500"`),
+				ExpectError: regexp.MustCompile("must start with https://"),
+			},
+			{
+				Config:      testAccResourceLogDrainInvalid(teamIDConfig(), "syslog", "syslog+tls://logs.example.com:6514", `headers = { some-key = "some-value" }`),
+				ExpectError: regexp.MustCompile("Headers cannot be used with the syslog format"),
+			},
+			{
+				Config:      testAccResourceLogDrainInvalid(teamIDConfig(), "protobuf", "https://otel.example.com/v1/logs", `filter = ""`),
+				ExpectError: regexp.MustCompile("string length must be at least 1"),
+			},
+			{
+				Config:      testAccResourceLogDrainInvalid(teamIDConfig(), "protobuf", "https://otel.example.com/v1/logs", `filter = "   "`),
+				ExpectError: regexp.MustCompile("must not be blank"),
+			},
+			{
+				Config:      testAccResourceLogDrainInvalid(teamIDConfig(), "ndjson", "https://logs.example.com", `sampling_rules = [{ source = "edge", rate = 0.5 }]`),
+				ExpectError: regexp.MustCompile(`does not send logs for "edge"`),
+			},
+		},
+	})
+}
+
+func testAccResourceLogDrainInvalid(team, format, endpoint, extra string) string {
+	return fmt.Sprintf(`
+resource "vercel_log_drain" "invalid" {
+    delivery_format = "%[2]s"
+    environments    = ["production"]
+    sources         = ["lambda"]
+    endpoint        = "%[3]s"
+    %[4]s
+
+    %[1]s
+}
+`, team, format, endpoint, extra)
+}
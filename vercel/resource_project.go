@@ -37,8 +37,14 @@ func newProjectResource() resource.Resource {
 	return &projectResource{}
 }
 
+// projectClient is the part of the Vercel API used to manage projects and their environment variables.
+type projectClient interface {
+	client.ProjectsAPI
+	client.TeamsAPI
+}
+
 type projectResource struct {
-	client *client.Client
+	client projectClient
 }
 
 func (r *projectResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -51,11 +57,11 @@ func (r *projectResource) Configure(ctx context.Context, req resource.ConfigureR
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(projectClient)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected a client implementing client.ProjectsAPI and client.TeamsAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -1199,12 +1205,9 @@ func convertResponseToProject(ctx context.Context, response client.ProjectRespon
 
 	protectionBypassSecret := types.StringNull()
 	protectionBypass := types.BoolNull()
-	for k, v := range response.ProtectionBypass {
-		if v.Scope == "automation-bypass" {
-			protectionBypass = types.BoolValue(true)
-			protectionBypassSecret = types.StringValue(k)
-			break
-		}
+	if secret, ok := automationBypassSecret(response.ProtectionBypass); ok {
+		protectionBypass = types.BoolValue(true)
+		protectionBypassSecret = types.StringValue(secret)
 	}
 	if !plan.ProtectionBypassForAutomation.IsNull() && !plan.ProtectionBypassForAutomation.ValueBool() {
 		protectionBypass = types.BoolValue(false)
@@ -27,7 +27,7 @@ func newProjectDeploymentRetentionResource() resource.Resource {
 }
 
 type projectDeploymentRetentionResource struct {
-	client *client.Client
+	client client.ProjectsAPI
 }
 
 func (r *projectDeploymentRetentionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -40,11 +40,11 @@ func (r *projectDeploymentRetentionResource) Configure(ctx context.Context, req
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(client.ProjectsAPI)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected client.ProjectsAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
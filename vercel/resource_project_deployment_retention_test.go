@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
@@ -27,7 +26,7 @@ func testAccProjectDeploymentRetentionExists(n, teamID string) resource.TestChec
 }
 
 func TestAcc_ProjectDeploymentRetention(t *testing.T) {
-	nameSuffix := acctest.RandString(16)
+	nameSuffix := randString(t, 16)
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -2,8 +2,14 @@ package vercel
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
+	"net"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -15,16 +21,23 @@ import (
 )
 
 var (
-	_ resource.Resource              = &projectDomainResource{}
-	_ resource.ResourceWithConfigure = &projectDomainResource{}
+	_ resource.Resource                   = &projectDomainResource{}
+	_ resource.ResourceWithConfigure      = &projectDomainResource{}
+	_ resource.ResourceWithValidateConfig = &projectDomainResource{}
 )
 
 func newProjectDomainResource() resource.Resource {
 	return &projectDomainResource{}
 }
 
+// projectDomainClient is the subset of the Vercel client used by the project domain resource.
+type projectDomainClient interface {
+	client.ProjectsAPI
+	client.DomainsAPI
+}
+
 type projectDomainResource struct {
-	client *client.Client
+	client projectDomainClient
 }
 
 func (r *projectDomainResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -37,11 +50,11 @@ func (r *projectDomainResource) Configure(ctx context.Context, req resource.Conf
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(projectDomainClient)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected a client implementing client.ProjectsAPI and client.DomainsAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -57,7 +70,11 @@ Provides a Project Domain resource.
 
 A Project Domain is used to associate a domain name with a ` + "`vercel_project`." + `
 
-By default, Project Domains will be automatically applied to any ` + "`production` deployments.",
+By default, Project Domains will be automatically applied to any ` + "`production` deployments." + `
+
+A domain that is already in use by another Vercel account or team must be verified before it can be used. Set
+` + "`wait_for_verification`" + ` to wait for this during the apply, and use the ` + "`verification`" + ` records to create the required
+DNS records. Set ` + "`wait_for_certificate`" + ` to also wait until the domain is serving a valid TLS certificate.`,
 		Attributes: map[string]schema.Attribute{
 			"project_id": schema.StringAttribute{
 				Description:   "The project ID to add the deployment to.",
@@ -94,31 +111,216 @@ By default, Project Domains will be automatically applied to any ` + "`productio
 				Description: "Git branch to link to the project domain. Deployments from this git branch will be assigned the domain name.",
 				Optional:    true,
 			},
+			"wait_for_verification": schema.BoolAttribute{
+				Description: "Whether the apply should wait until the domain is verified. If the domain needs to be verified, the required DNS records are available in `verification`.",
+				Optional:    true,
+			},
+			"wait_for_certificate": schema.BoolAttribute{
+				Description: "Whether the apply should wait until the domain is verified, configured correctly, and serving a valid TLS certificate. This is not supported for wildcard domains.",
+				Optional:    true,
+			},
+			"wait_timeout": schema.StringAttribute{
+				Description: "How long to wait for verification and the certificate, as a duration such as `10m`. Defaults to `15m`.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringDuration(10 * time.Second),
+				},
+			},
+			"verified": schema.BoolAttribute{
+				Description: "Whether the domain has been verified.",
+				Computed:    true,
+			},
+			"verification": schema.ListNestedAttribute{
+				Description: "The DNS records that must be created to verify the domain. This is empty once the domain is verified.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Description: "The type of DNS record, typically `TXT`.",
+							Computed:    true,
+						},
+						"domain": schema.StringAttribute{
+							Description: "The name of the DNS record, for example `_vercel.example.com`.",
+							Computed:    true,
+						},
+						"value": schema.StringAttribute{
+							Description: "The value of the DNS record.",
+							Computed:    true,
+						},
+						"reason": schema.StringAttribute{
+							Description: "Why the domain needs to be verified.",
+							Computed:    true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
 // ProjectDomain reflects the state terraform stores internally for a project domain.
 type ProjectDomain struct {
-	Domain             types.String `tfsdk:"domain"`
-	GitBranch          types.String `tfsdk:"git_branch"`
-	ID                 types.String `tfsdk:"id"`
-	ProjectID          types.String `tfsdk:"project_id"`
-	Redirect           types.String `tfsdk:"redirect"`
-	RedirectStatusCode types.Int64  `tfsdk:"redirect_status_code"`
-	TeamID             types.String `tfsdk:"team_id"`
+	Domain              types.String                `tfsdk:"domain"`
+	GitBranch           types.String                `tfsdk:"git_branch"`
+	ID                  types.String                `tfsdk:"id"`
+	ProjectID           types.String                `tfsdk:"project_id"`
+	Redirect            types.String                `tfsdk:"redirect"`
+	RedirectStatusCode  types.Int64                 `tfsdk:"redirect_status_code"`
+	TeamID              types.String                `tfsdk:"team_id"`
+	WaitForVerification types.Bool                  `tfsdk:"wait_for_verification"`
+	WaitForCertificate  types.Bool                  `tfsdk:"wait_for_certificate"`
+	WaitTimeout         types.String                `tfsdk:"wait_timeout"`
+	Verified            types.Bool                  `tfsdk:"verified"`
+	Verification        []ProjectDomainVerification `tfsdk:"verification"`
+}
+
+// ProjectDomainVerification is a DNS record required to verify a project domain.
+type ProjectDomainVerification struct {
+	Type   types.String `tfsdk:"type"`
+	Domain types.String `tfsdk:"domain"`
+	Value  types.String `tfsdk:"value"`
+	Reason types.String `tfsdk:"reason"`
 }
 
-func convertResponseToProjectDomain(response client.ProjectDomainResponse) ProjectDomain {
+// convertResponseToProjectDomain converts a project domain response into terraform state. The settings for
+// waiting are not part of the response, so these are kept from the previous plan or state.
+func convertResponseToProjectDomain(response client.ProjectDomainResponse, previous ProjectDomain) ProjectDomain {
+	verification := []ProjectDomainVerification{}
+	for _, v := range response.Verification {
+		verification = append(verification, ProjectDomainVerification{
+			Type:   types.StringValue(v.Type),
+			Domain: types.StringValue(v.Domain),
+			Value:  types.StringValue(v.Value),
+			Reason: types.StringValue(v.Reason),
+		})
+	}
 	return ProjectDomain{
-		Domain:             types.StringValue(response.Name),
-		GitBranch:          types.StringPointerValue(response.GitBranch),
-		ID:                 types.StringValue(response.Name),
-		ProjectID:          types.StringValue(response.ProjectID),
-		Redirect:           types.StringPointerValue(response.Redirect),
-		RedirectStatusCode: types.Int64PointerValue(response.RedirectStatusCode),
-		TeamID:             toTeamID(response.TeamID),
+		Domain:              types.StringValue(response.Name),
+		GitBranch:           types.StringPointerValue(response.GitBranch),
+		ID:                  types.StringValue(response.Name),
+		ProjectID:           types.StringValue(response.ProjectID),
+		Redirect:            types.StringPointerValue(response.Redirect),
+		RedirectStatusCode:  types.Int64PointerValue(response.RedirectStatusCode),
+		TeamID:              toTeamID(response.TeamID),
+		WaitForVerification: previous.WaitForVerification,
+		WaitForCertificate:  previous.WaitForCertificate,
+		WaitTimeout:         previous.WaitTimeout,
+		Verified:            types.BoolValue(response.Verified),
+		Verification:        verification,
+	}
+}
+
+// projectDomainPollInterval is how often a project domain is checked while waiting for it to be verified, or
+// for its certificate to be issued.
+var projectDomainPollInterval = 5 * time.Second
+
+// wait waits for a project domain to be verified, and optionally for it to serve a valid certificate, if the
+// plan asks for it. Verification is retried on each poll, as Vercel otherwise only checks periodically.
+func (r *projectDomainResource) wait(ctx context.Context, plan ProjectDomain, out client.ProjectDomainResponse) (client.ProjectDomainResponse, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	waitForCertificate := plan.WaitForCertificate.ValueBool()
+	if !plan.WaitForVerification.ValueBool() && !waitForCertificate {
+		return out, diags
+	}
+
+	timeout := 15 * time.Minute
+	if !plan.WaitTimeout.IsNull() {
+		// The timeout has already been validated.
+		timeout, _ = time.ParseDuration(plan.WaitTimeout.ValueString())
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	projectID, domain, teamID := plan.ProjectID.ValueString(), plan.Domain.ValueString(), plan.TeamID.ValueString()
+	sleep := func() bool {
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(projectDomainPollInterval):
+			return true
+		}
+	}
+
+	for !out.Verified {
+		tflog.Info(ctx, "waiting for project domain verification", map[string]interface{}{
+			"project_id": projectID,
+			"domain":     domain,
+		})
+		if verified, err := r.client.VerifyProjectDomain(ctx, projectID, domain, teamID); err == nil {
+			out = verified
+			continue
+		}
+		if !sleep() {
+			var records []string
+			for _, v := range out.Verification {
+				records = append(records, fmt.Sprintf("%s %s %s", v.Domain, v.Type, v.Value))
+			}
+			diags.AddError(
+				"Timed out waiting for project domain verification",
+				fmt.Sprintf("The domain %s was not verified within %s. Create the following DNS records to verify it:\n%s", domain, timeout, strings.Join(records, "\n")),
+			)
+			return out, diags
+		}
+		latest, err := r.client.GetProjectDomain(ctx, projectID, domain, teamID)
+		if err != nil && ctx.Err() == nil {
+			diags.AddError(
+				"Error reading project domain",
+				fmt.Sprintf("Could not get domain %s for project %s, unexpected error: %s", domain, projectID, err),
+			)
+			return out, diags
+		}
+		if err == nil {
+			out = latest
+		}
+	}
+	if !waitForCertificate {
+		return out, diags
+	}
+
+	for {
+		config, err := r.client.GetDomainConfig(ctx, domain, projectID, teamID)
+		if err != nil && ctx.Err() == nil {
+			diags.AddError(
+				"Error reading domain config",
+				fmt.Sprintf("Could not read the configuration of domain %s, unexpected error: %s", domain, err),
+			)
+			return out, diags
+		}
+		reason := "the domain is misconfigured. Use the `vercel_domain_config` data source to see how it should be configured"
+		if err == nil && !config.Misconfigured {
+			if err = checkServedCertificate(ctx, domain); err == nil {
+				return out, diags
+			}
+			reason = fmt.Sprintf("the domain is not serving a valid certificate: %s", err)
+		}
+		tflog.Info(ctx, "waiting for project domain certificate", map[string]interface{}{
+			"project_id": projectID,
+			"domain":     domain,
+			"reason":     reason,
+		})
+		if !sleep() {
+			diags.AddError(
+				"Timed out waiting for project domain certificate",
+				fmt.Sprintf("The domain %s was not serving a valid certificate within %s, as %s.", domain, timeout, reason),
+			)
+			return out, diags
+		}
+	}
+}
+
+// checkServedCertificate connects to a domain over HTTPS, and checks that it serves a certificate that is valid
+// for the domain and trusted by the system.
+func checkServedCertificate(ctx context.Context, domain string) error {
+	dialer := &tls.Dialer{
+		Config: &tls.Config{
+			ServerName: domain,
+		},
+	}
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(domain, "443"))
+	if err != nil {
+		return err
 	}
+	return conn.Close()
 }
 
 func (p *ProjectDomain) toCreateRequest() client.CreateProjectDomainRequest {
@@ -138,6 +340,24 @@ func (p *ProjectDomain) toUpdateRequest() client.UpdateProjectDomainRequest {
 	}
 }
 
+// ValidateConfig checks that a certificate is only waited for on domains that Vercel can serve one for.
+func (r *projectDomainResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var domain types.String
+	var waitForCertificate types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("domain"), &domain)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("wait_for_certificate"), &waitForCertificate)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if waitForCertificate.ValueBool() && strings.HasPrefix(domain.ValueString(), "*.") {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("wait_for_certificate"),
+			"Invalid Project Domain",
+			fmt.Sprintf("`wait_for_certificate` is not supported for the wildcard domain %s, as the certificate cannot be checked by connecting to it.", domain.ValueString()),
+		)
+	}
+}
+
 // Create will create a project domain within Vercel.
 // This is called automatically by the provider when a new resource should be created.
 func (r *projectDomainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -171,7 +391,7 @@ func (r *projectDomainResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
-	result := convertResponseToProjectDomain(out)
+	result := convertResponseToProjectDomain(out, plan)
 	tflog.Info(ctx, "added domain to project", map[string]interface{}{
 		"project_id": result.ProjectID.ValueString(),
 		"domain":     result.Domain.ValueString(),
@@ -183,6 +403,11 @@ func (r *projectDomainResource) Create(ctx context.Context, req resource.CreateR
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	out, diags = r.wait(ctx, plan, out)
+	resp.Diagnostics.Append(diags...)
+	diags = resp.State.Set(ctx, convertResponseToProjectDomain(out, plan))
+	resp.Diagnostics.Append(diags...)
 }
 
 // Read will read a project domain from the vercel API and provide terraform with information about it.
@@ -212,7 +437,7 @@ func (r *projectDomainResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
-	result := convertResponseToProjectDomain(out)
+	result := convertResponseToProjectDomain(out, state)
 	tflog.Info(ctx, "read project domain", map[string]interface{}{
 		"project_id": result.ProjectID.ValueString(),
 		"domain":     result.Domain.ValueString(),
@@ -254,7 +479,10 @@ func (r *projectDomainResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
-	result := convertResponseToProjectDomain(out)
+	out, diags = r.wait(ctx, plan, out)
+	resp.Diagnostics.Append(diags...)
+
+	result := convertResponseToProjectDomain(out, plan)
 	tflog.Info(ctx, "update project domain", map[string]interface{}{
 		"project_id": result.ProjectID.ValueString(),
 		"domain":     result.Domain.ValueString(),
@@ -327,7 +555,11 @@ func (r *projectDomainResource) ImportState(ctx context.Context, req resource.Im
 		return
 	}
 
-	result := convertResponseToProjectDomain(out)
+	result := convertResponseToProjectDomain(out, ProjectDomain{
+		WaitForVerification: types.BoolNull(),
+		WaitForCertificate:  types.BoolNull(),
+		WaitTimeout:         types.StringNull(),
+	})
 	tflog.Info(ctx, "imported project domain", map[string]interface{}{
 		"project_id": result.ProjectID.ValueString(),
 		"domain":     result.Domain.ValueString(),
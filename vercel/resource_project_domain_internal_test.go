@@ -0,0 +1,75 @@
+package vercel
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+// newTestProjectDomain adds a domain to a new project, returning a resource using the in-memory client and a
+// plan that waits for the domain to be verified.
+func newTestProjectDomain(t *testing.T, attempts int) (*projectDomainResource, ProjectDomain, client.ProjectDomainResponse) {
+	t.Helper()
+	ctx := context.Background()
+	fake := newFakeClient()
+	fake.DomainVerificationAttempts["example.com"] = attempts
+
+	project, err := fake.CreateProject(ctx, "team_a", client.CreateProjectRequest{Name: "project"})
+	if err != nil {
+		t.Fatalf("unable to create project: %s", err)
+	}
+	out, err := fake.CreateProjectDomain(ctx, project.ID, "team_a", client.CreateProjectDomainRequest{Name: "example.com"})
+	if err != nil {
+		t.Fatalf("unable to create project domain: %s", err)
+	}
+	if out.Verified {
+		t.Fatalf("expected the project domain to be created unverified")
+	}
+
+	plan := ProjectDomain{
+		Domain:              types.StringValue("example.com"),
+		ProjectID:           types.StringValue(project.ID),
+		TeamID:              types.StringValue("team_a"),
+		WaitForVerification: types.BoolValue(true),
+		WaitForCertificate:  types.BoolNull(),
+		WaitTimeout:         types.StringNull(),
+	}
+	return &projectDomainResource{client: fake}, plan, out
+}
+
+func TestProjectDomainWaitForVerification(t *testing.T) {
+	defer func(interval time.Duration) { projectDomainPollInterval = interval }(projectDomainPollInterval)
+	projectDomainPollInterval = time.Millisecond
+
+	r, plan, out := newTestProjectDomain(t, 3)
+	out, diags := r.wait(context.Background(), plan, out)
+	if diags.HasError() {
+		t.Fatalf("unexpected error waiting for verification: %v", diags)
+	}
+	if !out.Verified || len(out.Verification) != 0 {
+		t.Errorf("expected the project domain to be verified, got %+v", out)
+	}
+}
+
+func TestProjectDomainWaitForVerificationTimeout(t *testing.T) {
+	defer func(interval time.Duration) { projectDomainPollInterval = interval }(projectDomainPollInterval)
+	projectDomainPollInterval = time.Millisecond
+
+	r, plan, out := newTestProjectDomain(t, 1000000)
+	plan.WaitTimeout = types.StringValue("20ms")
+	out, diags := r.wait(context.Background(), plan, out)
+	if !diags.HasError() {
+		t.Fatalf("expected waiting for verification to time out")
+	}
+	if out.Verified {
+		t.Errorf("expected the project domain to still be unverified")
+	}
+	detail := diags.Errors()[0].Detail()
+	if diags.Errors()[0].Summary() != "Timed out waiting for project domain verification" || !strings.Contains(detail, "_vercel.example.com TXT vc-domain-verify=example.com") {
+		t.Errorf("expected a timeout listing the verification records, got %s: %s", diags.Errors()[0].Summary(), detail)
+	}
+}
@@ -3,9 +3,9 @@ package vercel_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/vercel/terraform-provider-vercel/client"
@@ -18,8 +18,8 @@ func TestAcc_ProjectDomain(t *testing.T) {
 		testTeamID = resource.TestCheckResourceAttr("vercel_project.test", "team_id", testTeam())
 	}
 
-	projectSuffix := acctest.RandString(16)
-	domain := acctest.RandString(30) + ".vercel.app"
+	projectSuffix := randString(t, 16)
+	domain := randString(t, 30) + ".vercel.app"
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -60,6 +60,56 @@ func TestAcc_ProjectDomain(t *testing.T) {
 
 }
 
+func TestAcc_ProjectDomainWaitForCertificate(t *testing.T) {
+	if replaying() {
+		t.Skip("Waiting for a certificate connects to the domain directly, so cannot be replayed")
+	}
+	projectSuffix := randString(t, 16)
+	domain := randString(t, 30) + ".vercel.app"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             noopDestroyCheck,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProjectDomainConfigWait(projectSuffix, domain, "1s", teamIDConfig()),
+				ExpectError: regexp.MustCompile("Value must be at least 10s"),
+			},
+			{
+				Config:      testAccProjectDomainConfigWait(projectSuffix, "*."+domain, "5m", teamIDConfig()),
+				ExpectError: regexp.MustCompile("not supported for the wildcard domain"),
+			},
+			{
+				Config: testAccProjectDomainConfigWait(projectSuffix, domain, "5m", teamIDConfig()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccProjectDomainExists("vercel_project.test", testTeam(), domain),
+					resource.TestCheckResourceAttr("vercel_project_domain.test", "verified", "true"),
+					resource.TestCheckResourceAttr("vercel_project_domain.test", "verification.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccProjectDomainConfigWait(projectSuffix, domain, timeout, extra string) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "test" {
+  name = "test-acc-domain-%[1]s"
+  %[4]s
+}
+
+resource "vercel_project_domain" "test" {
+  domain     = "%[2]s"
+  project_id = vercel_project.test.id
+  %[4]s
+
+  wait_for_certificate = true
+  wait_timeout         = "%[3]s"
+}
+`, projectSuffix, domain, timeout, extra)
+}
+
 func testAccProjectDomainExists(n, teamID, domain string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
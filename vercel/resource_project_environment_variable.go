@@ -18,10 +18,11 @@ import (
 )
 
 var (
-	_ resource.Resource                = &projectEnvironmentVariableResource{}
-	_ resource.ResourceWithConfigure   = &projectEnvironmentVariableResource{}
-	_ resource.ResourceWithImportState = &projectEnvironmentVariableResource{}
-	_ resource.ResourceWithModifyPlan  = &projectEnvironmentVariableResource{}
+	_ resource.Resource                   = &projectEnvironmentVariableResource{}
+	_ resource.ResourceWithConfigure      = &projectEnvironmentVariableResource{}
+	_ resource.ResourceWithImportState    = &projectEnvironmentVariableResource{}
+	_ resource.ResourceWithModifyPlan     = &projectEnvironmentVariableResource{}
+	_ resource.ResourceWithValidateConfig = &projectEnvironmentVariableResource{}
 )
 
 func newProjectEnvironmentVariableResource() resource.Resource {
@@ -29,7 +30,7 @@ func newProjectEnvironmentVariableResource() resource.Resource {
 }
 
 type projectEnvironmentVariableResource struct {
-	client *client.Client
+	client projectClient
 }
 
 func (r *projectEnvironmentVariableResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -42,11 +43,11 @@ func (r *projectEnvironmentVariableResource) Configure(ctx context.Context, req
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(projectClient)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected a client implementing client.ProjectsAPI and client.TeamsAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -83,10 +84,20 @@ At this time you cannot use a Vercel Project resource with in-line ` + "`environ
 				Description:   "The name of the Environment Variable.",
 			},
 			"value": schema.StringAttribute{
-				Required:    true,
-				Description: "The value of the Environment Variable.",
+				Optional:    true,
+				Description: "The value of the Environment Variable. Exactly one of `value` or `value_wo` must be set.",
 				Sensitive:   true,
 			},
+			"value_wo": schema.StringAttribute{
+				Optional:    true,
+				WriteOnly:   true,
+				Description: "The value of the Environment Variable, as a write-only attribute. This is sent to Vercel, but is never stored in the Terraform plan or state. Requires Terraform 1.11 or later. Exactly one of `value` or `value_wo` must be set.",
+				Sensitive:   true,
+			},
+			"value_wo_version": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The version of `value_wo`. As `value_wo` is not stored in state, this must be changed whenever `value_wo` changes in order for the new value to be sent to Vercel. Required when `value_wo` is set.",
+			},
 			"git_branch": schema.StringAttribute{
 				Optional:    true,
 				Description: "The git branch of the Environment Variable.",
@@ -120,14 +131,29 @@ At this time you cannot use a Vercel Project resource with in-line ` + "`environ
 
 // ProjectEnvironmentVariable reflects the state terraform stores internally for a project environment variable.
 type ProjectEnvironmentVariable struct {
-	Target    []types.String `tfsdk:"target"`
-	GitBranch types.String   `tfsdk:"git_branch"`
-	Key       types.String   `tfsdk:"key"`
-	Value     types.String   `tfsdk:"value"`
-	TeamID    types.String   `tfsdk:"team_id"`
-	ProjectID types.String   `tfsdk:"project_id"`
-	ID        types.String   `tfsdk:"id"`
-	Sensitive types.Bool     `tfsdk:"sensitive"`
+	Target         []types.String `tfsdk:"target"`
+	GitBranch      types.String   `tfsdk:"git_branch"`
+	Key            types.String   `tfsdk:"key"`
+	Value          types.String   `tfsdk:"value"`
+	ValueWO        types.String   `tfsdk:"value_wo"`
+	ValueWOVersion types.Int64    `tfsdk:"value_wo_version"`
+	TeamID         types.String   `tfsdk:"team_id"`
+	ProjectID      types.String   `tfsdk:"project_id"`
+	ID             types.String   `tfsdk:"id"`
+	Sensitive      types.Bool     `tfsdk:"sensitive"`
+}
+
+// ValidateConfig validates the Resource configuration.
+func (r *projectEnvironmentVariableResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	resp.Diagnostics.Append(validateWriteOnlyValueConfig(ctx, req.Config)...)
+}
+
+// value returns the value that should be sent to Vercel, preferring the write-only value if one is set.
+func (e *ProjectEnvironmentVariable) value() string {
+	if !e.ValueWO.IsNull() {
+		return e.ValueWO.ValueString()
+	}
+	return e.Value.ValueString()
 }
 
 func (r *projectEnvironmentVariableResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
@@ -189,7 +215,7 @@ func (e *ProjectEnvironmentVariable) toCreateEnvironmentVariableRequest() client
 	return client.CreateEnvironmentVariableRequest{
 		EnvironmentVariable: client.EnvironmentVariableRequest{
 			Key:       e.Key.ValueString(),
-			Value:     e.Value.ValueString(),
+			Value:     e.value(),
 			Target:    target,
 			GitBranch: e.GitBranch.ValueStringPointer(),
 			Type:      envVariableType,
@@ -214,7 +240,7 @@ func (e *ProjectEnvironmentVariable) toUpdateEnvironmentVariableRequest() client
 	}
 
 	return client.UpdateEnvironmentVariableRequest{
-		Value:     e.Value.ValueString(),
+		Value:     e.value(),
 		Target:    target,
 		GitBranch: e.GitBranch.ValueStringPointer(),
 		Type:      envVariableType,
@@ -227,7 +253,8 @@ func (e *ProjectEnvironmentVariable) toUpdateEnvironmentVariableRequest() client
 // convertResponseToProjectEnvironmentVariable is used to populate terraform state based on an API response.
 // Where possible, values from the API response are used to populate state. If not possible,
 // values from plan are used.
-func convertResponseToProjectEnvironmentVariable(response client.EnvironmentVariable, projectID types.String, v types.String) ProjectEnvironmentVariable {
+// If a write-only value is in use (indicated by a value_wo_version), the value is never stored in state.
+func convertResponseToProjectEnvironmentVariable(response client.EnvironmentVariable, projectID types.String, v types.String, valueWOVersion types.Int64) ProjectEnvironmentVariable {
 	target := []types.String{}
 	for _, t := range response.Target {
 		target = append(target, types.StringValue(t))
@@ -237,16 +264,21 @@ func convertResponseToProjectEnvironmentVariable(response client.EnvironmentVari
 	if response.Type == "sensitive" {
 		value = v
 	}
+	if !valueWOVersion.IsNull() {
+		value = types.StringNull()
+	}
 
 	return ProjectEnvironmentVariable{
-		Target:    target,
-		GitBranch: types.StringPointerValue(response.GitBranch),
-		Key:       types.StringValue(response.Key),
-		Value:     value,
-		TeamID:    toTeamID(response.TeamID),
-		ProjectID: projectID,
-		ID:        types.StringValue(response.ID),
-		Sensitive: types.BoolValue(response.Type == "sensitive"),
+		Target:         target,
+		GitBranch:      types.StringPointerValue(response.GitBranch),
+		Key:            types.StringValue(response.Key),
+		Value:          value,
+		ValueWO:        types.StringNull(),
+		ValueWOVersion: valueWOVersion,
+		TeamID:         toTeamID(response.TeamID),
+		ProjectID:      projectID,
+		ID:             types.StringValue(response.ID),
+		Sensitive:      types.BoolValue(response.Type == "sensitive"),
 	}
 }
 
@@ -260,6 +292,12 @@ func (r *projectEnvironmentVariableResource) Create(ctx context.Context, req res
 		return
 	}
 
+	plan.ValueWO, diags = getWriteOnlyValue(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	_, err := r.client.GetProject(ctx, plan.ProjectID.ValueString(), plan.TeamID.ValueString())
 	if client.NotFound(err) {
 		resp.Diagnostics.AddError(
@@ -278,7 +316,7 @@ func (r *projectEnvironmentVariableResource) Create(ctx context.Context, req res
 		return
 	}
 
-	result := convertResponseToProjectEnvironmentVariable(response, plan.ProjectID, plan.Value)
+	result := convertResponseToProjectEnvironmentVariable(response, plan.ProjectID, plan.Value, plan.ValueWOVersion)
 
 	tflog.Info(ctx, "created project environment variable", map[string]interface{}{
 		"id":         result.ID.ValueString(),
@@ -321,7 +359,7 @@ func (r *projectEnvironmentVariableResource) Read(ctx context.Context, req resou
 		return
 	}
 
-	result := convertResponseToProjectEnvironmentVariable(out, state.ProjectID, state.Value)
+	result := convertResponseToProjectEnvironmentVariable(out, state.ProjectID, state.Value, state.ValueWOVersion)
 	tflog.Info(ctx, "read project environment variable", map[string]interface{}{
 		"id":         result.ID.ValueString(),
 		"team_id":    result.TeamID.ValueString(),
@@ -344,6 +382,22 @@ func (r *projectEnvironmentVariableResource) Update(ctx context.Context, req res
 		return
 	}
 
+	var state ProjectEnvironmentVariable
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A write-only value is only sent to Vercel when its version changes, otherwise the existing value is kept.
+	if !plan.ValueWOVersion.IsNull() && !plan.ValueWOVersion.Equal(state.ValueWOVersion) {
+		plan.ValueWO, diags = getWriteOnlyValue(ctx, req.Config)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	response, err := r.client.UpdateEnvironmentVariable(ctx, plan.toUpdateEnvironmentVariableRequest())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -353,7 +407,7 @@ func (r *projectEnvironmentVariableResource) Update(ctx context.Context, req res
 		return
 	}
 
-	result := convertResponseToProjectEnvironmentVariable(response, plan.ProjectID, plan.Value)
+	result := convertResponseToProjectEnvironmentVariable(response, plan.ProjectID, plan.Value, plan.ValueWOVersion)
 
 	tflog.Info(ctx, "updated project environment variable", map[string]interface{}{
 		"id":         result.ID.ValueString(),
@@ -439,7 +493,7 @@ func (r *projectEnvironmentVariableResource) ImportState(ctx context.Context, re
 		return
 	}
 
-	result := convertResponseToProjectEnvironmentVariable(out, types.StringValue(projectID), types.StringNull())
+	result := convertResponseToProjectEnvironmentVariable(out, types.StringValue(projectID), types.StringNull(), types.Int64Null())
 	tflog.Info(ctx, "imported project environment variable", map[string]interface{}{
 		"team_id":    result.TeamID.ValueString(),
 		"project_id": result.ProjectID.ValueString(),
@@ -5,9 +5,9 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func testAccProjectEnvironmentVariableExists(n, teamID string) resource.TestCheckFunc {
@@ -51,7 +51,7 @@ func testAccProjectEnvironmentVariablesDoNotExist(n, teamID string) resource.Tes
 }
 
 func TestAcc_ProjectEnvironmentVariables(t *testing.T) {
-	nameSuffix := acctest.RandString(16)
+	nameSuffix := randString(t, 16)
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -254,3 +254,80 @@ resource "vercel_project" "example" {
 }
 `, projectName, testGithubRepo(), teamIDConfig())
 }
+
+func testAccProjectEnvironmentVariableValue(n, teamID, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		env, err := testClient().GetEnvironmentVariable(context.TODO(), rs.Primary.Attributes["project_id"], teamID, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if env.Value != value {
+			return fmt.Errorf("expected environment variable value to be %q, got %q", value, env.Value)
+		}
+		return nil
+	}
+}
+
+func TestAcc_ProjectEnvironmentVariableWriteOnly(t *testing.T) {
+	nameSuffix := randString(t, 16)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccProjectDestroy("vercel_project.example", testTeam()),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectEnvironmentVariableWriteOnlyConfig(nameSuffix, "bar", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccProjectEnvironmentVariableExists("vercel_project_environment_variable.example", testTeam()),
+					testAccProjectEnvironmentVariableValue("vercel_project_environment_variable.example", testTeam(), "bar"),
+					resource.TestCheckNoResourceAttr("vercel_project_environment_variable.example", "value"),
+					resource.TestCheckNoResourceAttr("vercel_project_environment_variable.example", "value_wo"),
+					resource.TestCheckResourceAttr("vercel_project_environment_variable.example", "value_wo_version", "1"),
+				),
+			},
+			{
+				// Changing the value without changing the version does not send the new value.
+				Config: testAccProjectEnvironmentVariableWriteOnlyConfig(nameSuffix, "baz", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccProjectEnvironmentVariableValue("vercel_project_environment_variable.example", testTeam(), "bar"),
+				),
+			},
+			{
+				Config: testAccProjectEnvironmentVariableWriteOnlyConfig(nameSuffix, "baz", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccProjectEnvironmentVariableValue("vercel_project_environment_variable.example", testTeam(), "baz"),
+					resource.TestCheckNoResourceAttr("vercel_project_environment_variable.example", "value"),
+					resource.TestCheckResourceAttr("vercel_project_environment_variable.example", "value_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccProjectEnvironmentVariableWriteOnlyConfig(projectName, value string, version int) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "example" {
+	name = "test-acc-example-project-%[1]s"
+	%[2]s
+}
+
+resource "vercel_project_environment_variable" "example" {
+	project_id       = vercel_project.example.id
+	%[2]s
+	key              = "foo"
+	value_wo         = "%[3]s"
+	value_wo_version = %[4]d
+	target           = ["production"]
+}
+`, projectName, teamIDConfig(), value, version)
+}
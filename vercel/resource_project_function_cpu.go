@@ -26,7 +26,7 @@ func newProjectFunctionCPUResource() resource.Resource {
 }
 
 type projectFunctionCPUResource struct {
-	client *client.Client
+	client client.ProjectsAPI
 }
 
 func (r *projectFunctionCPUResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -39,11 +39,11 @@ func (r *projectFunctionCPUResource) Configure(ctx context.Context, req resource
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(client.ProjectsAPI)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected client.ProjectsAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
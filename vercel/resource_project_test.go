@@ -6,7 +6,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
@@ -21,7 +20,7 @@ func TestAcc_Project(t *testing.T) {
 	if testTeam() != "" {
 		testTeamID = resource.TestCheckResourceAttr("vercel_project.test", "team_id", testTeam())
 	}
-	projectSuffix := acctest.RandString(16)
+	projectSuffix := randString(t, 16)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -101,7 +100,7 @@ func TestAcc_Project(t *testing.T) {
 }
 
 func TestAcc_ProjectAddingEnvAfterInitialCreation(t *testing.T) {
-	projectSuffix := acctest.RandString(16)
+	projectSuffix := randString(t, 16)
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -124,7 +123,7 @@ func TestAcc_ProjectAddingEnvAfterInitialCreation(t *testing.T) {
 }
 
 func TestAcc_ProjectUpdateResourceConfig(t *testing.T) {
-	projectSuffix := acctest.RandString(16)
+	projectSuffix := randString(t, 16)
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -193,7 +192,7 @@ func TestAcc_ProjectUpdateResourceConfig(t *testing.T) {
 }
 
 func TestAcc_ProjectWithGitRepository(t *testing.T) {
-	projectSuffix := acctest.RandString(16)
+	projectSuffix := randString(t, 16)
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -234,7 +233,7 @@ func TestAcc_ProjectWithGitRepository(t *testing.T) {
 }
 
 func TestAcc_ProjectWithVercelAuthAndPasswordProtectionAndTrustedIps(t *testing.T) {
-	projectSuffix := acctest.RandString(16)
+	projectSuffix := randString(t, 16)
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -344,7 +343,7 @@ func getProjectImportID(n string) resource.ImportStateIdFunc {
 }
 
 func TestAcc_ProjectImport(t *testing.T) {
-	projectSuffix := acctest.RandString(16)
+	projectSuffix := randString(t, 16)
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
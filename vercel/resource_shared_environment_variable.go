@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -20,18 +21,26 @@ import (
 )
 
 var (
-	_ resource.Resource                = &sharedEnvironmentVariableResource{}
-	_ resource.ResourceWithConfigure   = &sharedEnvironmentVariableResource{}
-	_ resource.ResourceWithImportState = &sharedEnvironmentVariableResource{}
-	_ resource.ResourceWithModifyPlan  = &sharedEnvironmentVariableResource{}
+	_ resource.Resource                   = &sharedEnvironmentVariableResource{}
+	_ resource.ResourceWithConfigure      = &sharedEnvironmentVariableResource{}
+	_ resource.ResourceWithImportState    = &sharedEnvironmentVariableResource{}
+	_ resource.ResourceWithModifyPlan     = &sharedEnvironmentVariableResource{}
+	_ resource.ResourceWithValidateConfig = &sharedEnvironmentVariableResource{}
 )
 
 func newSharedEnvironmentVariableResource() resource.Resource {
 	return &sharedEnvironmentVariableResource{}
 }
 
+// sharedEnvironmentVariableClient is the subset of the Vercel client used by the shared environment variable
+// resource.
+type sharedEnvironmentVariableClient interface {
+	client.SharedEnvironmentVariablesAPI
+	client.TeamsAPI
+}
+
 type sharedEnvironmentVariableResource struct {
-	client *client.Client
+	client sharedEnvironmentVariableClient
 }
 
 func (r *sharedEnvironmentVariableResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
@@ -87,11 +96,11 @@ func (r *sharedEnvironmentVariableResource) Configure(ctx context.Context, req r
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(sharedEnvironmentVariableClient)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected a client implementing client.SharedEnvironmentVariablesAPI and client.TeamsAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -125,14 +134,26 @@ For more detailed information, please see the [Vercel documentation](https://ver
 				Description:   "The name of the Environment Variable.",
 			},
 			"value": schema.StringAttribute{
-				Required:    true,
-				Description: "The value of the Environment Variable.",
+				Optional:    true,
+				Description: "The value of the Environment Variable. Exactly one of `value` or `value_wo` must be set.",
 				Sensitive:   true,
 			},
+			"value_wo": schema.StringAttribute{
+				Optional:    true,
+				WriteOnly:   true,
+				Description: "The value of the Environment Variable, as a write-only attribute. This is sent to Vercel, but is never stored in the Terraform plan or state. Requires Terraform 1.11 or later. Exactly one of `value` or `value_wo` must be set.",
+				Sensitive:   true,
+			},
+			"value_wo_version": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The version of `value_wo`. As `value_wo` is not stored in state, this must be changed whenever `value_wo` changes in order for the new value to be sent to Vercel. Required when `value_wo` is set.",
+			},
 			"project_ids": schema.SetAttribute{
-				Required:    true,
-				Description: "The ID of the Vercel project.",
-				ElementType: types.StringType,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The IDs of the Vercel projects that the Shared Environment Variable is linked to. If omitted, the linked projects are not managed by this resource, and `vercel_shared_environment_variable_project_link` resources can be used to link projects individually instead.",
+				ElementType:   types.StringType,
+				PlanModifiers: []planmodifier.Set{setplanmodifier.UseStateForUnknown()},
 			},
 			"team_id": schema.StringAttribute{
 				Optional:      true,
@@ -157,13 +178,28 @@ For more detailed information, please see the [Vercel documentation](https://ver
 
 // SharedEnvironmentVariable reflects the state terraform stores internally for a project environment variable.
 type SharedEnvironmentVariable struct {
-	Target     types.Set    `tfsdk:"target"`
-	Key        types.String `tfsdk:"key"`
-	Value      types.String `tfsdk:"value"`
-	TeamID     types.String `tfsdk:"team_id"`
-	ProjectIDs types.Set    `tfsdk:"project_ids"`
-	ID         types.String `tfsdk:"id"`
-	Sensitive  types.Bool   `tfsdk:"sensitive"`
+	Target         types.Set    `tfsdk:"target"`
+	Key            types.String `tfsdk:"key"`
+	Value          types.String `tfsdk:"value"`
+	ValueWO        types.String `tfsdk:"value_wo"`
+	ValueWOVersion types.Int64  `tfsdk:"value_wo_version"`
+	TeamID         types.String `tfsdk:"team_id"`
+	ProjectIDs     types.Set    `tfsdk:"project_ids"`
+	ID             types.String `tfsdk:"id"`
+	Sensitive      types.Bool   `tfsdk:"sensitive"`
+}
+
+// ValidateConfig validates the Resource configuration.
+func (r *sharedEnvironmentVariableResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	resp.Diagnostics.Append(validateWriteOnlyValueConfig(ctx, req.Config)...)
+}
+
+// value returns the value that should be sent to Vercel, preferring the write-only value if one is set.
+func (e *SharedEnvironmentVariable) value() string {
+	if !e.ValueWO.IsNull() {
+		return e.ValueWO.ValueString()
+	}
+	return e.Value.ValueString()
 }
 
 func (e *SharedEnvironmentVariable) toCreateSharedEnvironmentVariableRequest(ctx context.Context, diags diag.Diagnostics) (req client.CreateSharedEnvironmentVariableRequest, ok bool) {
@@ -174,11 +210,13 @@ func (e *SharedEnvironmentVariable) toCreateSharedEnvironmentVariableRequest(ctx
 		return req, false
 	}
 
-	var projectIDs []string
-	ds = e.ProjectIDs.ElementsAs(ctx, &projectIDs, false)
-	diags = append(diags, ds...)
-	if diags.HasError() {
-		return req, false
+	projectIDs := []string{}
+	if !e.ProjectIDs.IsUnknown() && !e.ProjectIDs.IsNull() {
+		ds = e.ProjectIDs.ElementsAs(ctx, &projectIDs, false)
+		diags = append(diags, ds...)
+		if diags.HasError() {
+			return req, false
+		}
 	}
 
 	var envVariableType string
@@ -197,7 +235,7 @@ func (e *SharedEnvironmentVariable) toCreateSharedEnvironmentVariableRequest(ctx
 			EnvironmentVariables: []client.SharedEnvVarRequest{
 				{
 					Key:   e.Key.ValueString(),
-					Value: e.Value.ValueString(),
+					Value: e.value(),
 				},
 			},
 		},
@@ -213,11 +251,13 @@ func (e *SharedEnvironmentVariable) toUpdateSharedEnvironmentVariableRequest(ctx
 		return req, false
 	}
 
-	var projectIDs []string
-	ds = e.ProjectIDs.ElementsAs(ctx, &projectIDs, false)
-	diags = append(diags, ds...)
-	if diags.HasError() {
-		return req, false
+	projectIDs := []string{}
+	if !e.ProjectIDs.IsUnknown() && !e.ProjectIDs.IsNull() {
+		ds = e.ProjectIDs.ElementsAs(ctx, &projectIDs, false)
+		diags = append(diags, ds...)
+		if diags.HasError() {
+			return req, false
+		}
 	}
 	var envVariableType string
 
@@ -227,7 +267,7 @@ func (e *SharedEnvironmentVariable) toUpdateSharedEnvironmentVariableRequest(ctx
 		envVariableType = "encrypted"
 	}
 	return client.UpdateSharedEnvironmentVariableRequest{
-		Value:      e.Value.ValueString(),
+		Value:      e.value(),
 		Target:     target,
 		Type:       envVariableType,
 		TeamID:     e.TeamID.ValueString(),
@@ -239,7 +279,8 @@ func (e *SharedEnvironmentVariable) toUpdateSharedEnvironmentVariableRequest(ctx
 // convertResponseToSharedEnvironmentVariable is used to populate terraform state based on an API response.
 // Where possible, values from the API response are used to populate state. If not possible,
 // values from plan are used.
-func convertResponseToSharedEnvironmentVariable(response client.SharedEnvironmentVariableResponse, v types.String) SharedEnvironmentVariable {
+// If a write-only value is in use (indicated by a value_wo_version), the value is never stored in state.
+func convertResponseToSharedEnvironmentVariable(response client.SharedEnvironmentVariableResponse, v types.String, valueWOVersion types.Int64) SharedEnvironmentVariable {
 	target := []attr.Value{}
 	for _, t := range response.Target {
 		target = append(target, types.StringValue(t))
@@ -254,15 +295,20 @@ func convertResponseToSharedEnvironmentVariable(response client.SharedEnvironmen
 	if response.Type == "sensitive" {
 		value = v
 	}
+	if !valueWOVersion.IsNull() {
+		value = types.StringNull()
+	}
 
 	return SharedEnvironmentVariable{
-		Target:     types.SetValueMust(types.StringType, target),
-		Key:        types.StringValue(response.Key),
-		Value:      value,
-		ProjectIDs: types.SetValueMust(types.StringType, projectIDs),
-		TeamID:     toTeamID(response.TeamID),
-		ID:         types.StringValue(response.ID),
-		Sensitive:  types.BoolValue(response.Type == "sensitive"),
+		Target:         types.SetValueMust(types.StringType, target),
+		Key:            types.StringValue(response.Key),
+		Value:          value,
+		ValueWO:        types.StringNull(),
+		ValueWOVersion: valueWOVersion,
+		ProjectIDs:     types.SetValueMust(types.StringType, projectIDs),
+		TeamID:         toTeamID(response.TeamID),
+		ID:             types.StringValue(response.ID),
+		Sensitive:      types.BoolValue(response.Type == "sensitive"),
 	}
 }
 
@@ -276,6 +322,12 @@ func (r *sharedEnvironmentVariableResource) Create(ctx context.Context, req reso
 		return
 	}
 
+	plan.ValueWO, diags = getWriteOnlyValue(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	request, ok := plan.toCreateSharedEnvironmentVariableRequest(ctx, resp.Diagnostics)
 	if !ok {
 		return
@@ -289,7 +341,7 @@ func (r *sharedEnvironmentVariableResource) Create(ctx context.Context, req reso
 		return
 	}
 
-	result := convertResponseToSharedEnvironmentVariable(response, plan.Value)
+	result := convertResponseToSharedEnvironmentVariable(response, plan.Value, plan.ValueWOVersion)
 
 	tflog.Info(ctx, "created shared environment variable", map[string]interface{}{
 		"id":      result.ID.ValueString(),
@@ -330,7 +382,7 @@ func (r *sharedEnvironmentVariableResource) Read(ctx context.Context, req resour
 		return
 	}
 
-	result := convertResponseToSharedEnvironmentVariable(out, state.Value)
+	result := convertResponseToSharedEnvironmentVariable(out, state.Value, state.ValueWOVersion)
 	tflog.Info(ctx, "read shared environment variable", map[string]interface{}{
 		"id":      result.ID.ValueString(),
 		"team_id": result.TeamID.ValueString(),
@@ -351,10 +403,46 @@ func (r *sharedEnvironmentVariableResource) Update(ctx context.Context, req reso
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	var state SharedEnvironmentVariable
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A write-only value is only sent to Vercel when its version changes, otherwise the existing value is kept.
+	if !plan.ValueWOVersion.IsNull() && !plan.ValueWOVersion.Equal(state.ValueWOVersion) {
+		plan.ValueWO, diags = getWriteOnlyValue(ctx, req.Config)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	request, ok := plan.toUpdateSharedEnvironmentVariableRequest(ctx, resp.Diagnostics)
 	if !ok {
 		return
 	}
+
+	var configProjectIDs types.Set
+	diags = req.Config.GetAttribute(ctx, path.Root("project_ids"), &configProjectIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if configProjectIDs.IsNull() {
+		// The linked projects are not managed by this resource, so preserve whatever is
+		// currently linked, rather than the (potentially stale) value from state.
+		existing, err := r.client.GetSharedEnvironmentVariable(ctx, plan.TeamID.ValueString(), plan.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating shared environment variable",
+				"Could not read existing shared environment variable, unexpected error: "+err.Error(),
+			)
+			return
+		}
+		request.ProjectIDs = existing.ProjectIDs
+	}
 	response, err := r.client.UpdateSharedEnvironmentVariable(ctx, request)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -364,7 +452,7 @@ func (r *sharedEnvironmentVariableResource) Update(ctx context.Context, req reso
 		return
 	}
 
-	result := convertResponseToSharedEnvironmentVariable(response, plan.Value)
+	result := convertResponseToSharedEnvironmentVariable(response, plan.Value, plan.ValueWOVersion)
 
 	tflog.Info(ctx, "updated shared environment variable", map[string]interface{}{
 		"id":      result.ID.ValueString(),
@@ -444,7 +532,7 @@ func (r *sharedEnvironmentVariableResource) ImportState(ctx context.Context, req
 		return
 	}
 
-	result := convertResponseToSharedEnvironmentVariable(out, types.StringNull())
+	result := convertResponseToSharedEnvironmentVariable(out, types.StringNull(), types.Int64Null())
 	tflog.Info(ctx, "imported shared environment variable", map[string]interface{}{
 		"team_id": result.TeamID.ValueString(),
 		"env_id":  result.ID.ValueString(),
@@ -0,0 +1,359 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+var (
+	_ resource.Resource                = &sharedEnvironmentVariableProjectLinkResource{}
+	_ resource.ResourceWithConfigure   = &sharedEnvironmentVariableProjectLinkResource{}
+	_ resource.ResourceWithImportState = &sharedEnvironmentVariableProjectLinkResource{}
+)
+
+func newSharedEnvironmentVariableProjectLinkResource() resource.Resource {
+	return &sharedEnvironmentVariableProjectLinkResource{}
+}
+
+type sharedEnvironmentVariableProjectLinkResource struct {
+	client client.SharedEnvironmentVariablesAPI
+}
+
+func (r *sharedEnvironmentVariableProjectLinkResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_shared_environment_variable_project_link"
+}
+
+func (r *sharedEnvironmentVariableProjectLinkResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.SharedEnvironmentVariablesAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.SharedEnvironmentVariablesAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Schema returns the schema information for a shared environment variable project link resource.
+func (r *sharedEnvironmentVariableProjectLinkResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Links a project to a Shared Environment Variable.
+
+This allows the projects that use a Shared Environment Variable to be managed separately from the Shared Environment Variable itself,
+for example from a different Terraform configuration.
+
+~> When using this resource, the ` + "`project_ids`" + ` field on the ` + "`vercel_shared_environment_variable`" + ` resource should be omitted. Otherwise the two will conflict with each other.
+
+For more detailed information, please see the [Vercel documentation](https://vercel.com/docs/concepts/projects/environment-variables/shared-environment-variables).
+`,
+		Attributes: map[string]schema.Attribute{
+			"shared_environment_variable_id": schema.StringAttribute{
+				Required:      true,
+				Description:   "The ID of the shared environment variable.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"project_id": schema.StringAttribute{
+				Required:      true,
+				Description:   "The ID of the Vercel project.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"team_id": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "The ID of the Vercel team. Shared environment variables require a team.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplaceIfConfigured(), stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+// SharedEnvironmentVariableProjectLink reflects the state terraform stores internally for a shared environment
+// variable project link.
+type SharedEnvironmentVariableProjectLink struct {
+	SharedEnvironmentVariableID types.String `tfsdk:"shared_environment_variable_id"`
+	ProjectID                   types.String `tfsdk:"project_id"`
+	TeamID                      types.String `tfsdk:"team_id"`
+}
+
+// sharedEnvironmentVariableLinkRetries is the number of times a change to the projects linked to a
+// shared environment variable will be attempted before giving up.
+const sharedEnvironmentVariableLinkRetries = 5
+
+// linkSharedEnvironmentVariableProject adds or removes a single project from the list of projects a shared
+// environment variable is linked to.
+//
+// The API only allows the full list of projects to be replaced, so the current list is read, modified, and
+// written back. As other Terraform configurations may be modifying the same shared environment variable at the
+// same time, the write is retried if the API reports a conflict, or if the result does not reflect the change.
+func linkSharedEnvironmentVariableProject(ctx context.Context, c client.SharedEnvironmentVariablesAPI, teamID, envID, projectID string, link bool) (client.SharedEnvironmentVariableResponse, error) {
+	var err error
+	for attempt := 0; attempt < sharedEnvironmentVariableLinkRetries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return client.SharedEnvironmentVariableResponse{}, ctx.Err()
+			case <-time.After(time.Duration(attempt) * time.Second):
+			}
+		}
+
+		var env client.SharedEnvironmentVariableResponse
+		env, err = c.GetSharedEnvironmentVariable(ctx, teamID, envID)
+		if err != nil {
+			return env, err
+		}
+		if contains(env.ProjectIDs, projectID) == link {
+			// Nothing to do, the project is already in the desired state.
+			return env, nil
+		}
+
+		projectIDs := []string{}
+		for _, id := range env.ProjectIDs {
+			if id != projectID {
+				projectIDs = append(projectIDs, id)
+			}
+		}
+		if link {
+			projectIDs = append(projectIDs, projectID)
+		}
+
+		env, err = c.UpdateSharedEnvironmentVariable(ctx, client.UpdateSharedEnvironmentVariableRequest{
+			Type:       env.Type,
+			ProjectIDs: projectIDs,
+			Target:     env.Target,
+			TeamID:     teamID,
+			EnvID:      envID,
+		})
+		if client.Conflict(err) {
+			tflog.Info(ctx, "conflict updating shared environment variable, retrying", map[string]interface{}{
+				"env_id":     envID,
+				"project_id": projectID,
+				"attempt":    attempt,
+			})
+			continue
+		}
+		if err != nil {
+			return env, err
+		}
+		if contains(env.ProjectIDs, projectID) == link {
+			return env, nil
+		}
+		err = fmt.Errorf("the linked projects were modified by another change while updating the link for project %s", projectID)
+	}
+
+	return client.SharedEnvironmentVariableResponse{}, err
+}
+
+// Create will link a project to a shared environment variable.
+// This is called automatically by the provider when a new resource should be created.
+func (r *sharedEnvironmentVariableProjectLinkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan SharedEnvironmentVariableProjectLink
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := linkSharedEnvironmentVariableProject(
+		ctx,
+		r.client,
+		plan.TeamID.ValueString(),
+		plan.SharedEnvironmentVariableID.ValueString(),
+		plan.ProjectID.ValueString(),
+		true,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error linking project to shared environment variable",
+			fmt.Sprintf(
+				"Could not link project %s to shared environment variable %s, unexpected error: %s",
+				plan.ProjectID.ValueString(),
+				plan.SharedEnvironmentVariableID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	result := SharedEnvironmentVariableProjectLink{
+		SharedEnvironmentVariableID: plan.SharedEnvironmentVariableID,
+		ProjectID:                   plan.ProjectID,
+		TeamID:                      toTeamID(out.TeamID),
+	}
+	tflog.Info(ctx, "linked shared environment variable to project", map[string]interface{}{
+		"env_id":     result.SharedEnvironmentVariableID.ValueString(),
+		"project_id": result.ProjectID.ValueString(),
+		"team_id":    result.TeamID.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read will check that the project is still linked to the shared environment variable, and will update terraform
+// with this information.
+func (r *sharedEnvironmentVariableProjectLinkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state SharedEnvironmentVariableProjectLink
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := r.client.GetSharedEnvironmentVariable(ctx, state.TeamID.ValueString(), state.SharedEnvironmentVariableID.ValueString())
+	if client.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading shared environment variable project link",
+			fmt.Sprintf("Could not get shared environment variable %s %s, unexpected error: %s",
+				state.TeamID.ValueString(),
+				state.SharedEnvironmentVariableID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	if !contains(out.ProjectIDs, state.ProjectID.ValueString()) {
+		// The project has been unlinked outside of terraform.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	result := SharedEnvironmentVariableProjectLink{
+		SharedEnvironmentVariableID: state.SharedEnvironmentVariableID,
+		ProjectID:                   state.ProjectID,
+		TeamID:                      toTeamID(out.TeamID),
+	}
+	tflog.Info(ctx, "read shared environment variable project link", map[string]interface{}{
+		"env_id":     result.SharedEnvironmentVariableID.ValueString(),
+		"project_id": result.ProjectID.ValueString(),
+		"team_id":    result.TeamID.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update is not supported, as every attribute requires the resource to be replaced.
+func (r *sharedEnvironmentVariableProjectLinkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError(
+		"Updating a Shared Environment Variable Project Link is not supported",
+		"Updating a Shared Environment Variable Project Link is not supported",
+	)
+}
+
+// Delete will unlink a project from a shared environment variable.
+func (r *sharedEnvironmentVariableProjectLinkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state SharedEnvironmentVariableProjectLink
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := linkSharedEnvironmentVariableProject(
+		ctx,
+		r.client,
+		state.TeamID.ValueString(),
+		state.SharedEnvironmentVariableID.ValueString(),
+		state.ProjectID.ValueString(),
+		false,
+	)
+	if client.NotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error unlinking project from shared environment variable",
+			fmt.Sprintf(
+				"Could not unlink project %s from shared environment variable %s, unexpected error: %s",
+				state.ProjectID.ValueString(),
+				state.SharedEnvironmentVariableID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "unlinked shared environment variable from project", map[string]interface{}{
+		"env_id":     state.SharedEnvironmentVariableID.ValueString(),
+		"project_id": state.ProjectID.ValueString(),
+		"team_id":    state.TeamID.ValueString(),
+	})
+}
+
+// ImportState takes an identifier and checks the project is linked to the shared environment variable.
+// The results are then stored in terraform state.
+func (r *sharedEnvironmentVariableProjectLinkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	teamID, envID, projectID, ok := splitInto2Or3(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Error importing shared environment variable project link",
+			fmt.Sprintf("Invalid id '%s' specified. should be in format \"team_id/env_id/project_id\" or \"env_id/project_id\"", req.ID),
+		)
+		return
+	}
+
+	out, err := r.client.GetSharedEnvironmentVariable(ctx, teamID, envID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading shared environment variable",
+			fmt.Sprintf("Could not get shared environment variable %s %s, unexpected error: %s",
+				teamID,
+				envID,
+				err,
+			),
+		)
+		return
+	}
+	if !contains(out.ProjectIDs, projectID) {
+		resp.Diagnostics.AddError(
+			"Error importing shared environment variable project link",
+			fmt.Sprintf("Project %s is not linked to shared environment variable %s", projectID, envID),
+		)
+		return
+	}
+
+	result := SharedEnvironmentVariableProjectLink{
+		SharedEnvironmentVariableID: types.StringValue(envID),
+		ProjectID:                   types.StringValue(projectID),
+		TeamID:                      toTeamID(out.TeamID),
+	}
+	tflog.Info(ctx, "imported shared environment variable project link", map[string]interface{}{
+		"env_id":     result.SharedEnvironmentVariableID.ValueString(),
+		"project_id": result.ProjectID.ValueString(),
+		"team_id":    result.TeamID.ValueString(),
+	})
+
+	diags := resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,149 @@
+package vercel_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func testAccSharedEnvironmentVariableProjectLinkExists(n, teamID string, linked bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		envID := rs.Primary.Attributes["shared_environment_variable_id"]
+		projectID := rs.Primary.Attributes["project_id"]
+		env, err := testClient().GetSharedEnvironmentVariable(context.TODO(), teamID, envID)
+		if err != nil {
+			return err
+		}
+		for _, id := range env.ProjectIDs {
+			if id == projectID {
+				if !linked {
+					return fmt.Errorf("expected project %s to not be linked to %s", projectID, envID)
+				}
+				return nil
+			}
+		}
+		if linked {
+			return fmt.Errorf("expected project %s to be linked to %s", projectID, envID)
+		}
+		return nil
+	}
+}
+
+func TestAcc_SharedEnvironmentVariableProjectLink(t *testing.T) {
+	nameSuffix := randString(t, 16)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccProjectDestroy("vercel_project.example", testTeam()),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSharedEnvironmentVariableProjectLinkConfig(nameSuffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccSharedEnvironmentVariableProjectLinkExists("vercel_shared_environment_variable_project_link.example", testTeam(), true),
+					testAccSharedEnvironmentVariableProjectLinkExists("vercel_shared_environment_variable_project_link.example2", testTeam(), true),
+					resource.TestCheckResourceAttrSet("vercel_shared_environment_variable_project_link.example", "shared_environment_variable_id"),
+					resource.TestCheckResourceAttrSet("vercel_shared_environment_variable_project_link.example", "project_id"),
+				),
+			},
+			{
+				ResourceName:                         "vercel_shared_environment_variable_project_link.example",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "project_id",
+				ImportStateIdFunc:                    getSharedEnvironmentVariableProjectLinkImportID("vercel_shared_environment_variable_project_link.example"),
+			},
+			{
+				Config: testAccSharedEnvironmentVariableProjectLinkConfigUnlinked(nameSuffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccSharedEnvironmentVariableProjectLinkExists("vercel_shared_environment_variable_project_link.example", testTeam(), true),
+				),
+			},
+		},
+	})
+}
+
+func getSharedEnvironmentVariableProjectLinkImportID(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", n)
+		}
+
+		return fmt.Sprintf(
+			"%s/%s/%s",
+			rs.Primary.Attributes["team_id"],
+			rs.Primary.Attributes["shared_environment_variable_id"],
+			rs.Primary.Attributes["project_id"],
+		), nil
+	}
+}
+
+func testAccSharedEnvironmentVariableProjectLinkConfig(projectName string) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "example" {
+	name = "test-acc-example-project-%[1]s"
+	%[2]s
+}
+
+resource "vercel_project" "example2" {
+	name = "test-acc-example-project-2-%[1]s"
+	%[2]s
+}
+
+resource "vercel_shared_environment_variable" "example" {
+	%[2]s
+	key    = "test_acc_foo_%[1]s"
+	value  = "bar"
+	target = ["production"]
+}
+
+resource "vercel_shared_environment_variable_project_link" "example" {
+	%[2]s
+	shared_environment_variable_id = vercel_shared_environment_variable.example.id
+	project_id                     = vercel_project.example.id
+}
+
+resource "vercel_shared_environment_variable_project_link" "example2" {
+	%[2]s
+	shared_environment_variable_id = vercel_shared_environment_variable.example.id
+	project_id                     = vercel_project.example2.id
+}
+`, projectName, teamIDConfig())
+}
+
+func testAccSharedEnvironmentVariableProjectLinkConfigUnlinked(projectName string) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "example" {
+	name = "test-acc-example-project-%[1]s"
+	%[2]s
+}
+
+resource "vercel_project" "example2" {
+	name = "test-acc-example-project-2-%[1]s"
+	%[2]s
+}
+
+resource "vercel_shared_environment_variable" "example" {
+	%[2]s
+	key    = "test_acc_foo_%[1]s"
+	value  = "bar"
+	target = ["production"]
+}
+
+resource "vercel_shared_environment_variable_project_link" "example" {
+	%[2]s
+	shared_environment_variable_id = vercel_shared_environment_variable.example.id
+	project_id                     = vercel_project.example.id
+}
+`, projectName, teamIDConfig())
+}
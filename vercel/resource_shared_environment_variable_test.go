@@ -5,9 +5,9 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func testAccSharedEnvironmentVariableExists(n, teamID string) resource.TestCheckFunc {
@@ -27,7 +27,7 @@ func testAccSharedEnvironmentVariableExists(n, teamID string) resource.TestCheck
 }
 
 func TestAcc_SharedEnvironmentVariables(t *testing.T) {
-	nameSuffix := acctest.RandString(16)
+	nameSuffix := randString(t, 16)
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -170,3 +170,64 @@ resource "vercel_project" "example2" {
 }
     `, projectName, teamIDConfig())
 }
+
+func testAccSharedEnvironmentVariableValue(n, teamID, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		env, err := testClient().GetSharedEnvironmentVariable(context.TODO(), teamID, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if env.Value != value {
+			return fmt.Errorf("expected shared environment variable value to be %q, got %q", value, env.Value)
+		}
+		return nil
+	}
+}
+
+func TestAcc_SharedEnvironmentVariableWriteOnly(t *testing.T) {
+	nameSuffix := randString(t, 16)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSharedEnvironmentVariableWriteOnlyConfig(nameSuffix, "bar", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccSharedEnvironmentVariableExists("vercel_shared_environment_variable.example", testTeam()),
+					testAccSharedEnvironmentVariableValue("vercel_shared_environment_variable.example", testTeam(), "bar"),
+					resource.TestCheckNoResourceAttr("vercel_shared_environment_variable.example", "value"),
+					resource.TestCheckNoResourceAttr("vercel_shared_environment_variable.example", "value_wo"),
+					resource.TestCheckResourceAttr("vercel_shared_environment_variable.example", "value_wo_version", "1"),
+				),
+			},
+			{
+				Config: testAccSharedEnvironmentVariableWriteOnlyConfig(nameSuffix, "baz", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccSharedEnvironmentVariableValue("vercel_shared_environment_variable.example", testTeam(), "baz"),
+					resource.TestCheckNoResourceAttr("vercel_shared_environment_variable.example", "value"),
+					resource.TestCheckResourceAttr("vercel_shared_environment_variable.example", "value_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSharedEnvironmentVariableWriteOnlyConfig(name, value string, version int) string {
+	return fmt.Sprintf(`
+resource "vercel_shared_environment_variable" "example" {
+	%[2]s
+	key              = "test_acc_foo_wo_%[1]s"
+	value_wo         = "%[3]s"
+	value_wo_version = %[4]d
+	target           = ["production"]
+}
+`, name, teamIDConfig(), value, version)
+}
@@ -0,0 +1,458 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &teamConfigResource{}
+	_ resource.ResourceWithConfigure   = &teamConfigResource{}
+	_ resource.ResourceWithImportState = &teamConfigResource{}
+)
+
+func newTeamConfigResource() resource.Resource {
+	return &teamConfigResource{}
+}
+
+type teamConfigResource struct {
+	client client.TeamsAPI
+}
+
+func (r *teamConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_config"
+}
+
+func (r *teamConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.TeamsAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.TeamsAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Schema returns the schema information for a team config resource.
+func (r *teamConfigResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Manages the configuration of an existing Vercel team.
+
+Only the settings that are specified are managed. Any setting that is not specified is left unchanged, and any change
+made to a managed setting outside of terraform is detected when planning.
+
+Destroying a Team Config resource leaves the settings of the team unchanged, and does not delete the team.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:   "The ID of the team to configure, which starts with `team_`. A team slug cannot be used, as the slug can be changed by this resource.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^team_`), "must be a team ID, starting with `team_`, rather than a team slug"),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description:   "The name of the team.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 256),
+				},
+			},
+			"slug": schema.StringAttribute{
+				Description:   "The slug of the team, which is used in the URLs of the team. Changing the slug breaks any existing links to the team.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 48),
+				},
+			},
+			"sensitive_environment_variable_policy": schema.StringAttribute{
+				Description:   "Whether new environment variables for the Production and Preview environments are always created as sensitive. Must be one of `on` or `off`.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Validators: []validator.String{
+					stringvalidator.OneOf("on", "off"),
+				},
+			},
+			"preview_deployment_suffix": schema.StringAttribute{
+				Description:   "A custom domain, owned by the team, that is used in place of `vercel.app` for the URLs of Preview Deployments.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"remote_caching": schema.SingleNestedAttribute{
+				Description:   "The Remote Caching settings of the team.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Object{objectplanmodifier.UseStateForUnknown()},
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						Description: "Whether builds of the team's projects can use Remote Caching.",
+						Required:    true,
+					},
+				},
+			},
+			"hide_ip_addresses": schema.BoolAttribute{
+				Description:   "Whether IP addresses are hidden in Monitoring and Observability.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"default_deployment_protection": schema.SingleNestedAttribute{
+				Description:   "The deployment protection applied by default to new projects of the team.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Object{objectplanmodifier.UseStateForUnknown()},
+				Attributes: map[string]schema.Attribute{
+					"vercel_authentication": schema.SingleNestedAttribute{
+						Description: "Ensures visitors to the Deployments of new projects are logged into Vercel and have a minimum of Viewer access on the team.",
+						Required:    true,
+						Attributes: map[string]schema.Attribute{
+							"deployment_type": schema.StringAttribute{
+								Required:    true,
+								Description: "The deployment environment to protect. Must be one of `standard_protection`, `all_deployments`, `only_preview_deployments`, or `none`.",
+								Validators: []validator.String{
+									stringvalidator.OneOf("standard_protection", "all_deployments", "only_preview_deployments", "none"),
+								},
+							},
+						},
+					},
+				},
+			},
+			"enable_preview_feedback": schema.StringAttribute{
+				Description:   "Whether the Vercel Toolbar, and commenting, is enabled on Preview Deployments. Must be one of `on`, `off` or `default`, which leaves the choice to each project.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Validators: []validator.String{
+					stringvalidator.OneOf("on", "off", "default"),
+				},
+			},
+		},
+	}
+}
+
+// TeamConfig represents the terraform state for a team config resource.
+type TeamConfig struct {
+	ID                                 types.String `tfsdk:"id"`
+	Name                               types.String `tfsdk:"name"`
+	Slug                               types.String `tfsdk:"slug"`
+	SensitiveEnvironmentVariablePolicy types.String `tfsdk:"sensitive_environment_variable_policy"`
+	PreviewDeploymentSuffix            types.String `tfsdk:"preview_deployment_suffix"`
+	RemoteCaching                      types.Object `tfsdk:"remote_caching"`
+	HideIPAddresses                    types.Bool   `tfsdk:"hide_ip_addresses"`
+	DefaultDeploymentProtection        types.Object `tfsdk:"default_deployment_protection"`
+	EnablePreviewFeedback              types.String `tfsdk:"enable_preview_feedback"`
+}
+
+// TeamRemoteCaching represents the remote_caching attribute of a team config resource.
+type TeamRemoteCaching struct {
+	Enabled types.Bool `tfsdk:"enabled"`
+}
+
+// TeamDeploymentProtection represents the default_deployment_protection attribute of a team config resource.
+type TeamDeploymentProtection struct {
+	VercelAuthentication VercelAuthentication `tfsdk:"vercel_authentication"`
+}
+
+var teamRemoteCachingAttrTypes = map[string]attr.Type{
+	"enabled": types.BoolType,
+}
+
+var teamDeploymentProtectionAttrTypes = map[string]attr.Type{
+	"vercel_authentication": types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"deployment_type": types.StringType,
+		},
+	},
+}
+
+// knownString returns a pointer to the value of a string, or nil if the value is null or unknown, so that it is left
+// unchanged by an update.
+func knownString(v types.String) *string {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	return v.ValueStringPointer()
+}
+
+func (c TeamConfig) toUpdateTeamRequest(ctx context.Context) (client.UpdateTeamRequest, diag.Diagnostics) {
+	request := client.UpdateTeamRequest{
+		TeamID:                             c.ID.ValueString(),
+		SensitiveEnvironmentVariablePolicy: knownString(c.SensitiveEnvironmentVariablePolicy),
+		PreviewDeploymentSuffix:            knownString(c.PreviewDeploymentSuffix),
+		EnablePreviewFeedback:              knownString(c.EnablePreviewFeedback),
+	}
+	if name := knownString(c.Name); name != nil {
+		request.Name = *name
+	}
+	if slug := knownString(c.Slug); slug != nil {
+		request.Slug = *slug
+	}
+	if !c.HideIPAddresses.IsNull() && !c.HideIPAddresses.IsUnknown() {
+		request.HideIPAddresses = c.HideIPAddresses.ValueBoolPointer()
+	}
+
+	var diags diag.Diagnostics
+	if !c.RemoteCaching.IsNull() && !c.RemoteCaching.IsUnknown() {
+		var rc TeamRemoteCaching
+		diags.Append(c.RemoteCaching.As(ctx, &rc, basetypes.ObjectAsOptions{})...)
+		request.RemoteCaching = &client.TeamRemoteCaching{
+			Enabled: rc.Enabled.ValueBoolPointer(),
+		}
+	}
+	if !c.DefaultDeploymentProtection.IsNull() && !c.DefaultDeploymentProtection.IsUnknown() {
+		var dp TeamDeploymentProtection
+		diags.Append(c.DefaultDeploymentProtection.As(ctx, &dp, basetypes.ObjectAsOptions{})...)
+		request.DefaultDeploymentProtection = &client.TeamDeploymentProtection{
+			VercelAuthentication: dp.VercelAuthentication.toUpdateProjectRequest(),
+		}
+	}
+	return request, diags
+}
+
+func convertResponseToTeamConfig(ctx context.Context, response client.Team) (TeamConfig, diag.Diagnostics) {
+	result := TeamConfig{
+		ID:                                 types.StringValue(response.ID),
+		Name:                               types.StringValue(response.Name),
+		Slug:                               types.StringValue(response.Slug),
+		SensitiveEnvironmentVariablePolicy: types.StringValue("off"),
+		PreviewDeploymentSuffix:            types.StringPointerValue(response.PreviewDeploymentSuffix),
+		RemoteCaching:                      types.ObjectNull(teamRemoteCachingAttrTypes),
+		HideIPAddresses:                    types.BoolValue(false),
+		DefaultDeploymentProtection:        types.ObjectNull(teamDeploymentProtectionAttrTypes),
+		EnablePreviewFeedback:              types.StringValue("default"),
+	}
+	// The API omits settings that have never been changed from their defaults.
+	if response.SensitiveEnvironmentVariablePolicy != nil && *response.SensitiveEnvironmentVariablePolicy == "on" {
+		result.SensitiveEnvironmentVariablePolicy = types.StringValue("on")
+	}
+	if response.HideIPAddresses != nil {
+		result.HideIPAddresses = types.BoolValue(*response.HideIPAddresses)
+	}
+	if response.EnablePreviewFeedback != nil {
+		result.EnablePreviewFeedback = types.StringValue(*response.EnablePreviewFeedback)
+	}
+
+	var diags diag.Diagnostics
+	if response.RemoteCaching != nil && response.RemoteCaching.Enabled != nil {
+		var d diag.Diagnostics
+		result.RemoteCaching, d = types.ObjectValueFrom(ctx, teamRemoteCachingAttrTypes, TeamRemoteCaching{
+			Enabled: types.BoolValue(*response.RemoteCaching.Enabled),
+		})
+		diags.Append(d...)
+	}
+	if response.DefaultDeploymentProtection != nil && response.DefaultDeploymentProtection.VercelAuthentication != nil {
+		var d diag.Diagnostics
+		result.DefaultDeploymentProtection, d = types.ObjectValueFrom(ctx, teamDeploymentProtectionAttrTypes, TeamDeploymentProtection{
+			VercelAuthentication: VercelAuthentication{
+				DeploymentType: fromApiDeploymentProtectionType(response.DefaultDeploymentProtection.VercelAuthentication.DeploymentType),
+			},
+		})
+		diags.Append(d...)
+	}
+	return result, diags
+}
+
+// updateTeamConfig applies the settings of a team config, and then reads back the resulting configuration of the team.
+func (r *teamConfigResource) updateTeamConfig(ctx context.Context, plan TeamConfig) (TeamConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	request, d := plan.toUpdateTeamRequest(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return plan, diags
+	}
+
+	if _, err := r.client.UpdateTeam(ctx, request); err != nil {
+		diags.AddError(
+			"Error updating team config",
+			fmt.Sprintf("Could not update the configuration of team %s, unexpected error: %s", plan.ID.ValueString(), err),
+		)
+		return plan, diags
+	}
+
+	out, err := r.client.GetTeam(ctx, plan.ID.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Error reading team config",
+			fmt.Sprintf("Could not read the configuration of team %s after updating it, unexpected error: %s", plan.ID.ValueString(), err),
+		)
+		return plan, diags
+	}
+
+	result, d := convertResponseToTeamConfig(ctx, out)
+	diags.Append(d...)
+	return result, diags
+}
+
+// Create will apply the configuration of an existing team.
+// This is called automatically by the provider when a new resource should be created.
+func (r *teamConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TeamConfig
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, diags := r.updateTeamConfig(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "created team config", map[string]interface{}{
+		"team_id": result.ID.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read will read the configuration of a team by requesting it from the Vercel API, and will update terraform
+// with this information, so that any changes made outside of terraform are detected.
+func (r *teamConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TeamConfig
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := r.client.GetTeam(ctx, state.ID.ValueString())
+	if client.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading team config",
+			fmt.Sprintf("Could not read the configuration of team %s, unexpected error: %s", state.ID.ValueString(), err),
+		)
+		return
+	}
+
+	result, diags := convertResponseToTeamConfig(ctx, out)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "read team config", map[string]interface{}{
+		"team_id": result.ID.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update will apply any changed settings of a team.
+func (r *teamConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan TeamConfig
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, diags := r.updateTeamConfig(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "updated team config", map[string]interface{}{
+		"team_id": result.ID.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete removes the team config from terraform state. The settings of the team are left unchanged, as a team
+// always has a configuration.
+func (r *teamConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TeamConfig
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "deleted team config", map[string]interface{}{
+		"team_id": state.ID.ValueString(),
+	})
+}
+
+// ImportState takes a team ID or slug and reads the configuration of the team from the Vercel API.
+// The results are then stored in terraform state.
+func (r *teamConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	out, err := r.client.GetTeam(ctx, req.ID)
+	if client.NotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error importing team config",
+			fmt.Sprintf("Could not find team %s", req.ID),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing team config",
+			fmt.Sprintf("Could not read the configuration of team %s, unexpected error: %s", req.ID, err),
+		)
+		return
+	}
+
+	result, diags := convertResponseToTeamConfig(ctx, out)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "imported team config", map[string]interface{}{
+		"team_id": result.ID.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,104 @@
+package vercel_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+func getTeamConfigImportSlug(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.Attributes["slug"] == "" {
+			return "", fmt.Errorf("no slug is set")
+		}
+		return rs.Primary.Attributes["slug"], nil
+	}
+}
+
+func TestAcc_TeamConfigResource(t *testing.T) {
+	if testTeam() == "" {
+		t.Skip("VERCEL_TERRAFORM_TESTING_TEAM must be set to configure a team")
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "vercel_team_config" "slug" {
+  id = "my-team"
+}
+`,
+				ExpectError: regexp.MustCompile("must be a team ID"),
+			},
+			{
+				Config: testAccTeamConfigResourceConfig(true, "off"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vercel_team_config.test", "id", testTeam()),
+					resource.TestCheckResourceAttr("vercel_team_config.test", "hide_ip_addresses", "true"),
+					resource.TestCheckResourceAttr("vercel_team_config.test", "enable_preview_feedback", "off"),
+					resource.TestCheckResourceAttr("vercel_team_config.test", "remote_caching.enabled", "true"),
+					resource.TestCheckResourceAttrSet("vercel_team_config.test", "name"),
+					resource.TestCheckResourceAttrSet("vercel_team_config.test", "slug"),
+				),
+			},
+			{
+				ResourceName:      "vercel_team_config.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getTeamConfigImportSlug("vercel_team_config.test"),
+			},
+			{
+				// A change made outside of terraform should be planned to be reverted.
+				PreConfig: func() {
+					hide := false
+					_, err := testClient().UpdateTeam(context.Background(), client.UpdateTeamRequest{
+						TeamID:          testTeam(),
+						HideIPAddresses: &hide,
+					})
+					if err != nil {
+						t.Fatalf("unable to update team: %s", err)
+					}
+				},
+				Config: testAccTeamConfigResourceConfig(true, "off"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("vercel_team_config.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("vercel_team_config.test", "hide_ip_addresses", "true"),
+			},
+			{
+				Config: testAccTeamConfigResourceConfig(false, "default"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vercel_team_config.test", "hide_ip_addresses", "false"),
+					resource.TestCheckResourceAttr("vercel_team_config.test", "enable_preview_feedback", "default"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTeamConfigResourceConfig(hideIPAddresses bool, previewFeedback string) string {
+	return fmt.Sprintf(`
+resource "vercel_team_config" "test" {
+    id                      = "%[1]s"
+    hide_ip_addresses       = %[2]t
+    enable_preview_feedback = "%[3]s"
+    remote_caching = {
+        enabled = true
+    }
+}
+`, testTeam(), hideIPAddresses, previewFeedback)
+}
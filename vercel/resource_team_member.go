@@ -0,0 +1,406 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &teamMemberResource{}
+	_ resource.ResourceWithConfigure   = &teamMemberResource{}
+	_ resource.ResourceWithImportState = &teamMemberResource{}
+)
+
+func newTeamMemberResource() resource.Resource {
+	return &teamMemberResource{}
+}
+
+type teamMemberResource struct {
+	client client.TeamsAPI
+}
+
+func (r *teamMemberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_member"
+}
+
+func (r *teamMemberResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.TeamsAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.TeamsAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// requiresReplaceIfPendingInvitation forces an invitation that has not been accepted to be sent again when its role
+// changes, as only the role of a member with a Vercel account can be updated.
+func requiresReplaceIfPendingInvitation(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	var userID types.String
+	diags := req.State.GetAttribute(ctx, path.Root("user_id"), &userID)
+	resp.Diagnostics.Append(diags...)
+	resp.RequiresReplace = userID.IsNull()
+}
+
+// Schema returns the schema information for a team member resource.
+func (r *teamMemberResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides a Team Member resource.
+
+A Team Member is a user that has been invited to a Vercel team, along with the role they have within it. Users can be
+invited by either their Vercel user ID or their email address. An invitation to an email address that does not yet
+belong to a Vercel account remains pending, without a user ID, until it has been accepted.
+
+Removing a Team Member resource removes the user from the team, or revokes their invitation if it has not been accepted.`,
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.StringAttribute{
+				Description:   "The ID of the team the member should belong to. Required when configuring a team resource if a default team has not been set in the provider.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplaceIfConfigured(), stringplanmodifier.UseStateForUnknown()},
+			},
+			"user_id": schema.StringAttribute{
+				Description:   "The ID of the user to invite. Exactly one of `user_id` and `email` must be specified. When inviting by email, this is set once the user has a Vercel account.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplaceIfConfigured(), stringplanmodifier.UseStateForUnknown()},
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("email")),
+				},
+			},
+			"email": schema.StringAttribute{
+				Description:   "The email address of the user to invite. Exactly one of `user_id` and `email` must be specified.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplaceIfConfigured(), stringplanmodifier.UseStateForUnknown()},
+			},
+			"role": schema.StringAttribute{
+				Description: "The role the user should have within the team. Must be one of " + strings.Join(client.TeamMemberRoles, ", ") + ".",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						requiresReplaceIfPendingInvitation,
+						"The role of a pending invitation cannot be changed, so the invitation is sent again.",
+						"The role of a pending invitation cannot be changed, so the invitation is sent again.",
+					),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(client.TeamMemberRoles...),
+				},
+			},
+			"confirmed": schema.BoolAttribute{
+				Description:   "Whether the user has accepted the invitation to join the team.",
+				Computed:      true,
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+// TeamMember represents the terraform state for a team member resource.
+type TeamMember struct {
+	TeamID    types.String `tfsdk:"team_id"`
+	UserID    types.String `tfsdk:"user_id"`
+	Email     types.String `tfsdk:"email"`
+	Role      types.String `tfsdk:"role"`
+	Confirmed types.Bool   `tfsdk:"confirmed"`
+}
+
+func (m TeamMember) toRequest() client.TeamMemberRequest {
+	return client.TeamMemberRequest{
+		TeamID: m.TeamID.ValueString(),
+		UserID: m.UserID.ValueString(),
+		Email:  m.Email.ValueString(),
+	}
+}
+
+func (m TeamMember) toClient() client.TeamMember {
+	return client.TeamMember{
+		TeamID: m.TeamID.ValueString(),
+		UserID: m.UserID.ValueString(),
+		Email:  m.Email.ValueString(),
+	}
+}
+
+func convertResponseToTeamMember(response client.TeamMember, ref TeamMember) TeamMember {
+	email := stringOrNull(response.Email)
+	// the email used for an invitation may differ in case from the email of the account
+	if ref.Email.ValueString() != "" && strings.EqualFold(response.Email, ref.Email.ValueString()) {
+		email = ref.Email
+	}
+	return TeamMember{
+		TeamID:    toTeamID(response.TeamID),
+		UserID:    stringOrNull(response.UserID),
+		Email:     email,
+		Role:      types.StringValue(response.Role),
+		Confirmed: types.BoolValue(response.Confirmed),
+	}
+}
+
+// Create will invite a user to a team.
+// This is called automatically by the provider when a new resource should be created.
+func (r *teamMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TeamMember
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := r.client.InviteTeamMember(ctx, client.InviteTeamMemberRequest{
+		TeamID: plan.TeamID.ValueString(),
+		UserID: plan.UserID.ValueString(),
+		Email:  plan.Email.ValueString(),
+		Role:   plan.Role.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating team member",
+			fmt.Sprintf("Could not invite %s to team %s, unexpected error: %s",
+				teamMemberName(plan),
+				plan.TeamID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	result := convertResponseToTeamMember(out, plan)
+	tflog.Info(ctx, "created team member", map[string]interface{}{
+		"team_id": result.TeamID.ValueString(),
+		"user_id": result.UserID.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// teamMemberName describes a team member by their user ID, or their email address if they have no user ID.
+func teamMemberName(m TeamMember) string {
+	if m.UserID.ValueString() != "" {
+		return m.UserID.ValueString()
+	}
+	return m.Email.ValueString()
+}
+
+// Read will read a team member by requesting it from the Vercel API, and will update terraform with this
+// information.
+func (r *teamMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TeamMember
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := r.client.GetTeamMember(ctx, state.toRequest())
+	if client.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading team member",
+			fmt.Sprintf("Could not read team member %s of team %s, unexpected error: %s",
+				teamMemberName(state),
+				state.TeamID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	result := convertResponseToTeamMember(out, state)
+	tflog.Info(ctx, "read team member", map[string]interface{}{
+		"team_id": result.TeamID.ValueString(),
+		"user_id": result.UserID.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update changes the role of a team member.
+func (r *teamMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state TeamMember
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.UpdateTeamMember(ctx, client.UpdateTeamMemberRequest{
+		TeamID: state.TeamID.ValueString(),
+		UserID: state.UserID.ValueString(),
+		Role:   plan.Role.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating team member",
+			fmt.Sprintf("Could not update team member %s of team %s, unexpected error: %s",
+				teamMemberName(state),
+				state.TeamID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	out, err := r.client.GetTeamMember(ctx, state.toRequest())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating team member",
+			fmt.Sprintf("Could not read team member %s of team %s after updating it, unexpected error: %s",
+				teamMemberName(state),
+				state.TeamID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	result := convertResponseToTeamMember(out, plan)
+	tflog.Info(ctx, "updated team member", map[string]interface{}{
+		"team_id": result.TeamID.ValueString(),
+		"user_id": result.UserID.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete removes a user from a team, or revokes their invitation if it has not been accepted.
+func (r *teamMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TeamMember
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	member := state.toClient()
+	if member.UserID == "" {
+		// A pending invitation is identified by an ID that isn't stored in state, so it has to be looked up.
+		out, err := r.client.GetTeamMember(ctx, state.toRequest())
+		if client.NotFound(err) {
+			return
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error deleting team member",
+				fmt.Sprintf("Could not read team member %s of team %s, unexpected error: %s",
+					teamMemberName(state),
+					state.TeamID.ValueString(),
+					err,
+				),
+			)
+			return
+		}
+		member = out
+	}
+
+	err := r.client.RemoveTeamMember(ctx, member)
+	if client.NotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting team member",
+			fmt.Sprintf("Could not remove team member %s from team %s, unexpected error: %s",
+				teamMemberName(state),
+				state.TeamID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "deleted team member", map[string]interface{}{
+		"team_id": state.TeamID.ValueString(),
+		"user_id": state.UserID.ValueString(),
+	})
+}
+
+// ImportState takes an identifier and reads the team member from the Vercel API.
+// The results are then stored in terraform state.
+func (r *teamMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	teamID, userID, ok := splitInto1Or2(req.ID)
+	if !ok || userID == "" {
+		resp.Diagnostics.AddError(
+			"Error importing team member",
+			fmt.Sprintf("Invalid id '%s' specified. should be in format \"team_id/user_id\", \"team_id/email\", \"user_id\" or \"email\"", req.ID),
+		)
+		return
+	}
+
+	ref := TeamMember{
+		TeamID: types.StringValue(teamID),
+		UserID: types.StringValue(userID),
+		Email:  types.StringNull(),
+	}
+	if strings.Contains(userID, "@") {
+		ref.UserID = types.StringNull()
+		ref.Email = types.StringValue(userID)
+	}
+	out, err := r.client.GetTeamMember(ctx, ref.toRequest())
+	if client.NotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error importing team member",
+			fmt.Sprintf("Could not find team member %s of team %s", userID, teamID),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing team member",
+			fmt.Sprintf("Could not read team member %s of team %s, unexpected error: %s", userID, teamID, err),
+		)
+		return
+	}
+
+	result := convertResponseToTeamMember(out, ref)
+	tflog.Info(ctx, "imported team member", map[string]interface{}{
+		"team_id": result.TeamID.ValueString(),
+		"user_id": result.UserID.ValueString(),
+	})
+
+	diags := resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,97 @@
+package vercel_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func getTeamMemberImportID(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", n)
+		}
+
+		member := rs.Primary.Attributes["user_id"]
+		if member == "" {
+			member = rs.Primary.Attributes["email"]
+		}
+		if rs.Primary.Attributes["team_id"] == "" {
+			return member, nil
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["team_id"], member), nil
+	}
+}
+
+func TestAcc_TeamMemberResource(t *testing.T) {
+	name := randString(t, 16)
+	email := fmt.Sprintf("terraform-%s@example.com", name)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTeamMemberResourceConfig(email, "ADMIN"),
+				ExpectError: regexp.MustCompile(`value must be one of`),
+			},
+			{
+				Config: testAccTeamMemberResourceConfig(email, "VIEWER"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vercel_team_member.test", "email", email),
+					resource.TestCheckResourceAttr("vercel_team_member.test", "role", "VIEWER"),
+					resource.TestCheckResourceAttr("vercel_team_member.test", "confirmed", "false"),
+					resource.TestCheckNoResourceAttr("vercel_team_member.test", "user_id"),
+					resource.TestCheckResourceAttrSet("vercel_team_member.test", "team_id"),
+				),
+			},
+			{
+				ResourceName:                         "vercel_team_member.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateIdFunc:                    getTeamMemberImportID("vercel_team_member.test"),
+				ImportStateVerifyIdentifierAttribute: "email",
+			},
+			{
+				Config: testAccTeamMemberResourceConfig(email, "DEVELOPER"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vercel_team_member.test", "email", email),
+					resource.TestCheckResourceAttr("vercel_team_member.test", "role", "DEVELOPER"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_TeamMemberResourceInvalid(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "vercel_team_member" "test" {
+    user_id = "user_1"
+    email   = "jane@example.com"
+    role    = "MEMBER"
+    %[1]s
+}
+`, teamIDConfig()),
+				ExpectError: regexp.MustCompile(`(?s)Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func testAccTeamMemberResourceConfig(email, role string) string {
+	return fmt.Sprintf(`
+resource "vercel_team_member" "test" {
+    email = "%[1]s"
+    role  = "%[2]s"
+    %[3]s
+}
+`, email, role, teamIDConfig())
+}
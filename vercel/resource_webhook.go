@@ -3,6 +3,7 @@ package vercel
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -14,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/vercel/terraform-provider-vercel/client"
+	"github.com/vercel/terraform-provider-vercel/delivery"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -27,7 +29,7 @@ func newWebhookResource() resource.Resource {
 }
 
 type webhookResource struct {
-	client *client.Client
+	client client.WebhooksAPI
 }
 
 func (r *webhookResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -40,11 +42,11 @@ func (r *webhookResource) Configure(ctx context.Context, req resource.ConfigureR
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(client.WebhooksAPI)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected client.WebhooksAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -60,6 +62,12 @@ A webhook is a trigger-based HTTP endpoint configured to receive HTTP POST reque
 When an event happens, a webhook is sent to a third-party app, which can then take appropriate action.
 
 ~> Only Pro and Enterprise teams are able to configure these webhooks at the account level.
+
+~> The Vercel API cannot change the secret of an existing webhook. The only way to get a new secret is to delete the
+webhook and create a new one, which has a new ID. Changing ` + "`replace_trigger`" + ` does exactly that: the webhook is destroyed
+and recreated with a new ID and a new secret. Anything using ` + "`id`" + ` or ` + "`secret`" + ` is planned with the new values and
+updated in the same apply. Setting ` + "`create_before_destroy`" + ` in a ` + "`lifecycle`" + ` block means the new webhook is
+receiving events before the old one is deleted, so receivers should accept either secret while they are updated.
 `,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -74,18 +82,11 @@ When an event happens, a webhook is sent to a third-party app, which can then ta
 				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplaceIfConfigured(), stringplanmodifier.UseStateForUnknown()},
 			},
 			"events": schema.SetAttribute{
-				Description: "A list of the events the webhook will listen to. At least one must be present.",
+				Description: "A list of the events the webhook will listen to. At least one must be present. Names are checked against the catalogue of events when planning, and near misses such as `deployment.cancelled` suggest the event that was likely meant. Valid events are `" + strings.Join(delivery.Events, "`, `") + "`.",
 				Required:    true,
 				ElementType: types.StringType,
 				Validators: []validator.Set{
-					stringSetItemsIn(
-						"deployment.created",
-						"deployment.error",
-						"deployment.canceled",
-						"deployment.succeeded",
-						"project.created",
-						"project.removed",
-					),
+					webhookEvents(),
 					stringSetMinCount(1),
 				},
 				PlanModifiers: []planmodifier.Set{setplanmodifier.RequiresReplace()},
@@ -101,6 +102,11 @@ When an event happens, a webhook is sent to a third-party app, which can then ta
 				ElementType:   types.StringType,
 				PlanModifiers: []planmodifier.Set{setplanmodifier.RequiresReplace()},
 			},
+			"replace_trigger": schema.StringAttribute{
+				Description:   "An arbitrary value, such as a date, that replaces the webhook when it changes. The webhook is destroyed and recreated, so it gets a new ID as well as a new secret.",
+				Optional:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
 			"secret": schema.StringAttribute{
 				Description:   "A secret value which will be provided in the `x-vercel-signature` header and can be used to verify the authenticity of the webhook. See https://vercel.com/docs/observability/webhooks-overview/webhooks-api#securing-webhooks for further details.",
 				Computed:      true,
@@ -112,15 +118,16 @@ When an event happens, a webhook is sent to a third-party app, which can then ta
 }
 
 type Webhook struct {
-	ID         types.String `tfsdk:"id"`
-	TeamID     types.String `tfsdk:"team_id"`
-	Endpoint   types.String `tfsdk:"endpoint"`
-	Secret     types.String `tfsdk:"secret"`
-	ProjectIDs types.Set    `tfsdk:"project_ids"`
-	Events     types.Set    `tfsdk:"events"`
+	ID             types.String `tfsdk:"id"`
+	TeamID         types.String `tfsdk:"team_id"`
+	Endpoint       types.String `tfsdk:"endpoint"`
+	Secret         types.String `tfsdk:"secret"`
+	ProjectIDs     types.Set    `tfsdk:"project_ids"`
+	Events         types.Set    `tfsdk:"events"`
+	ReplaceTrigger types.String `tfsdk:"replace_trigger"`
 }
 
-func responseToWebhook(ctx context.Context, out client.Webhook) (Webhook, diag.Diagnostics) {
+func responseToWebhook(ctx context.Context, out client.Webhook, replaceTrigger types.String) (Webhook, diag.Diagnostics) {
 	projectIDs, diags := types.SetValueFrom(ctx, types.StringType, out.ProjectIDs)
 	if diags.HasError() {
 		return Webhook{}, diags
@@ -131,12 +138,13 @@ func responseToWebhook(ctx context.Context, out client.Webhook) (Webhook, diag.D
 	}
 
 	return Webhook{
-		ID:         types.StringValue(out.ID),
-		TeamID:     types.StringValue(out.TeamID),
-		Endpoint:   types.StringValue(out.Endpoint),
-		Secret:     types.StringValue(out.Secret),
-		ProjectIDs: projectIDs,
-		Events:     events,
+		ID:             types.StringValue(out.ID),
+		TeamID:         types.StringValue(out.TeamID),
+		Endpoint:       types.StringValue(out.Endpoint),
+		Secret:         types.StringValue(out.Secret),
+		ProjectIDs:     projectIDs,
+		Events:         events,
+		ReplaceTrigger: replaceTrigger,
 	}, diags
 }
 
@@ -175,7 +183,7 @@ func (r *webhookResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	result, diags := responseToWebhook(ctx, out)
+	result, diags := responseToWebhook(ctx, out, plan.ReplaceTrigger)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -221,7 +229,7 @@ func (r *webhookResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 	// Override the secret with state as this is not returned by the 'GET' endpoint.
 	out.Secret = state.Secret.ValueString()
-	result, diags := responseToWebhook(ctx, out)
+	result, diags := responseToWebhook(ctx, out, state.ReplaceTrigger)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
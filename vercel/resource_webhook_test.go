@@ -3,9 +3,9 @@ package vercel_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/vercel/terraform-provider-vercel/client"
@@ -52,7 +52,7 @@ func testCheckWebhooksDeleted(n1, n2, teamID string) resource.TestCheckFunc {
 }
 
 func TestAcc_WebhookResource(t *testing.T) {
-	name := acctest.RandString(16)
+	name := randString(t, 16)
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -105,3 +105,101 @@ resource "vercel_webhook" "without_project_ids" {
 }
 `, name, team)
 }
+
+func TestAcc_WebhookResourceInvalidEvents(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceWebhookEvents(teamIDConfig(), `"deployment.created", "deployment.cancelled"`),
+				ExpectError: regexp.MustCompile(`did you mean "deployment.canceled"\?`),
+			},
+			{
+				Config:      testAccResourceWebhookEvents(teamIDConfig(), `"invoice.paid"`),
+				ExpectError: regexp.MustCompile(`"invoice.paid" is not a valid webhook event, expected one of`),
+			},
+		},
+	})
+}
+
+func testAccResourceWebhookEvents(team, events string) string {
+	return fmt.Sprintf(`
+resource "vercel_webhook" "invalid" {
+    events = [%[2]s]
+    endpoint = "https://example.com/foo"
+    %[1]s
+}
+`, team, events)
+}
+
+func TestAcc_WebhookResourceReplaceTrigger(t *testing.T) {
+	name := randString(t, 16)
+	var id, secret string
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceWebhookReplaceTrigger(name, teamIDConfig(), "2024-01"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckWebhookExists(testTeam(), "vercel_webhook.replaced"),
+					resource.TestCheckResourceAttr("vercel_webhook.replaced", "replace_trigger", "2024-01"),
+					resource.TestCheckResourceAttrPair("vercel_webhook.replaced", "secret", "vercel_project_environment_variable.secret", "value"),
+					func(s *terraform.State) error {
+						rs := s.RootModule().Resources["vercel_webhook.replaced"]
+						id, secret = rs.Primary.ID, rs.Primary.Attributes["secret"]
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccResourceWebhookReplaceTrigger(name, teamIDConfig(), "2024-02"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckWebhookExists(testTeam(), "vercel_webhook.replaced"),
+					resource.TestCheckResourceAttrPair("vercel_webhook.replaced", "secret", "vercel_project_environment_variable.secret", "value"),
+					func(s *terraform.State) error {
+						rs := s.RootModule().Resources["vercel_webhook.replaced"]
+						if rs.Primary.ID == id {
+							return fmt.Errorf("expected the webhook to be replaced, but it still has the ID %s", id)
+						}
+						if rs.Primary.Attributes["secret"] == secret {
+							return fmt.Errorf("expected the secret to change, but it is unchanged")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceWebhookReplaceTrigger(name, team, trigger string) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "test" {
+    name = "test-acc-%[1]s"
+    %[2]s
+}
+
+resource "vercel_webhook" "replaced" {
+    events = ["deployment.created"]
+    endpoint = "https://example.com/foo"
+    project_ids = [vercel_project.test.id]
+    replace_trigger = "%[3]s"
+    %[2]s
+
+    lifecycle {
+        create_before_destroy = true
+    }
+}
+
+resource "vercel_project_environment_variable" "secret" {
+    project_id = vercel_project.test.id
+    key = "WEBHOOK_SECRET"
+    value = vercel_webhook.replaced.secret
+    target = ["production"]
+    sensitive = true
+    %[2]s
+}
+`, name, team, trigger)
+}
@@ -0,0 +1,48 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func stringDuration(min time.Duration) validatorStringDuration {
+	return validatorStringDuration{
+		Min: min,
+	}
+}
+
+type validatorStringDuration struct {
+	Min time.Duration
+}
+
+func (v validatorStringDuration) Description(ctx context.Context) string {
+	return fmt.Sprintf("Value must be a duration, such as `30s` or `10m`, of at least %s", v.Min)
+}
+func (v validatorStringDuration) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf("Value must be a duration, such as `30s` or `10m`, of at least `%s`", v.Min)
+}
+
+func (v validatorStringDuration) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+	d, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid value provided",
+			fmt.Sprintf("Value must be a duration, such as `30s` or `10m`, got: %s.", req.ConfigValue.ValueString()),
+		)
+		return
+	}
+	if d < v.Min {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid value provided",
+			fmt.Sprintf("Value must be at least %s, got: %s.", v.Min, req.ConfigValue.ValueString()),
+		)
+	}
+}
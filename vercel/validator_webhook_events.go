@@ -0,0 +1,55 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vercel/terraform-provider-vercel/delivery"
+)
+
+// webhookEvents validates that every item of a set is a webhook event type from the catalogue, suggesting
+// the closest event type for near misses.
+func webhookEvents() validatorWebhookEvents {
+	return validatorWebhookEvents{}
+}
+
+type validatorWebhookEvents struct{}
+
+func (v validatorWebhookEvents) Description(ctx context.Context) string {
+	return fmt.Sprintf("Set item must be one of %s", strings.Join(delivery.Events, ", "))
+}
+func (v validatorWebhookEvents) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf("Set item must be one of `%s`", strings.Join(delivery.Events, "`, `"))
+}
+
+func (v validatorWebhookEvents) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	for _, i := range req.ConfigValue.Elements() {
+		item, ok := i.(types.String)
+		if !ok || item.IsUnknown() || item.IsNull() {
+			continue
+		}
+		if delivery.ValidEvent(item.ValueString()) {
+			continue
+		}
+		if suggestion := delivery.SuggestEvent(item.ValueString()); suggestion != "" {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Invalid webhook event",
+				fmt.Sprintf("%q is not a valid webhook event, did you mean %q?", item.ValueString(), suggestion),
+			)
+			continue
+		}
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid webhook event",
+			fmt.Sprintf("%q is not a valid webhook event, expected one of: %s", item.ValueString(), strings.Join(delivery.Events, ", ")),
+		)
+	}
+}
@@ -0,0 +1,67 @@
+package vercel
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// validateWriteOnlyValue checks that exactly one of `value` or `value_wo` has been configured, and that a
+// `value_wo_version` accompanies any `value_wo`. Without a version there is no way to tell when a write-only
+// value has changed, as it is never stored in state.
+func validateWriteOnlyValue(value, valueWO types.String, valueWOVersion types.Int64) (diags diag.Diagnostics) {
+	if !value.IsNull() && !valueWO.IsNull() {
+		diags.AddAttributeError(
+			path.Root("value_wo"),
+			"Invalid Environment Variable",
+			"Only one of `value` or `value_wo` can be set.",
+		)
+	}
+	if value.IsNull() && valueWO.IsNull() {
+		diags.AddAttributeError(
+			path.Root("value"),
+			"Invalid Environment Variable",
+			"One of `value` or `value_wo` must be set.",
+		)
+	}
+	if !valueWO.IsNull() && valueWOVersion.IsNull() {
+		diags.AddAttributeError(
+			path.Root("value_wo_version"),
+			"Invalid Environment Variable",
+			"`value_wo_version` must be set when using `value_wo`. Change the version whenever `value_wo` is changed.",
+		)
+	}
+	if valueWO.IsNull() && !valueWOVersion.IsNull() {
+		diags.AddAttributeError(
+			path.Root("value_wo_version"),
+			"Invalid Environment Variable",
+			"`value_wo_version` can only be set when using `value_wo`.",
+		)
+	}
+	return diags
+}
+
+// validateWriteOnlyValueConfig reads the `value`, `value_wo` and `value_wo_version` attributes from config,
+// and validates them with validateWriteOnlyValue.
+func validateWriteOnlyValueConfig(ctx context.Context, config tfsdk.Config) (diags diag.Diagnostics) {
+	var value, valueWO types.String
+	var valueWOVersion types.Int64
+	diags.Append(config.GetAttribute(ctx, path.Root("value"), &value)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("value_wo"), &valueWO)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("value_wo_version"), &valueWOVersion)...)
+	if diags.HasError() {
+		return diags
+	}
+	return validateWriteOnlyValue(value, valueWO, valueWOVersion)
+}
+
+// getWriteOnlyValue reads the `value_wo` attribute from config. Write-only attributes are always null in
+// plan and state, so config is the only place they can be read from.
+func getWriteOnlyValue(ctx context.Context, config tfsdk.Config) (types.String, diag.Diagnostics) {
+	var valueWO types.String
+	diags := config.GetAttribute(ctx, path.Root("value_wo"), &valueWO)
+	return valueWO, diags
+}